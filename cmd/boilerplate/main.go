@@ -128,6 +128,9 @@ func defineFlags(cmd *cobra.Command, config *model.Config) error {
 	if err = bindStringVar(cmd, &config.API.GRPCPort, "api.grpc-port", "8082", "API GRPC Port"); err != nil {
 		return fmt.Errorf("bind api.grpc-port: %w", err)
 	}
+	if err = bindStringVar(cmd, &config.API.MetricsPort, "api.metrics-port", "9090", "API Metrics Port"); err != nil {
+		return fmt.Errorf("bind api.metrics-port: %w", err)
+	}
 	if err = bindStringVar(cmd, &config.API.AccessPrivateKey, "api.access-private-key", "dd4dcf2eae3c3a6f097d69f49ce584852d66ac85505f5d264e1b6fb8f90d9019", "API Access Private Key"); err != nil {
 		return fmt.Errorf("bind api.access-private-key: %w", err)
 	}
@@ -139,6 +142,9 @@ func defineFlags(cmd *cobra.Command, config *model.Config) error {
 	}
 
 	// S3
+	if err = bindStringVar(cmd, &config.S3.Backend, "s3.backend", model.S3BackendS3, "S3 Storage Backend (s3, nats)"); err != nil {
+		return fmt.Errorf("bind s3.backend: %w", err)
+	}
 	if err = bindStringVar(cmd, &config.S3.Host, "s3.host", "localhost", "S3 Host"); err != nil {
 		return fmt.Errorf("bind s3.host: %w", err)
 	}
@@ -182,6 +188,9 @@ func defineFlags(cmd *cobra.Command, config *model.Config) error {
 	if err = bindStringVar(cmd, &config.Nats.DataDir, "nats.data-dir", "./tmp/nats", "NATS Data Directory"); err != nil {
 		return fmt.Errorf("bind nats.data-dir: %w", err)
 	}
+	if err = bindIntVar(cmd, &config.Nats.DrainTimeout, "nats.drain-timeout", 30, "NATS Consumers Drain Timeout"); err != nil {
+		return fmt.Errorf("bind nats.drain-timeout: %w", err)
+	}
 
 	// Mail
 	if err = bindStringVar(cmd, &config.Mail.SMTPHost, "mail.smtp-host", "smtp.example.com", "Mail SMTP Host"); err != nil {
@@ -206,6 +215,53 @@ func defineFlags(cmd *cobra.Command, config *model.Config) error {
 		return fmt.Errorf("bind mail.tls: %w", err)
 	}
 
+	// Users
+	if err = bindStringVar(cmd, &config.Users.AttributesSchema, "users.attributes-schema", "", "Users Attributes JSON Schema Path"); err != nil {
+		return fmt.Errorf("bind users.attributes-schema: %w", err)
+	}
+	if err = bindStringVar(cmd, &config.Users.EmailConfirmURL, "users.email-confirm-url", "http://localhost:8080/api/users/email/confirm", "Users Email Change Confirmation URL"); err != nil {
+		return fmt.Errorf("bind users.email-confirm-url: %w", err)
+	}
+	if err = bindStringVar(cmd, &config.Users.EmailUndoURL, "users.email-undo-url", "http://localhost:8080/api/users/email/undo", "Users Email Change Undo URL"); err != nil {
+		return fmt.Errorf("bind users.email-undo-url: %w", err)
+	}
+	if err = bindIntVar(cmd, &config.Users.EmailChangeTTL, "users.email-change-ttl", 86400, "Users Email Change Confirmation TTL"); err != nil {
+		return fmt.Errorf("bind users.email-change-ttl: %w", err)
+	}
+	if err = bindIntVar(cmd, &config.Users.EmailUndoTTL, "users.email-undo-ttl", 604800, "Users Email Change Undo TTL"); err != nil {
+		return fmt.Errorf("bind users.email-undo-ttl: %w", err)
+	}
+
+	// Outbox
+	if err = bindIntVar(cmd, &config.Outbox.PollInterval, "outbox.poll-interval", 1000, "Outbox Poll Interval"); err != nil {
+		return fmt.Errorf("bind outbox.poll-interval: %w", err)
+	}
+	if err = bindIntVar(cmd, &config.Outbox.BatchSize, "outbox.batch-size", 100, "Outbox Batch Size"); err != nil {
+		return fmt.Errorf("bind outbox.batch-size: %w", err)
+	}
+	if err = bindIntVar(cmd, &config.Outbox.MaxAttempts, "outbox.max-attempts", 10, "Outbox Max Publish Attempts"); err != nil {
+		return fmt.Errorf("bind outbox.max-attempts: %w", err)
+	}
+	if err = bindIntVar(cmd, &config.Outbox.RetryDelay, "outbox.retry-delay", 5, "Outbox Retry Delay"); err != nil {
+		return fmt.Errorf("bind outbox.retry-delay: %w", err)
+	}
+	if err = bindIntVar(cmd, &config.Outbox.MaxRetryDelay, "outbox.max-retry-delay", 300, "Outbox Max Retry Delay"); err != nil {
+		return fmt.Errorf("bind outbox.max-retry-delay: %w", err)
+	}
+	if err = bindIntVar(cmd, &config.Outbox.Retention, "outbox.retention", 604800, "Outbox Published Messages Retention"); err != nil {
+		return fmt.Errorf("bind outbox.retention: %w", err)
+	}
+
+	// Inbox
+	if err = bindIntVar(cmd, &config.Inbox.Retention, "inbox.retention", 604800, "Inbox Processed Messages Retention"); err != nil {
+		return fmt.Errorf("bind inbox.retention: %w", err)
+	}
+
+	// Lock
+	if err = bindIntVar(cmd, &config.Lock.TTL, "lock.ttl", 15, "Distributed Lock TTL"); err != nil {
+		return fmt.Errorf("bind lock.ttl: %w", err)
+	}
+
 	return nil
 }
 
@@ -5,6 +5,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"boilerplate/internal/api/grpc/handlers/preferences"
 	"boilerplate/internal/api/grpc/handlers/users"
 	"boilerplate/internal/pkg/grpc"
 	"boilerplate/pkg/pb"
@@ -17,6 +18,7 @@ func (h *handler) Me(ctx context.Context, req *emptypb.Empty) (*pb.AuthMeRespons
 	}
 
 	return &pb.AuthMeResponse{
-		User: users.ToUser(resp),
+		User:        users.ToUser(resp.User),
+		Preferences: preferences.ToPreferences(resp.Preferences),
 	}, nil
 }
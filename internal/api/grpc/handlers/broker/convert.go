@@ -0,0 +1,95 @@
+package broker
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/schema_registry"
+	"boilerplate/internal/services/broker"
+	"boilerplate/pkg/pb"
+)
+
+func ToDLQMessage(message *broker.DLQMessage) *pb.DLQMessage {
+	return &pb.DLQMessage{
+		Id:       message.ID,
+		Topic:    message.Topic,
+		Subject:  message.Subject,
+		Consumer: message.Consumer,
+		Error:    message.Error,
+		Attempts: convert.ToInt64(message.Attempts),
+		FailedAt: timestamppb.New(message.FailedAt),
+		Headers:  message.Headers,
+		Data:     message.Data,
+	}
+}
+
+func FromDLQFilter(filter *pb.DLQFilter) *broker.DLQFilter {
+	if filter == nil {
+		return nil
+	}
+
+	return &broker.DLQFilter{
+		Subject:  filter.Subject,
+		Consumer: filter.Consumer,
+		Error:    filter.Error,
+	}
+}
+
+func ToStreamStats(stats *model.BrokerStreamStats) *pb.StreamStats {
+	res := &pb.StreamStats{
+		Topic:     stats.Topic,
+		Messages:  stats.Messages,
+		Bytes:     stats.Bytes,
+		FirstId:   stats.FirstID,
+		LastId:    stats.LastID,
+		Consumers: convert.ToInt64(stats.Consumers),
+	}
+	if stats.LastTime != nil {
+		res.LastTime = timestamppb.New(*stats.LastTime)
+	}
+
+	return res
+}
+
+func ToConsumerStats(stats *model.BrokerConsumerStats) *pb.ConsumerStats {
+	res := &pb.ConsumerStats{
+		Topic:       stats.Topic,
+		Consumer:    stats.Consumer,
+		Name:        stats.Name,
+		Subject:     stats.Subject,
+		Pending:     stats.Pending,
+		AckPending:  convert.ToInt64(stats.AckPending),
+		Redelivered: convert.ToInt64(stats.Redelivered),
+	}
+	if stats.LastActive != nil {
+		res.LastActive = timestamppb.New(*stats.LastActive)
+	}
+
+	return res
+}
+
+// ToSchema передает JSON Schema объектом, а FileDescriptorSet - байтами
+func ToSchema(schema *schema_registry.Schema) (*pb.Schema, error) {
+	res := &pb.Schema{
+		Topic:     schema.Topic,
+		Version:   convert.ToInt64(schema.Version),
+		Format:    string(schema.Format),
+		Message:   schema.Message,
+		CreatedAt: timestamppb.New(schema.CreatedAt),
+	}
+
+	if schema.Format == schema_registry.FormatJSON {
+		res.JsonSchema = &structpb.Struct{}
+		if err := res.JsonSchema.UnmarshalJSON(schema.Definition); err != nil {
+			return nil, fmt.Errorf("unmarshal json schema: %w", err)
+		}
+	} else {
+		res.DescriptorSet = schema.Definition
+	}
+
+	return res, nil
+}
@@ -0,0 +1,24 @@
+package broker
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) GetConsumerStats(ctx context.Context, _ *pb.ConsumerStatsRequest) (*pb.ConsumerStatsResponse, error) {
+	resp, err := h.brokerService.GetConsumerStats(ctx)
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	consumers := make([]*pb.ConsumerStats, 0, len(resp))
+	for _, stats := range resp {
+		consumers = append(consumers, ToConsumerStats(stats))
+	}
+
+	return &pb.ConsumerStatsResponse{
+		Consumers: consumers,
+	}, nil
+}
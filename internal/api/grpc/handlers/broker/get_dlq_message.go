@@ -0,0 +1,19 @@
+package broker
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) GetDLQMessage(ctx context.Context, req *pb.DLQGetRequest) (*pb.DLQGetResponse, error) {
+	resp, err := h.brokerService.GetDLQMessage(ctx, req.GetTopic(), req.GetId())
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.DLQGetResponse{
+		Message: ToDLQMessage(resp),
+	}, nil
+}
@@ -0,0 +1,25 @@
+package broker
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) GetSchema(ctx context.Context, req *pb.SchemaGetRequest) (*pb.SchemaGetResponse, error) {
+	resp, err := h.brokerService.GetSchema(ctx, req.GetTopic(), convert.ToInt(req.GetVersion()))
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	schema, err := ToSchema(resp)
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.SchemaGetResponse{
+		Schema: schema,
+	}, nil
+}
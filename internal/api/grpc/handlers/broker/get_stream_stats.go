@@ -0,0 +1,24 @@
+package broker
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) GetStreamStats(ctx context.Context, _ *pb.StreamStatsRequest) (*pb.StreamStatsResponse, error) {
+	resp, err := h.brokerService.GetStreamStats(ctx)
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	streams := make([]*pb.StreamStats, 0, len(resp))
+	for _, stats := range resp {
+		streams = append(streams, ToStreamStats(stats))
+	}
+
+	return &pb.StreamStatsResponse{
+		Streams: streams,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package broker
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/services/broker"
+	"boilerplate/pkg/pb"
+)
+
+type handler struct {
+	pb.UnimplementedBrokerAPIServer
+	brokerService broker.Service
+}
+
+func NewHandler(
+	brokerService broker.Service,
+) model.GRPCHandler {
+	return &handler{
+		brokerService: brokerService,
+	}
+}
+
+func (h *handler) RegisterGRPCServer(server *grpc.Server) {
+	pb.RegisterBrokerAPIServer(server, h)
+}
+
+func (h *handler) RegisterHTTPHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return pb.RegisterBrokerAPIHandler(ctx, mux, conn)
+}
@@ -0,0 +1,36 @@
+package broker
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/services/broker"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) ListDLQMessages(ctx context.Context, req *pb.DLQListRequest) (*pb.DLQListResponse, error) {
+	listReq := &broker.DLQListRequest{
+		Topic:   req.GetTopic(),
+		AfterID: req.AfterId,
+		Limit:   convert.ToIntPtr(req.Limit),
+	}
+	if filter := FromDLQFilter(req.GetFilter()); filter != nil {
+		listReq.Filter = *filter
+	}
+
+	resp, err := h.brokerService.ListDLQMessages(ctx, listReq)
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	res := &pb.DLQListResponse{
+		Messages: make([]*pb.DLQMessage, 0, len(resp.Result)),
+	}
+
+	for _, message := range resp.Result {
+		res.Messages = append(res.Messages, ToDLQMessage(message))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,28 @@
+package broker
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) ListSchemas(ctx context.Context, req *pb.SchemaListRequest) (*pb.SchemaListResponse, error) {
+	resp, err := h.brokerService.ListSchemas(ctx, req.Topic)
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	schemas := make([]*pb.Schema, 0, len(resp))
+	for _, schema := range resp {
+		res, err := ToSchema(schema)
+		if err != nil {
+			return nil, grpc.Error(err)
+		}
+		schemas = append(schemas, res)
+	}
+
+	return &pb.SchemaListResponse{
+		Schemas: schemas,
+	}, nil
+}
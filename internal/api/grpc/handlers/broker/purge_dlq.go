@@ -0,0 +1,20 @@
+package broker
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) PurgeDLQ(ctx context.Context, req *pb.DLQPurgeRequest) (*pb.DLQPurgeResponse, error) {
+	purged, err := h.brokerService.PurgeDLQ(ctx, req.GetTopic())
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.DLQPurgeResponse{
+		Purged: convert.ToInt64(purged),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package broker
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/services/broker"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) ReplayDLQMessages(ctx context.Context, req *pb.DLQReplayRequest) (*pb.DLQReplayResponse, error) {
+	resp, err := h.brokerService.ReplayDLQMessages(ctx, &broker.DLQReplayRequest{
+		Topic:  req.GetTopic(),
+		IDs:    req.GetIds(),
+		Filter: FromDLQFilter(req.GetFilter()),
+		All:    req.GetAll(),
+	})
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.DLQReplayResponse{
+		ReplayedIds: resp.Replayed,
+		SkippedIds:  resp.Skipped,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package groups
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/services/groups"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) AddMembers(ctx context.Context, req *pb.GroupAddMembersRequest) (*pb.GroupAddMembersResponse, error) {
+	added, err := h.groupsService.AddMembers(ctx, &groups.GroupMembersRequest{
+		GroupID: convert.ToInt(req.GetGroupId()),
+		UserIDs: convert.ToInts(req.GetUserIds()),
+	})
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.GroupAddMembersResponse{
+		AddedUserIds: convert.ToInt64s(added),
+	}, nil
+}
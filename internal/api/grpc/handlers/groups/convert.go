@@ -0,0 +1,30 @@
+package groups
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/services/groups"
+	"boilerplate/pkg/pb"
+)
+
+func ToGroup(group *groups.Group) *pb.Group {
+	return &pb.Group{
+		Id:          convert.ToInt64(group.ID),
+		Name:        group.Name,
+		Description: group.Description,
+		CreatedBy:   convert.ToInt64Ptr(group.CreatedBy),
+		CreatedAt:   timestamppb.New(group.CreatedAt),
+		UpdatedAt:   timestamppb.New(group.UpdatedAt),
+	}
+}
+
+func ToGroupMember(member *groups.GroupMember) *pb.GroupMember {
+	return &pb.GroupMember{
+		UserId:  convert.ToInt64(member.UserID),
+		Name:    member.Name,
+		Email:   member.Email,
+		AddedBy: convert.ToInt64Ptr(member.AddedBy),
+		AddedAt: timestamppb.New(member.AddedAt),
+	}
+}
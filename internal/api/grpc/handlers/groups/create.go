@@ -0,0 +1,23 @@
+package groups
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/services/groups"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) Create(ctx context.Context, req *pb.GroupCreateRequest) (*pb.GroupCreateResponse, error) {
+	resp, err := h.groupsService.Create(ctx, &groups.GroupCreateRequest{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	})
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.GroupCreateResponse{
+		Group: ToGroup(resp),
+	}, nil
+}
@@ -0,0 +1,20 @@
+package groups
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) Delete(ctx context.Context, req *pb.GroupDeleteRequest) (*emptypb.Empty, error) {
+	err := h.groupsService.Delete(ctx, convert.ToInt(req.GetGroupId()))
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,20 @@
+package groups
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) Get(ctx context.Context, req *pb.GroupGetRequest) (*pb.GroupGetResponse, error) {
+	resp, err := h.groupsService.Get(ctx, convert.ToInt(req.GetGroupId()))
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.GroupGetResponse{
+		Group: ToGroup(resp),
+	}, nil
+}
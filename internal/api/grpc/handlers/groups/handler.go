@@ -0,0 +1,33 @@
+package groups
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/services/groups"
+	"boilerplate/pkg/pb"
+)
+
+type handler struct {
+	pb.UnimplementedGroupsAPIServer
+	groupsService groups.Service
+}
+
+func NewHandler(
+	groupsService groups.Service,
+) model.GRPCHandler {
+	return &handler{
+		groupsService: groupsService,
+	}
+}
+
+func (h *handler) RegisterGRPCServer(server *grpc.Server) {
+	pb.RegisterGroupsAPIServer(server, h)
+}
+
+func (h *handler) RegisterHTTPHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return pb.RegisterGroupsAPIHandler(ctx, mux, conn)
+}
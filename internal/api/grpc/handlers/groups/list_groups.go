@@ -0,0 +1,33 @@
+package groups
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/services/groups"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) ListGroups(ctx context.Context, req *pb.GroupListRequest) (*pb.GroupListResponse, error) {
+	resp, err := h.groupsService.ListGroups(ctx, &groups.GroupListRequest{
+		MemberID: convert.ToIntPtr(req.MemberId),
+		Name:     req.Name,
+		Limit:    convert.ToIntPtr(req.Limit),
+		Offset:   convert.ToIntPtr(req.Offset),
+	})
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	res := &pb.GroupListResponse{
+		Groups: make([]*pb.Group, 0, len(resp.Result)),
+		Total:  convert.ToInt64(resp.Total),
+	}
+
+	for _, group := range resp.Result {
+		res.Groups = append(res.Groups, ToGroup(group))
+	}
+
+	return res, nil
+}
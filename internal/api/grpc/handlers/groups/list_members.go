@@ -0,0 +1,32 @@
+package groups
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/services/groups"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) ListMembers(ctx context.Context, req *pb.GroupListMembersRequest) (*pb.GroupListMembersResponse, error) {
+	resp, err := h.groupsService.ListMembers(ctx, &groups.GroupListMembersRequest{
+		GroupID: convert.ToInt(req.GetGroupId()),
+		Limit:   convert.ToIntPtr(req.Limit),
+		Offset:  convert.ToIntPtr(req.Offset),
+	})
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	res := &pb.GroupListMembersResponse{
+		Members: make([]*pb.GroupMember, 0, len(resp.Result)),
+		Total:   convert.ToInt64(resp.Total),
+	}
+
+	for _, member := range resp.Result {
+		res.Members = append(res.Members, ToGroupMember(member))
+	}
+
+	return res, nil
+}
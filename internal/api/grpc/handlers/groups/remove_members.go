@@ -0,0 +1,24 @@
+package groups
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/services/groups"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) RemoveMembers(ctx context.Context, req *pb.GroupRemoveMembersRequest) (*pb.GroupRemoveMembersResponse, error) {
+	removed, err := h.groupsService.RemoveMembers(ctx, &groups.GroupMembersRequest{
+		GroupID: convert.ToInt(req.GetGroupId()),
+		UserIDs: convert.ToInts(req.GetUserIds()),
+	})
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.GroupRemoveMembersResponse{
+		RemovedUserIds: convert.ToInt64s(removed),
+	}, nil
+}
@@ -0,0 +1,25 @@
+package groups
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/services/groups"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) Update(ctx context.Context, req *pb.GroupUpdateRequest) (*pb.GroupUpdateResponse, error) {
+	resp, err := h.groupsService.Update(ctx, &groups.GroupUpdateRequest{
+		ID:          convert.ToInt(req.GetGroupId()),
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.GroupUpdateResponse{
+		Group: ToGroup(resp),
+	}, nil
+}
@@ -2,6 +2,12 @@ package handlers
 
 import (
 	"boilerplate/internal/api/grpc/handlers/auth"
+	"boilerplate/internal/api/grpc/handlers/broker"
+	"boilerplate/internal/api/grpc/handlers/groups"
+	"boilerplate/internal/api/grpc/handlers/preferences"
+	"boilerplate/internal/api/grpc/handlers/scheduler"
+	"boilerplate/internal/api/grpc/handlers/user_exports"
+	"boilerplate/internal/api/grpc/handlers/user_imports"
 	"boilerplate/internal/api/grpc/handlers/users"
 	"boilerplate/internal/model"
 	"boilerplate/internal/service_provider"
@@ -17,5 +23,23 @@ func NewHandlers(
 		users.NewHandler(
 			sp.GetUsersService(),
 		),
+		user_imports.NewHandler(
+			sp.GetUserImportsService(),
+		),
+		user_exports.NewHandler(
+			sp.GetUserExportsService(),
+		),
+		preferences.NewHandler(
+			sp.GetPreferencesService(),
+		),
+		groups.NewHandler(
+			sp.GetGroupsService(),
+		),
+		broker.NewHandler(
+			sp.GetBrokerService(),
+		),
+		scheduler.NewHandler(
+			sp.GetSchedulerService(),
+		),
 	}
 }
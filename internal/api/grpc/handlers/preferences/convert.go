@@ -0,0 +1,17 @@
+package preferences
+
+import (
+	"boilerplate/internal/services/preferences"
+	"boilerplate/pkg/pb"
+)
+
+func ToPreferences(prefs *preferences.Preferences) *pb.Preferences {
+	return &pb.Preferences{
+		Locale:   prefs.Locale,
+		Timezone: prefs.Timezone,
+		Notifications: &pb.PreferencesNotifications{
+			Email:    prefs.Notifications.Email,
+			Security: prefs.Notifications.Security,
+		},
+	}
+}
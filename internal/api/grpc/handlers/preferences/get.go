@@ -0,0 +1,26 @@
+package preferences
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) Get(ctx context.Context, _ *emptypb.Empty) (*pb.PreferencesGetResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	resp, err := h.preferencesService.Get(ctx, userID)
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.PreferencesGetResponse{
+		Preferences: ToPreferences(resp),
+	}, nil
+}
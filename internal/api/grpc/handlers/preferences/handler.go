@@ -0,0 +1,45 @@
+package preferences
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+
+	"boilerplate/internal/model"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/pkg/metadata"
+	"boilerplate/internal/services/preferences"
+	"boilerplate/pkg/pb"
+)
+
+type handler struct {
+	pb.UnimplementedPreferencesAPIServer
+	preferencesService preferences.Service
+}
+
+func NewHandler(
+	preferencesService preferences.Service,
+) model.GRPCHandler {
+	return &handler{
+		preferencesService: preferencesService,
+	}
+}
+
+func (h *handler) RegisterGRPCServer(server *grpc.Server) {
+	pb.RegisterPreferencesAPIServer(server, h)
+}
+
+func (h *handler) RegisterHTTPHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return pb.RegisterPreferencesAPIHandler(ctx, mux, conn)
+}
+
+// currentUserID возвращает пользователя, настройки которого читаются и меняются
+func currentUserID(ctx context.Context) (int, error) {
+	userID, exists := metadata.GetUserID(ctx)
+	if !exists {
+		return 0, errors_pkg.NewUnauthorizedError(i18n.T(ctx, i18n.KeyUnauthorized))
+	}
+	return userID, nil
+}
@@ -0,0 +1,38 @@
+package preferences
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/services/preferences"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) Update(ctx context.Context, req *pb.PreferencesUpdateRequest) (*pb.PreferencesUpdateResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	notifications := req.GetNotifications()
+	if notifications == nil {
+		notifications = &pb.PreferencesNotificationsUpdateRequest{}
+	}
+
+	resp, err := h.preferencesService.Update(ctx, &preferences.PreferencesUpdateRequest{
+		UserID:   userID,
+		Locale:   req.Locale,
+		Timezone: req.Timezone,
+		Notifications: preferences.PreferencesNotificationsUpdate{
+			Email:    notifications.Email,
+			Security: notifications.Security,
+		},
+	})
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.PreferencesUpdateResponse{
+		Preferences: ToPreferences(resp),
+	}, nil
+}
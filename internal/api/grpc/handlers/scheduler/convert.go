@@ -0,0 +1,37 @@
+package scheduler
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"boilerplate/internal/services/scheduler"
+	"boilerplate/pkg/pb"
+)
+
+func ToJob(job *scheduler.JobInfo) *pb.Job {
+	res := &pb.Job{
+		Name:      job.Name,
+		Schedule:  job.Schedule,
+		Timezone:  job.Timezone,
+		CatchUp:   string(job.CatchUp),
+		Paused:    job.Paused,
+		NextRunAt: timestamppb.New(job.NextRunAt),
+	}
+
+	if job.TriggeredAt != nil {
+		res.TriggeredAt = timestamppb.New(*job.TriggeredAt)
+	}
+
+	if job.LastRun != nil {
+		res.LastRun = &pb.JobRun{
+			StartedAt: timestamppb.New(job.LastRun.StartedAt),
+			Status:    string(job.LastRun.Status),
+			Error:     job.LastRun.Error,
+		}
+		if job.LastRun.Duration != nil {
+			durationMs := job.LastRun.Duration.Milliseconds()
+			res.LastRun.DurationMs = &durationMs
+		}
+	}
+
+	return res
+}
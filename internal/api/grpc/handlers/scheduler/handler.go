@@ -0,0 +1,33 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/services/scheduler"
+	"boilerplate/pkg/pb"
+)
+
+type handler struct {
+	pb.UnimplementedSchedulerAPIServer
+	schedulerService scheduler.Service
+}
+
+func NewHandler(
+	schedulerService scheduler.Service,
+) model.GRPCHandler {
+	return &handler{
+		schedulerService: schedulerService,
+	}
+}
+
+func (h *handler) RegisterGRPCServer(server *grpc.Server) {
+	pb.RegisterSchedulerAPIServer(server, h)
+}
+
+func (h *handler) RegisterHTTPHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return pb.RegisterSchedulerAPIHandler(ctx, mux, conn)
+}
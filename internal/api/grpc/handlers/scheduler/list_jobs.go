@@ -0,0 +1,24 @@
+package scheduler
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) ListJobs(ctx context.Context, _ *pb.JobListRequest) (*pb.JobListResponse, error) {
+	resp, err := h.schedulerService.ListJobs(ctx)
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	jobs := make([]*pb.Job, 0, len(resp))
+	for _, job := range resp {
+		jobs = append(jobs, ToJob(job))
+	}
+
+	return &pb.JobListResponse{
+		Jobs: jobs,
+	}, nil
+}
@@ -0,0 +1,19 @@
+package scheduler
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) PauseJob(ctx context.Context, req *pb.JobPauseRequest) (*pb.JobPauseResponse, error) {
+	resp, err := h.schedulerService.PauseJob(ctx, req.GetName())
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.JobPauseResponse{
+		Job: ToJob(resp),
+	}, nil
+}
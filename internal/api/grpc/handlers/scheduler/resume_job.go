@@ -0,0 +1,19 @@
+package scheduler
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) ResumeJob(ctx context.Context, req *pb.JobResumeRequest) (*pb.JobResumeResponse, error) {
+	resp, err := h.schedulerService.ResumeJob(ctx, req.GetName())
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.JobResumeResponse{
+		Job: ToJob(resp),
+	}, nil
+}
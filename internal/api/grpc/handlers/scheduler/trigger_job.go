@@ -0,0 +1,19 @@
+package scheduler
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) TriggerJob(ctx context.Context, req *pb.JobTriggerRequest) (*pb.JobTriggerResponse, error) {
+	resp, err := h.schedulerService.TriggerJob(ctx, req.GetName())
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.JobTriggerResponse{
+		Job: ToJob(resp),
+	}, nil
+}
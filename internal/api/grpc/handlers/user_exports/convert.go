@@ -0,0 +1,44 @@
+package user_exports
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/services/user_exports"
+	"boilerplate/pkg/pb"
+)
+
+func ToUserExport(userExport *user_exports.UserExport) *pb.UserExport {
+	return &pb.UserExport{
+		Id:          convert.ToInt64(userExport.ID),
+		Status:      string(userExport.Status),
+		Format:      string(userExport.Format),
+		Total:       convert.ToInt64(userExport.Total),
+		DownloadUrl: userExport.DownloadURL,
+		Error:       userExport.Error,
+		CreatedAt:   timestamppb.New(userExport.CreatedAt),
+		UpdatedAt:   timestamppb.New(userExport.UpdatedAt),
+		FinishedAt: func() *timestamppb.Timestamp {
+			if userExport.FinishedAt == nil {
+				return nil
+			}
+			return timestamppb.New(*userExport.FinishedAt)
+		}(),
+	}
+}
+
+func ToUserExportFilter(filter *pb.UserExportFilter) user_exports.UserExportFilter {
+	res := user_exports.UserExportFilter{
+		Name:        filter.Name,
+		Emails:      filter.GetEmails(),
+		IsAdmin:     filter.IsAdmin,
+		Attributes:  convert.FromStruct(filter.GetAttributes()),
+		WithDeleted: filter.WithDeleted,
+	}
+
+	for _, id := range filter.GetIds() {
+		res.IDs = append(res.IDs, convert.ToInt(id))
+	}
+
+	return res
+}
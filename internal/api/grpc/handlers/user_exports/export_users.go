@@ -0,0 +1,24 @@
+package user_exports
+
+import (
+	"context"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/services/user_exports"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) ExportUsers(ctx context.Context, req *pb.ExportUsersRequest) (*pb.ExportUsersResponse, error) {
+	resp, err := h.userExportsService.ExportUsers(ctx, &user_exports.ExportUsersRequest{
+		Format: model.UserExportFormat(req.GetFormat()),
+		Filter: ToUserExportFilter(req.GetFilter()),
+	})
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.ExportUsersResponse{
+		Export: ToUserExport(resp),
+	}, nil
+}
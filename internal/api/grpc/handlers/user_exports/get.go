@@ -0,0 +1,20 @@
+package user_exports
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) Get(ctx context.Context, req *pb.UserExportGetRequest) (*pb.UserExportGetResponse, error) {
+	resp, err := h.userExportsService.Get(ctx, convert.ToInt(req.GetExportId()))
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.UserExportGetResponse{
+		Export: ToUserExport(resp),
+	}, nil
+}
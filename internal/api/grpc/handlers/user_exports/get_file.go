@@ -0,0 +1,53 @@
+package user_exports
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	grpc_lib "google.golang.org/grpc"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+// fileChunkSize размер части файла в одном сообщении потока
+const fileChunkSize = 64 * 1024
+
+// GetFile передает файл выгрузки частями, чтобы не держать крупную выгрузку
+// в памяти целиком
+func (h *handler) GetFile(req *pb.UserExportGetFileRequest, stream grpc_lib.ServerStreamingServer[httpbody.HttpBody]) error {
+	resp, err := h.userExportsService.GetFile(stream.Context(), convert.ToInt(req.GetExportId()))
+	if err != nil {
+		return grpc.Error(err)
+	}
+	defer func() {
+		_ = resp.Content.Close()
+	}()
+
+	buf := make([]byte, fileChunkSize)
+	sent := false
+	for {
+		n, readErr := resp.Content.Read(buf)
+		// Пустой файл передается одним сообщением, чтобы ответ получил тип
+		if n > 0 || (!sent && errors.Is(readErr, io.EOF)) {
+			err := stream.Send(&httpbody.HttpBody{
+				ContentType: resp.ContentType,
+				Data:        buf[:n],
+			})
+			if err != nil {
+				return err
+			}
+			sent = true
+		}
+
+		if errors.Is(readErr, io.EOF) {
+			return nil
+		}
+		if readErr != nil {
+			return grpc.Error(fmt.Errorf("read file: %w", readErr))
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package user_exports
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/services/user_exports"
+	"boilerplate/pkg/pb"
+)
+
+type handler struct {
+	pb.UnimplementedUserExportsAPIServer
+	userExportsService user_exports.Service
+}
+
+func NewHandler(
+	userExportsService user_exports.Service,
+) model.GRPCHandler {
+	return &handler{
+		userExportsService: userExportsService,
+	}
+}
+
+func (h *handler) RegisterGRPCServer(server *grpc.Server) {
+	pb.RegisterUserExportsAPIServer(server, h)
+}
+
+func (h *handler) RegisterHTTPHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return pb.RegisterUserExportsAPIHandler(ctx, mux, conn)
+}
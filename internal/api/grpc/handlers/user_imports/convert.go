@@ -0,0 +1,31 @@
+package user_imports
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/services/user_imports"
+	"boilerplate/pkg/pb"
+)
+
+func ToUserImport(userImport *user_imports.UserImport) *pb.UserImport {
+	return &pb.UserImport{
+		Id:        convert.ToInt64(userImport.ID),
+		Status:    string(userImport.Status),
+		FilePath:  userImport.FilePath,
+		DryRun:    userImport.DryRun,
+		Total:     convert.ToInt64(userImport.Total),
+		Processed: convert.ToInt64(userImport.Processed),
+		Created:   convert.ToInt64(userImport.Created),
+		Failed:    convert.ToInt64(userImport.Failed),
+		Error:     userImport.Error,
+		CreatedAt: timestamppb.New(userImport.CreatedAt),
+		UpdatedAt: timestamppb.New(userImport.UpdatedAt),
+		FinishedAt: func() *timestamppb.Timestamp {
+			if userImport.FinishedAt == nil {
+				return nil
+			}
+			return timestamppb.New(*userImport.FinishedAt)
+		}(),
+	}
+}
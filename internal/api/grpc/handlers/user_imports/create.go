@@ -0,0 +1,23 @@
+package user_imports
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/services/user_imports"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) Create(ctx context.Context, req *pb.UserImportCreateRequest) (*pb.UserImportCreateResponse, error) {
+	resp, err := h.userImportsService.Create(ctx, &user_imports.UserImportCreateRequest{
+		FilePath: req.GetFilePath(),
+		DryRun:   req.GetDryRun(),
+	})
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.UserImportCreateResponse{
+		Import: ToUserImport(resp),
+	}, nil
+}
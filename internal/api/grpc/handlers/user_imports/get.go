@@ -0,0 +1,20 @@
+package user_imports
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) Get(ctx context.Context, req *pb.UserImportGetRequest) (*pb.UserImportGetResponse, error) {
+	resp, err := h.userImportsService.Get(ctx, convert.ToInt(req.GetImportId()))
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.UserImportGetResponse{
+		Import: ToUserImport(resp),
+	}, nil
+}
@@ -0,0 +1,23 @@
+package user_imports
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) GetReport(ctx context.Context, req *pb.UserImportGetReportRequest) (*httpbody.HttpBody, error) {
+	resp, err := h.userImportsService.GetReport(ctx, convert.ToInt(req.GetImportId()))
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: resp.ContentType,
+		Data:        resp.Content,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package user_imports
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/services/user_imports"
+	"boilerplate/pkg/pb"
+)
+
+type handler struct {
+	pb.UnimplementedUserImportsAPIServer
+	userImportsService user_imports.Service
+}
+
+func NewHandler(
+	userImportsService user_imports.Service,
+) model.GRPCHandler {
+	return &handler{
+		userImportsService: userImportsService,
+	}
+}
+
+func (h *handler) RegisterGRPCServer(server *grpc.Server) {
+	pb.RegisterUserImportsAPIServer(server, h)
+}
+
+func (h *handler) RegisterHTTPHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return pb.RegisterUserImportsAPIHandler(ctx, mux, conn)
+}
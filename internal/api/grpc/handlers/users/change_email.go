@@ -0,0 +1,25 @@
+package users
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/services/users"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) ChangeEmail(ctx context.Context, req *pb.UserChangeEmailRequest) (*pb.UserChangeEmailResponse, error) {
+	resp, err := h.usersService.ChangeEmail(ctx, &users.UserChangeEmailRequest{
+		ID:    convert.ToInt(req.GetUserId()),
+		Email: req.GetEmail(),
+		ETag:  getETag(ctx, req.Etag),
+	})
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.UserChangeEmailResponse{
+		Change: ToUserEmailChange(resp),
+	}, nil
+}
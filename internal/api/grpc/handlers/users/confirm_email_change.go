@@ -0,0 +1,19 @@
+package users
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) ConfirmEmailChange(ctx context.Context, req *pb.UserConfirmEmailChangeRequest) (*pb.UserConfirmEmailChangeResponse, error) {
+	resp, err := h.usersService.ConfirmEmailChange(ctx, req.GetToken())
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.UserConfirmEmailChangeResponse{
+		User: ToUser(resp),
+	}, nil
+}
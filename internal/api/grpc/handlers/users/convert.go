@@ -1,9 +1,12 @@
 package users
 
 import (
+	"context"
+
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
 	"boilerplate/internal/services/users"
 	"boilerplate/pkg/pb"
 )
@@ -24,5 +27,62 @@ func ToUser(user *users.User) *pb.User {
 			}
 			return timestamppb.New(*user.DeletedAt)
 		}(),
+		Etag:       user.ETag,
+		Attributes: convert.ToStruct(user.Attributes),
 	}
 }
+
+func ToUserEmailChange(change *users.UserEmailChange) *pb.UserEmailChange {
+	return &pb.UserEmailChange{
+		Id:            convert.ToInt64(change.ID),
+		UserId:        convert.ToInt64(change.UserID),
+		NewEmail:      change.NewEmail,
+		Status:        string(change.Status),
+		ExpiresAt:     timestamppb.New(change.ExpiresAt),
+		UndoExpiresAt: timestamppb.New(change.UndoExpiresAt),
+		ConfirmedAt: func() *timestamppb.Timestamp {
+			if change.ConfirmedAt == nil {
+				return nil
+			}
+			return timestamppb.New(*change.ConfirmedAt)
+		}(),
+		CreatedAt: timestamppb.New(change.CreatedAt),
+	}
+}
+
+func ToUserHistoryEntry(entry *users.UserHistoryEntry) *pb.UserHistoryEntry {
+	res := &pb.UserHistoryEntry{
+		Version:   convert.ToInt64(entry.Version),
+		Operation: string(entry.Operation),
+		ChangedAt: timestamppb.New(entry.ChangedAt),
+		Changes:   make([]*pb.UserFieldChange, 0, len(entry.Changes)),
+	}
+
+	if entry.ChangedBy != nil {
+		changedBy := convert.ToInt64(*entry.ChangedBy)
+		res.ChangedBy = &changedBy
+	}
+
+	for _, change := range entry.Changes {
+		res.Changes = append(res.Changes, &pb.UserFieldChange{
+			Field:    change.Field,
+			OldValue: convert.ToValue(change.OldValue),
+			NewValue: convert.ToValue(change.NewValue),
+		})
+	}
+
+	return res
+}
+
+// getETag возвращает ETag из тела запроса, а если он не передан - из заголовка If-Match
+func getETag(ctx context.Context, etag *string) *string {
+	if etag != nil {
+		return etag
+	}
+
+	if ifMatch, exists := grpc.GetIfMatch(ctx); exists {
+		return &ifMatch
+	}
+
+	return nil
+}
@@ -2,7 +2,9 @@ package users
 
 import (
 	"context"
+	"fmt"
 
+	"boilerplate/internal/pkg/convert"
 	"boilerplate/internal/pkg/grpc"
 	"boilerplate/internal/services/users"
 	"boilerplate/pkg/pb"
@@ -10,14 +12,19 @@ import (
 
 func (h *handler) Create(ctx context.Context, req *pb.UserCreateRequest) (*pb.UserCreateResponse, error) {
 	resp, err := h.usersService.Create(ctx, &users.UserCreateRequest{
-		Name:     req.GetName(),
-		Email:    req.GetEmail(),
-		Password: req.GetPassword(),
+		Name:       req.GetName(),
+		Email:      req.GetEmail(),
+		Password:   req.GetPassword(),
+		Attributes: convert.FromStruct(req.GetAttributes()),
 	})
 	if err != nil {
 		return nil, grpc.Error(err)
 	}
 
+	if err := grpc.SetETag(ctx, resp.ETag); err != nil {
+		return nil, fmt.Errorf("set etag: %w", err)
+	}
+
 	return &pb.UserCreateResponse{
 		User: ToUser(resp),
 	}, nil
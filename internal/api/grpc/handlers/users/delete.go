@@ -7,11 +7,15 @@ import (
 
 	"boilerplate/internal/pkg/convert"
 	"boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/services/users"
 	"boilerplate/pkg/pb"
 )
 
 func (h *handler) Delete(ctx context.Context, req *pb.UserDeleteRequest) (*emptypb.Empty, error) {
-	err := h.usersService.Delete(ctx, convert.ToInt(req.GetUserId()))
+	err := h.usersService.Delete(ctx, &users.UserDeleteRequest{
+		ID:   convert.ToInt(req.GetUserId()),
+		ETag: getETag(ctx, req.Etag),
+	})
 	if err != nil {
 		return nil, grpc.Error(err)
 	}
@@ -2,18 +2,41 @@ package users
 
 import (
 	"context"
+	"fmt"
 
 	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/etag"
 	"boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/services/users"
 	"boilerplate/pkg/pb"
 )
 
 func (h *handler) Get(ctx context.Context, req *pb.UserGetRequest) (*pb.UserGetResponse, error) {
-	resp, err := h.usersService.Get(ctx, convert.ToInt(req.GetUserId()))
+	var (
+		resp *users.User
+		err  error
+	)
+	if req.AsOf != nil {
+		resp, err = h.usersService.GetAsOf(ctx, convert.ToInt(req.GetUserId()), req.GetAsOf().AsTime())
+	} else {
+		resp, err = h.usersService.Get(ctx, convert.ToInt(req.GetUserId()))
+	}
 	if err != nil {
 		return nil, grpc.Error(err)
 	}
 
+	if err := grpc.SetETag(ctx, resp.ETag); err != nil {
+		return nil, fmt.Errorf("set etag: %w", err)
+	}
+
+	// Клиент уже получил актуальную версию пользователя
+	if ifNoneMatch, exists := grpc.GetIfNoneMatch(ctx); exists && !etag.NoneMatch(ifNoneMatch, resp.ETag) {
+		if err := grpc.SetNotModified(ctx); err != nil {
+			return nil, fmt.Errorf("set not modified: %w", err)
+		}
+		return &pb.UserGetResponse{}, nil
+	}
+
 	return &pb.UserGetResponse{
 		User: ToUser(resp),
 	}, nil
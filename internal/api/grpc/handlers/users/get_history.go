@@ -0,0 +1,25 @@
+package users
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) GetHistory(ctx context.Context, req *pb.UserGetHistoryRequest) (*pb.UserGetHistoryResponse, error) {
+	resp, err := h.usersService.GetHistory(ctx, convert.ToInt(req.GetUserId()))
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	entries := make([]*pb.UserHistoryEntry, 0, len(resp))
+	for _, entry := range resp {
+		entries = append(entries, ToUserHistoryEntry(entry))
+	}
+
+	return &pb.UserGetHistoryResponse{
+		Entries: entries,
+	}, nil
+}
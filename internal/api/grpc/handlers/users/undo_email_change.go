@@ -0,0 +1,19 @@
+package users
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/grpc"
+	"boilerplate/pkg/pb"
+)
+
+func (h *handler) UndoEmailChange(ctx context.Context, req *pb.UserUndoEmailChangeRequest) (*pb.UserUndoEmailChangeResponse, error) {
+	resp, err := h.usersService.UndoEmailChange(ctx, req.GetToken())
+	if err != nil {
+		return nil, grpc.Error(err)
+	}
+
+	return &pb.UserUndoEmailChangeResponse{
+		User: ToUser(resp),
+	}, nil
+}
@@ -2,6 +2,7 @@ package users
 
 import (
 	"context"
+	"fmt"
 
 	"boilerplate/internal/pkg/convert"
 	"boilerplate/internal/pkg/grpc"
@@ -11,14 +12,27 @@ import (
 
 func (h *handler) Update(ctx context.Context, req *pb.UserUpdateRequest) (*pb.UserUpdateResponse, error) {
 	resp, err := h.usersService.Update(ctx, &users.UserUpdateRequest{
-		ID:       convert.ToInt(req.GetUserId()),
-		Name:     req.Name,
-		Password: req.Password,
+		ID:         convert.ToInt(req.GetUserId()),
+		Name:       req.Name,
+		Password:   req.Password,
+		Attributes: convert.FromStruct(req.GetAttributes()),
+		ETag:       getETag(ctx, req.Etag),
+		UpdateMask: func() []string {
+			if req.GetUpdateMask() == nil {
+				return nil
+			}
+			// Пустая маска означает "ничего не обновлять", а не "все поля"
+			return append([]string{}, req.GetUpdateMask().GetPaths()...)
+		}(),
 	})
 	if err != nil {
 		return nil, grpc.Error(err)
 	}
 
+	if err := grpc.SetETag(ctx, resp.ETag); err != nil {
+		return nil, fmt.Errorf("set etag: %w", err)
+	}
+
 	return &pb.UserUpdateResponse{
 		User: ToUser(resp),
 	}, nil
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -12,13 +13,25 @@ import (
 	"boilerplate/internal/services/auth"
 )
 
-var errUnauthenticated = status.Error(codes.Unauthenticated, "требуется аутентификация")
+var (
+	errUnauthenticated = status.Error(codes.Unauthenticated, "требуется аутентификация")
+	errAdminRequired   = status.Error(codes.PermissionDenied, "требуются права администратора")
+)
 
 // Список публичных методов, не требующих авторизации
 var publicMethods = map[string]bool{
 	"/auth.AuthAPI/Login":    true,
 	"/auth.AuthAPI/Refresh":  true,
 	"/users.UsersAPI/Create": true,
+	// Ссылки из писем открываются без авторизации, доступ дает токен
+	"/users.UsersAPI/ConfirmEmailChange": true,
+	"/users.UsersAPI/UndoEmailChange":    true,
+}
+
+// Список сервисов, доступных только администраторам
+var adminServices = map[string]bool{
+	"broker.BrokerAPI":       true,
+	"scheduler.SchedulerAPI": true,
 }
 
 // nolint:revive
@@ -49,6 +62,10 @@ func (m *middleware) Auth(ctx context.Context, req any, info *grpc.UnaryServerIn
 		return nil, errUnauthenticated
 	}
 
+	if adminServices[serviceName(info.FullMethod)] && !authResp.IsAdmin {
+		return nil, errAdminRequired
+	}
+
 	// Добавляем данные пользователя в контекст
 	if authResp.UserID != nil {
 		ctx = metadata_pkg.WithUserID(ctx, *authResp.UserID)
@@ -68,3 +85,9 @@ func (m *middleware) Auth(ctx context.Context, req any, info *grpc.UnaryServerIn
 
 	return handler(ctx, req)
 }
+
+// serviceName возвращает имя сервиса из полного имени метода /package.Service/Method
+func serviceName(fullMethod string) string {
+	service, _, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	return service
+}
@@ -7,6 +7,7 @@ import (
 
 	logger_pkg "boilerplate/internal/pkg/logger"
 	"boilerplate/internal/services/auth"
+	"boilerplate/internal/services/preferences"
 )
 
 type Middleware interface {
@@ -15,19 +16,23 @@ type Middleware interface {
 	Tracer(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error)
 	Validate(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error)
 	Auth(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error)
+	Preferences(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error)
 }
 
 type middleware struct {
-	logger      logger_pkg.Logger
-	authService auth.Service
+	logger             logger_pkg.Logger
+	authService        auth.Service
+	preferencesService preferences.Service
 }
 
 func NewMiddleware(
 	logger logger_pkg.Logger,
 	authService auth.Service,
+	preferencesService preferences.Service,
 ) Middleware {
 	return &middleware{
-		authService: authService,
-		logger:      logger,
+		authService:        authService,
+		preferencesService: preferencesService,
+		logger:             logger,
 	}
 }
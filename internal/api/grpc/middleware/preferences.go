@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	grpc_pkg "boilerplate/internal/pkg/grpc"
+	"boilerplate/internal/pkg/i18n"
+	metadata_pkg "boilerplate/internal/pkg/metadata"
+	"boilerplate/internal/services/preferences"
+)
+
+// Preferences включает кэш настроек на время запроса и определяет язык ответа:
+// для авторизованного пользователя из его настроек, иначе из Accept-Language
+func (m *middleware) Preferences(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx = preferences.WithCache(ctx)
+
+	locale := i18n.DefaultLocale
+	if header, ok := grpc_pkg.GetAcceptLanguage(ctx); ok {
+		if matched, ok := i18n.MatchAcceptLanguage(header); ok {
+			locale = matched
+		}
+	}
+
+	if userID, ok := metadata_pkg.GetUserID(ctx); ok {
+		prefs, err := m.preferencesService.Get(ctx, userID)
+		if err != nil {
+			m.logger.Warnf(ctx, "get preferences of user %d: %s", userID, err.Error())
+		} else {
+			locale = prefs.Locale
+		}
+	}
+
+	return handler(metadata_pkg.WithLocale(ctx, locale), req)
+}
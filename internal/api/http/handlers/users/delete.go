@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	gin_pkg "boilerplate/internal/pkg/gin"
+	users_service "boilerplate/internal/services/users"
 )
 
 // Delete user
@@ -16,10 +17,12 @@ import (
 //	@Accept			json
 //	@Produce		json
 //	@Success		204
-//	@Failure		400		{object}	model.HandlerError
-//	@Failure		404		{object}	model.HandlerError
-//	@Failure		500		{object}	model.HandlerError
-//	@Param			userid	path		int	true	"user id"
+//	@Failure		400			{object}	model.HandlerError
+//	@Failure		404			{object}	model.HandlerError
+//	@Failure		412			{object}	model.HandlerError
+//	@Failure		500			{object}	model.HandlerError
+//	@Param			userid		path		int		true	"user id"
+//	@Param			If-Match	header		string	false	"user etag"
 //	@Router			/users/{userid} [delete]
 func (h *handler) Delete(ctx *gin.Context) {
 	req := &userRequest{}
@@ -28,7 +31,14 @@ func (h *handler) Delete(ctx *gin.Context) {
 		return
 	}
 
-	err := h.usersService.Delete(ctx, req.UserID)
+	deleteReq := &users_service.UserDeleteRequest{
+		ID: req.UserID,
+	}
+	if ifMatch := ctx.GetHeader("If-Match"); ifMatch != "" {
+		deleteReq.ETag = &ifMatch
+	}
+
+	err := h.usersService.Delete(ctx, deleteReq)
 	if err != nil {
 		gin_pkg.RenderErrorResponse(ctx, err)
 		return
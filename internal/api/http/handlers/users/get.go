@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"boilerplate/internal/pkg/etag"
 	gin_pkg "boilerplate/internal/pkg/gin"
 )
 
@@ -15,11 +16,13 @@ import (
 //	@Tags			UsersAPI
 //	@Accept			json
 //	@Produce		json
-//	@Success		200		{array}		users.User
-//	@Failure		400		{object}	model.HandlerError
-//	@Failure		404		{object}	model.HandlerError
-//	@Failure		500		{object}	model.HandlerError
-//	@Param			userid	path		int	true	"user id"
+//	@Success		200	{array}	users.User
+//	@Success		304
+//	@Failure		400				{object}	model.HandlerError
+//	@Failure		404				{object}	model.HandlerError
+//	@Failure		500				{object}	model.HandlerError
+//	@Param			userid			path		int		true	"user id"
+//	@Param			If-None-Match	header		string	false	"user etag"
 //	@Router			/users/{userid} [get]
 func (h *handler) Get(ctx *gin.Context) {
 	req := &userRequest{}
@@ -34,5 +37,13 @@ func (h *handler) Get(ctx *gin.Context) {
 		return
 	}
 
+	ctx.Header("ETag", user.ETag)
+
+	// Клиент уже получил актуальную версию пользователя
+	if ifNoneMatch := ctx.GetHeader("If-None-Match"); ifNoneMatch != "" && !etag.NoneMatch(ifNoneMatch, user.ETag) {
+		gin_pkg.RenderResponse(ctx, http.StatusNotModified, nil)
+		return
+	}
+
 	gin_pkg.RenderResponse(ctx, http.StatusOK, user)
 }
@@ -1,6 +1,7 @@
 package users
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -16,16 +17,17 @@ import (
 //	@Tags			UsersAPI
 //	@Accept			json
 //	@Produce		json
-//	@Success		200		{object}	users_service.User
-//	@Failure		400		{object}	model.HandlerError
-//	@Failure		404		{object}	model.HandlerError
-//	@Failure		500		{object}	model.HandlerError
-//	@Param			id		query		[]int	false	"user id"
-//	@Param			name	query		string	false	"name"
-//	@Param			email	query		string	false	"email"
-//	@Param			limit	query		int		false	"limit"
-//	@Param			offset	query		int		false	"offset"
-//	@Param			sort	query		string	false	"sort"
+//	@Success		200				{object}	users_service.UserSearchResponse
+//	@Failure		400				{object}	model.HandlerError
+//	@Failure		500				{object}	model.HandlerError
+//	@Param			id				query		[]int		false	"user id"
+//	@Param			name			query		string		false	"name"
+//	@Param			email			query		[]string	false	"email"
+//	@Param			with_deleted	query		bool		false	"include deleted users"
+//	@Param			attributes		query		string		false	"attributes JSON object"
+//	@Param			limit			query		int			false	"limit"
+//	@Param			offset			query		int			false	"offset"
+//	@Param			sort			query		string		false	"sort"
 //	@Router			/users [get]
 func (h *handler) Search(ctx *gin.Context) {
 	req := &users_service.UserSearchRequest{}
@@ -34,6 +36,13 @@ func (h *handler) Search(ctx *gin.Context) {
 		return
 	}
 
+	if attributes := ctx.Query("attributes"); attributes != "" {
+		if err := json.Unmarshal([]byte(attributes), &req.Filter.Attributes); err != nil {
+			gin_pkg.RenderResponse(ctx, http.StatusBadRequest, err)
+			return
+		}
+	}
+
 	users, err := h.usersService.Search(ctx, req)
 	if err != nil {
 		gin_pkg.RenderErrorResponse(ctx, err)
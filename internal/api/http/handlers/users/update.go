@@ -16,11 +16,13 @@ import (
 //	@Tags			UsersAPI
 //	@Accept			json
 //	@Produce		json
-//	@Success		200		{object}	users_service.User
-//	@Failure		400		{object}	model.HandlerError
-//	@Failure		404		{object}	model.HandlerError
-//	@Failure		500		{object}	model.HandlerError
-//	@Param			request	body		users_service.UserUpdateRequest	true	"user data"
+//	@Success		200			{object}	users_service.User
+//	@Failure		400			{object}	model.HandlerError
+//	@Failure		404			{object}	model.HandlerError
+//	@Failure		412			{object}	model.HandlerError
+//	@Failure		500			{object}	model.HandlerError
+//	@Param			request		body		users_service.UserUpdateRequest	true	"user data"
+//	@Param			If-Match	header		string							false	"user etag"
 //	@Router			/users [patch]
 func (h *handler) Update(ctx *gin.Context) {
 	req := &users_service.UserUpdateRequest{}
@@ -33,11 +35,16 @@ func (h *handler) Update(ctx *gin.Context) {
 		return
 	}
 
+	if ifMatch := ctx.GetHeader("If-Match"); req.ETag == nil && ifMatch != "" {
+		req.ETag = &ifMatch
+	}
+
 	user, err := h.usersService.Update(ctx, req)
 	if err != nil {
 		gin_pkg.RenderErrorResponse(ctx, err)
 		return
 	}
 
+	ctx.Header("ETag", user.ETag)
 	gin_pkg.RenderResponse(ctx, http.StatusOK, user)
 }
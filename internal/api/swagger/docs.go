@@ -229,11 +229,27 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "type": "string",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
                         "description": "email",
                         "name": "email",
                         "in": "query"
                     },
+                    {
+                        "type": "boolean",
+                        "description": "include deleted users",
+                        "name": "with_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "attributes JSON object",
+                        "name": "attributes",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "limit",
@@ -257,7 +273,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/users.User"
+                            "$ref": "#/definitions/users.UserSearchResponse"
                         }
                     },
                     "400": {
@@ -266,12 +282,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.HandlerError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/model.HandlerError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -345,6 +355,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/users.UserUpdateRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "user etag",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -366,6 +382,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.HandlerError"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/model.HandlerError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -395,6 +417,12 @@ const docTemplate = `{
                         "name": "userid",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "user etag",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -407,6 +435,9 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -446,6 +477,12 @@ const docTemplate = `{
                         "name": "userid",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "user etag",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -464,6 +501,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.HandlerError"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/model.HandlerError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -541,6 +584,11 @@ const docTemplate = `{
         "users.User": {
             "type": "object",
             "properties": {
+                "attributes": {
+                    "description": "Attributes произвольные атрибуты, соответствующие настроенной JSON Schema",
+                    "type": "object",
+                    "additionalProperties": {}
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -553,6 +601,9 @@ const docTemplate = `{
                 "email": {
                     "type": "string"
                 },
+                "etag": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -573,6 +624,10 @@ const docTemplate = `{
         "users.UserCreateRequest": {
             "type": "object",
             "properties": {
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
                 "email": {
                     "type": "string"
                 },
@@ -584,10 +639,28 @@ const docTemplate = `{
                 }
             }
         },
+        "users.UserSearchResponse": {
+            "type": "object",
+            "properties": {
+                "total": {
+                    "type": "integer"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/users.User"
+                    }
+                }
+            }
+        },
         "users.UserUpdateRequest": {
             "type": "object",
             "properties": {
-                "email": {
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "etag": {
                     "type": "string"
                 },
                 "id": {
@@ -598,6 +671,12 @@ const docTemplate = `{
                 },
                 "password": {
                     "type": "string"
+                },
+                "update_mask": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         }
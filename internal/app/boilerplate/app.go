@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"os"
 	"syscall"
+	"time"
 
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"google.golang.org/grpc"
 
 	grpc_handlers "boilerplate/internal/api/grpc/handlers"
@@ -19,13 +22,19 @@ import (
 	"boilerplate/internal/pkg/clients/s3"
 	closer_pkg "boilerplate/internal/pkg/closer"
 	"boilerplate/internal/pkg/gateway"
+	"boilerplate/internal/pkg/lock"
 	logger_pkg "boilerplate/internal/pkg/logger"
+	"boilerplate/internal/pkg/metadata"
+	"boilerplate/internal/pkg/metrics"
+	"boilerplate/internal/pkg/schema"
+	"boilerplate/internal/pkg/schema_registry"
 	grpc_server "boilerplate/internal/pkg/servers/grpc"
 	http_server "boilerplate/internal/pkg/servers/http"
 	nats_server "boilerplate/internal/pkg/servers/nats"
 	"boilerplate/internal/repository"
 	"boilerplate/internal/service_provider"
 	"boilerplate/internal/topics"
+	workers_pkg "boilerplate/internal/workers"
 	"boilerplate/migrations"
 )
 
@@ -41,6 +50,7 @@ func New(
 	}
 }
 
+// nolint: gocognit, gocyclo, funlen
 func (a *App) Run() error {
 	debug := a.config.LogLevel == logger_pkg.LevelDebug
 
@@ -59,6 +69,22 @@ func (a *App) Run() error {
 
 	closer.Add(logger.Close)
 
+	// Tracing
+	// Спаны брокера и outbox записываются глобальным провайдером, поэтому
+	// trace_id одного события совпадает в логах всех сервисов. Экспортер
+	// спанов подключается опцией sdktrace.WithBatcher
+	tracerProvider := sdktrace.NewTracerProvider()
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(metadata.Propagator)
+
+	closer.Add(func() error {
+		err := tracerProvider.Shutdown(ctx)
+		if err != nil {
+			return fmt.Errorf("shutdown tracer provider: %w", err)
+		}
+		return nil
+	})
+
 	// DB Client
 	dbClient, err := db.New(ctx, logger, a.config.DB.GetDSN())
 	if err != nil {
@@ -77,23 +103,6 @@ func (a *App) Run() error {
 	// Repository
 	repo := repository.NewRepo(dbClient)
 
-	// S3 Client
-	s3Client, err := s3.NewClient(ctx, a.config.S3.Host, a.config.S3.Port, a.config.S3.AccessKey, a.config.S3.SecretKey, a.config.S3.Bucket, s3.WithLogger(logger))
-	if err != nil {
-		closer.CloseAll()
-		return fmt.Errorf("create s3 client: %w", err)
-	}
-	logger.Info(ctx, "s3 client created")
-
-	bucketCreated, err := s3Client.CreateBucket(ctx, a.config.S3.Bucket)
-	if err != nil {
-		closer.CloseAll()
-		return fmt.Errorf("create bucket %s: %w", a.config.S3.Bucket, err)
-	}
-	if bucketCreated {
-		logger.Infof(ctx, "s3 bucket %s created", a.config.S3.Bucket)
-	}
-
 	// Chrome Client
 	chromeClient := chrome.NewClient(a.config.Chrome.Host, a.config.Chrome.Port, a.config.Chrome.Timeout)
 
@@ -145,8 +154,70 @@ func (a *App) Run() error {
 		return nil
 	})
 
+	// S3 Client
+	var s3Client s3.Client
+	if a.config.S3.Backend == model.S3BackendNats {
+		s3Client = s3.NewObjectStoreClient(brokerClient.JetStream(), a.config.S3.Bucket, s3.WithLogger(logger))
+	} else {
+		s3Client, err = s3.NewClient(ctx, a.config.S3.Host, a.config.S3.Port, a.config.S3.AccessKey, a.config.S3.SecretKey, a.config.S3.Bucket, s3.WithLogger(logger))
+		if err != nil {
+			closer.CloseAll()
+			return fmt.Errorf("create s3 client: %w", err)
+		}
+	}
+	logger.InfoKV(ctx, "s3 client created", "backend", a.config.S3.Backend)
+
+	bucketCreated, err := s3Client.CreateBucket(ctx, a.config.S3.Bucket)
+	if err != nil {
+		closer.CloseAll()
+		return fmt.Errorf("create bucket %s: %w", a.config.S3.Bucket, err)
+	}
+	if bucketCreated {
+		logger.Infof(ctx, "s3 bucket %s created", a.config.S3.Bucket)
+	}
+
+	// Users attributes schema
+	attributesValidator, err := schema.LoadValidator(a.config.Users.AttributesSchema)
+	if err != nil {
+		closer.CloseAll()
+		return fmt.Errorf("load users attributes schema: %w", err)
+	}
+
+	// Distributed locks
+	locker, err := lock.NewLocker(ctx, logger, brokerClient.JetStream(), lock.WithTTL(time.Duration(a.config.Lock.TTL)*time.Second))
+	if err != nil {
+		closer.CloseAll()
+		return fmt.Errorf("create locker: %w", err)
+	}
+
+	// Event schemas
+	schemaRegistry, err := schema_registry.NewRegistry(ctx, logger, brokerClient.JetStream())
+	if err != nil {
+		closer.CloseAll()
+		return fmt.Errorf("create schema registry: %w", err)
+	}
+
+	closer.Add(func() error {
+		err := schemaRegistry.Close()
+		if err != nil {
+			return fmt.Errorf("close schema registry: %w", err)
+		}
+		return nil
+	})
+
+	// Сервисы публикуют события, проверенные по схемам топиков
+	eventsClient := schema_registry.NewValidatingClient(brokerClient, schemaRegistry)
+
+	// Metrics
+	metricsRegistry := metrics.NewRegistry()
+	err = metricsRegistry.Register(metrics.NewBrokerCollector(logger, brokerClient))
+	if err != nil {
+		closer.CloseAll()
+		return fmt.Errorf("register broker metrics: %w", err)
+	}
+
 	// Service Provider
-	sp := service_provider.NewProvider(a.config, logger, repo, s3Client, chromeClient, brokerClient)
+	sp := service_provider.NewProvider(a.config, logger, repo, s3Client, chromeClient, eventsClient, locker, schemaRegistry, attributesValidator)
 
 	// Create or update topics
 	err = topics.CreateOrUpdateTopics(ctx, brokerClient)
@@ -155,6 +226,13 @@ func (a *App) Run() error {
 		return fmt.Errorf("create or update topics: %w", err)
 	}
 
+	// Register event schemas
+	err = topics.RegisterSchemas(ctx, schemaRegistry)
+	if err != nil {
+		closer.CloseAll()
+		return fmt.Errorf("register event schemas: %w", err)
+	}
+
 	// Start Consumers
 	consumers := consumers_pkg.NewConsumers(logger, brokerClient, sp)
 
@@ -165,6 +243,27 @@ func (a *App) Run() error {
 	}
 	logger.Info(ctx, "consumers started")
 
+	// Консьюмеры завершают обработку полученных сообщений до закрытия клиента
+	closer.AddDrain(time.Duration(a.config.Nats.DrainTimeout)*time.Second, brokerClient.Drain)
+
+	// Start Workers
+	workers, err := workers_pkg.NewWorkers(logger, a.config, sp)
+	if err != nil {
+		closer.CloseAll()
+		return fmt.Errorf("create workers: %w", err)
+	}
+	workers.Start(ctx)
+	logger.Info(ctx, "workers started")
+
+	closer.Add(func() error {
+		err := workers.Stop()
+		if err != nil {
+			return fmt.Errorf("stop workers: %w", err)
+		}
+		logger.Info(ctx, "workers stopped")
+		return nil
+	})
+
 	// HTTP Server
 	if 1 == 2 {
 		httpServer := http_server.NewServer(a.config.API.Host, a.config.API.HTTPPort, http_handlers.NewHandler(logger, sp))
@@ -189,7 +288,7 @@ func (a *App) Run() error {
 	}
 
 	// GRPC Server
-	grpcMiddleware := grpc_middleware.NewMiddleware(logger, sp.GetAuthService())
+	grpcMiddleware := grpc_middleware.NewMiddleware(logger, sp.GetAuthService(), sp.GetPreferencesService())
 	grpcHandlers := grpc_handlers.NewHandlers(sp)
 
 	grpcServer := grpc_server.NewServer(
@@ -201,6 +300,7 @@ func (a *App) Run() error {
 			grpcMiddleware.Logger,
 			grpcMiddleware.Validate,
 			grpcMiddleware.Auth,
+			grpcMiddleware.Preferences,
 		},
 		grpcHandlers,
 	)
@@ -243,6 +343,25 @@ func (a *App) Run() error {
 		return nil
 	})
 
+	// Metrics Server
+	metricsServer := http_server.NewServer(a.config.API.Host, a.config.API.MetricsPort, metrics.NewHandler(metricsRegistry))
+
+	go func() {
+		logger.Infof(ctx, "metrics server started on port %s", a.config.API.MetricsPort)
+		if err := metricsServer.Start(); err != nil {
+			closer.CloseAll()
+			logger.Errorf(ctx, "start metrics server: %s", err.Error())
+		}
+	}()
+
+	closer.Add(func() error {
+		if err := metricsServer.Stop(ctx); err != nil {
+			return fmt.Errorf("stop metrics server: %w", err)
+		}
+		logger.Info(ctx, "metrics server stopped")
+		return nil
+	})
+
 	logger.Info(ctx, "App is running...")
 
 	return nil
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"boilerplate/internal/consumers/user_created"
+	"boilerplate/internal/consumers/user_export_created"
+	"boilerplate/internal/consumers/user_import_created"
 	"boilerplate/internal/model"
 	logger_pkg "boilerplate/internal/pkg/logger"
 	"boilerplate/internal/service_provider"
@@ -17,7 +19,7 @@ type consumers struct {
 	consumers []model.BrokerConsumer
 }
 
-func NewConsumers(logger logger_pkg.Logger, client model.BrokerClient, _ *service_provider.Provider) *consumers {
+func NewConsumers(logger logger_pkg.Logger, client model.BrokerClient, sp *service_provider.Provider) *consumers {
 	c := &consumers{
 		logger: logger,
 		client: client,
@@ -25,7 +27,15 @@ func NewConsumers(logger logger_pkg.Logger, client model.BrokerClient, _ *servic
 
 	c.consumers = []model.BrokerConsumer{
 		user_created.NewConsumer(
-			logger.With("consumer", "user_created")),
+			logger.With("consumer", "user_created"),
+			sp.GetInboxService(),
+			sp.GetNotificationsService()),
+		user_import_created.NewConsumer(
+			logger.With("consumer", "user_import_created"),
+			sp.GetUserImportsService()),
+		user_export_created.NewConsumer(
+			logger.With("consumer", "user_export_created"),
+			sp.GetUserExportsService()),
 	}
 
 	return c
@@ -38,7 +48,7 @@ func (c *consumers) Start(ctx context.Context) error {
 			return fmt.Errorf("topic %s not found", consumer.MainTopic())
 		}
 
-		err := c.client.Subscribe(ctx, consumer.Name(), consumer.Description(), topic, consumer.HandleMessage)
+		err := c.client.Subscribe(ctx, consumer.Name(), consumer.Description(), topic, consumer.HandleMessage, consumer.SubscribeOptions()...)
 		if err != nil {
 			return fmt.Errorf("subscribe consumer %s: %w", consumer.Name(), err)
 		}
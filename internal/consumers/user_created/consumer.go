@@ -2,24 +2,35 @@ package user_created
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/cloudevents"
+	"boilerplate/internal/pkg/convert"
 	logger_pkg "boilerplate/internal/pkg/logger"
+	"boilerplate/internal/services/inbox"
+	"boilerplate/internal/services/notifications"
 	"boilerplate/internal/topics"
+	"boilerplate/pkg/pb/events"
 )
 
 const (
 	Name        = "user-created-consumer"
-	Description = "Consumer for handling user created events"
+	Description = "Consumer for sending welcome emails to created users"
 )
 
 type consumer struct {
-	logger logger_pkg.Logger
+	logger               logger_pkg.Logger
+	inboxService         inbox.Service
+	notificationsService notifications.Service
 }
 
-func NewConsumer(logger logger_pkg.Logger) model.BrokerConsumer {
+func NewConsumer(logger logger_pkg.Logger, inboxService inbox.Service, notificationsService notifications.Service) model.BrokerConsumer {
 	return &consumer{
-		logger: logger,
+		logger:               logger,
+		inboxService:         inboxService,
+		notificationsService: notificationsService,
 	}
 }
 
@@ -39,6 +50,30 @@ func (c *consumer) DLQTopic() string {
 	return topics.TopicUserCreatedDLQ
 }
 
-func (c *consumer) HandleMessage(_ context.Context, _ string, _ []byte) error {
+func (c *consumer) SubscribeOptions() []model.SubscribeOption {
+	return []model.SubscribeOption{
+		model.WithHandlerTimeout(time.Minute),
+	}
+}
+
+func (c *consumer) HandleMessage(ctx context.Context, _ string, data []byte) error {
+	event, err := cloudevents.Decode[events.UserCreated](ctx, data)
+	if err != nil {
+		return model.ErrPermanent(fmt.Errorf("decode event: %w", err))
+	}
+
+	userID := convert.ToInt(event.GetUserId())
+
+	processed, err := c.inboxService.Handle(ctx, Name, func(ctx context.Context) error {
+		c.logger.InfoKV(ctx, "sending welcome email", "user_id", userID)
+		return c.notificationsService.SendWelcome(ctx, userID)
+	})
+	if err != nil {
+		return fmt.Errorf("send welcome to user %d: %w", userID, err)
+	}
+	if !processed {
+		c.logger.DebugKV(ctx, "message already processed", "user_id", userID)
+	}
+
 	return nil
 }
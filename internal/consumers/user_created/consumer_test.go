@@ -0,0 +1,82 @@
+package user_created_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/consumers/user_created"
+	mail_mocks "boilerplate/internal/pkg/clients/mail/mocks"
+	"boilerplate/internal/pkg/convert"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/topics"
+	"boilerplate/pkg/pb/events"
+)
+
+func TestHandleMessage(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	ctx := sp.Context()
+	client := sp.GetNatsClient()
+	require.NoError(t, topics.CreateOrUpdateTopics(ctx, client))
+
+	mailClient, ok := sp.GetMailClient().(*mail_mocks.Client)
+	require.True(t, ok)
+	mailClient.EXPECT().
+		Send(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(ctx, user)
+	require.NoError(t, err)
+
+	consumer := user_created.NewConsumer(sp.GetLogger(), sp.GetInboxService(), sp.GetNotificationsService())
+
+	topic := topics.Topics[consumer.MainTopic()]
+	topic.Retry.InitialDelay = 10 * time.Millisecond
+
+	var calls atomic.Int32
+	handled := make(chan error, 2)
+	err = client.Subscribe(ctx, consumer.Name(), consumer.Description(), topic,
+		func(ctx context.Context, subject string, data []byte) error {
+			err := consumer.HandleMessage(ctx, subject, data)
+			handled <- err
+			// Первая обработка завершается, но подтверждение теряется, и
+			// сообщение доставляется повторно
+			if calls.Add(1) == 1 && err == nil {
+				return errors.New("ack lost")
+			}
+			return err
+		})
+	require.NoError(t, err)
+
+	key := strconv.Itoa(user.ID)
+	err = client.Publish(ctx, topics.TopicUserCreated, nil, key, &events.UserCreated{
+		UserId: convert.ToInt64(user.ID),
+		Name:   user.Name,
+		Email:  user.Email,
+	})
+	require.NoError(t, err)
+
+	for range 2 {
+		select {
+		case err := <-handled:
+			require.NoError(t, err)
+		case <-time.After(10 * time.Second):
+			require.FailNow(t, "message not handled")
+		}
+	}
+
+	mailClient.AssertNumberOfCalls(t, "Send", 1)
+	mailClient.AssertCalled(t, "Send", mock.Anything, user.Email, "Добро пожаловать!", mock.Anything, mock.Anything)
+}
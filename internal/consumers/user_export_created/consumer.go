@@ -0,0 +1,71 @@
+package user_export_created
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/cloudevents"
+	logger_pkg "boilerplate/internal/pkg/logger"
+	"boilerplate/internal/services/user_exports"
+	"boilerplate/internal/topics"
+)
+
+const (
+	Name        = "user-export-created-consumer"
+	Description = "Consumer for processing user exports"
+)
+
+type consumer struct {
+	logger             logger_pkg.Logger
+	userExportsService user_exports.Service
+}
+
+func NewConsumer(logger logger_pkg.Logger, userExportsService user_exports.Service) model.BrokerConsumer {
+	return &consumer{
+		logger:             logger,
+		userExportsService: userExportsService,
+	}
+}
+
+func (c *consumer) Name() string {
+	return Name
+}
+
+func (c *consumer) Description() string {
+	return Description
+}
+
+func (c *consumer) MainTopic() string {
+	return topics.TopicUserExportCreated
+}
+
+func (c *consumer) DLQTopic() string {
+	return topics.TopicUserExportCreatedDLQ
+}
+
+// SubscribeOptions экспорты обрабатываются долго, поэтому экземпляр приложения
+// не берет больше сообщений, чем может обработать одновременно
+func (c *consumer) SubscribeOptions() []model.SubscribeOption {
+	return []model.SubscribeOption{
+		model.WithWorkers(2),
+		model.WithBatchSize(2),
+		model.WithHandlerTimeout(30 * time.Minute),
+	}
+}
+
+func (c *consumer) HandleMessage(ctx context.Context, _ string, data []byte) error {
+	event, err := cloudevents.Decode[model.UserExportCreatedEvent](ctx, data)
+	if err != nil {
+		return model.ErrPermanent(fmt.Errorf("decode event: %w", err))
+	}
+
+	c.logger.InfoKV(ctx, "processing user export", "export_id", event.ExportID)
+
+	if err := c.userExportsService.Process(ctx, event.ExportID); err != nil {
+		return fmt.Errorf("process user export %d: %w", event.ExportID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package user_import_created
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/cloudevents"
+	logger_pkg "boilerplate/internal/pkg/logger"
+	"boilerplate/internal/services/user_imports"
+	"boilerplate/internal/topics"
+)
+
+const (
+	Name        = "user-import-created-consumer"
+	Description = "Consumer for processing user imports"
+)
+
+type consumer struct {
+	logger             logger_pkg.Logger
+	userImportsService user_imports.Service
+}
+
+func NewConsumer(logger logger_pkg.Logger, userImportsService user_imports.Service) model.BrokerConsumer {
+	return &consumer{
+		logger:             logger,
+		userImportsService: userImportsService,
+	}
+}
+
+func (c *consumer) Name() string {
+	return Name
+}
+
+func (c *consumer) Description() string {
+	return Description
+}
+
+func (c *consumer) MainTopic() string {
+	return topics.TopicUserImportCreated
+}
+
+func (c *consumer) DLQTopic() string {
+	return topics.TopicUserImportCreatedDLQ
+}
+
+// SubscribeOptions импорты обрабатываются долго, поэтому экземпляр приложения
+// не берет больше сообщений, чем может обработать одновременно
+func (c *consumer) SubscribeOptions() []model.SubscribeOption {
+	return []model.SubscribeOption{
+		model.WithWorkers(2),
+		model.WithBatchSize(2),
+		model.WithHandlerTimeout(30 * time.Minute),
+	}
+}
+
+func (c *consumer) HandleMessage(ctx context.Context, _ string, data []byte) error {
+	event, err := cloudevents.Decode[model.UserImportCreatedEvent](ctx, data)
+	if err != nil {
+		return model.ErrPermanent(fmt.Errorf("decode event: %w", err))
+	}
+
+	c.logger.InfoKV(ctx, "processing user import", "import_id", event.ImportID)
+
+	if err := c.userImportsService.Process(ctx, event.ImportID); err != nil {
+		return fmt.Errorf("process user import %d: %w", event.ImportID, err)
+	}
+
+	return nil
+}
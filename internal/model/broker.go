@@ -2,10 +2,49 @@ package model
 
 import (
 	"context"
+	"errors"
+	"hash/fnv"
 	"net"
 	"time"
 )
 
+// Заголовки, которые клиент добавляет к сообщению при перемещении в DLQ
+const (
+	// BrokerHeaderDLQSubject исходный subject сообщения
+	BrokerHeaderDLQSubject = "X-DLQ-Subject"
+	// BrokerHeaderDLQConsumer консьюмер, который не смог обработать сообщение
+	BrokerHeaderDLQConsumer = "X-DLQ-Consumer"
+	// BrokerHeaderDLQError ошибка последней попытки обработки
+	BrokerHeaderDLQError = "X-DLQ-Error"
+	// BrokerHeaderDLQAttempts количество попыток обработки
+	BrokerHeaderDLQAttempts = "X-DLQ-Attempts"
+	// BrokerHeaderDLQFailedAt момент перемещения в DLQ в формате RFC 3339
+	BrokerHeaderDLQFailedAt = "X-DLQ-Failed-At"
+)
+
+// ErrBrokerMessageNotFound сообщение с указанным номером отсутствует в топике
+var ErrBrokerMessageNotFound = errors.New("broker message not found")
+
+// ErrBrokerNoResponders на subject запроса не зарегистрирован ни один обработчик
+var ErrBrokerNoResponders = errors.New("broker no responders")
+
+// Коды BrokerRequestError
+const (
+	BrokerRequestErrorInternal    = "internal"
+	BrokerRequestErrorUnavailable = "unavailable"
+)
+
+// BrokerRequestError ошибка обработчика запроса, которая не соответствует
+// ошибкам пакета errors
+type BrokerRequestError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *BrokerRequestError) Error() string {
+	return e.Code + ": " + e.Message
+}
+
 type BrokerServer interface {
 	Start() error
 	Stop() error
@@ -13,29 +52,219 @@ type BrokerServer interface {
 }
 
 type BrokerClient interface {
-	Publish(ctx context.Context, topic string, partition *int, key, data any) error
-	Subscribe(ctx context.Context, consumerName, description string, topic BrokerTopic, handler BrokerHandler) error
+	// Publish отправляет data в формате CloudEvents: proto.Message кодируется в
+	// protobuf, остальные значения - в JSON. Если partition не задана, партиция
+	// топика выбирается по ключу через BrokerPartition
+	Publish(ctx context.Context, topic string, partition *int, key, data any, opts ...PublishOption) error
+	// Subscribe создает консьюмера для каждой партиции топика и обрабатывает
+	// сообщения, пока клиент не будет остановлен
+	Subscribe(ctx context.Context, consumerName, description string, topic BrokerTopic, handler BrokerHandler, opts ...SubscribeOption) error
 	CreateOrUpdateTopic(ctx context.Context, topic BrokerTopic) error
+	// GetMessages возвращает до limit сохраненных сообщений топика с номерами
+	// не меньше from в порядке номеров
+	GetMessages(ctx context.Context, topic string, from uint64, limit int) ([]*BrokerMessage, error)
+	// GetMessage возвращает сообщение топика по номеру или ErrBrokerMessageNotFound
+	GetMessage(ctx context.Context, topic string, id uint64) (*BrokerMessage, error)
+	// PublishMessage публикует сохраненное сообщение в его subject с теми же
+	// заголовками и данными
+	PublishMessage(ctx context.Context, message *BrokerMessage) error
+	DeleteMessage(ctx context.Context, topic string, id uint64) error
+	// PurgeTopic удаляет все сообщения топика и возвращает их количество
+	PurgeTopic(ctx context.Context, topic string) (int, error)
+	// GetStreamStats возвращает состояние топиков, созданных клиентом через
+	// CreateOrUpdateTopic
+	GetStreamStats(ctx context.Context) ([]*BrokerStreamStats, error)
+	// GetConsumerStats возвращает состояние консьюмеров партиций, созданных
+	// клиентом через Subscribe
+	GetConsumerStats(ctx context.Context) ([]*BrokerConsumerStats, error)
+	// Request отправляет req обработчику subject и декодирует его ответ в resp.
+	// req кодируется так же, как data в Publish. Без дедлайна в ctx запрос
+	// ограничен временем по умолчанию
+	Request(ctx context.Context, subject string, req, resp any) error
+	// Respond регистрирует обработчик запросов subject. Экземпляры приложения
+	// с тем же источником событий получают запросы по очереди
+	Respond(subject string, handler BrokerRequestHandler) error
+	// Drain прекращает получение новых сообщений и запросов и ждет завершения
+	// обрабатываемых, пока не истечет ctx
+	Drain(ctx context.Context) error
 	Close() error
 }
 
 type BrokerHandler func(ctx context.Context, subject string, data []byte) error
 
+// BrokerRequestHandler обработчик запроса. Ответ кодируется так же, как data в
+// Publish
+type BrokerRequestHandler func(ctx context.Context, subject string, data []byte) (any, error)
+
 type BrokerConsumer interface {
 	Name() string
 	Description() string
 	MainTopic() string
 	DLQTopic() string
+	SubscribeOptions() []SubscribeOption
 	HandleMessage(ctx context.Context, subject string, data []byte) error
 }
 
 type BrokerTopic struct {
-	Name         string
-	Description  string
-	Partitions   int
-	MaxAge       time.Duration
-	MaxBytes     int64
-	Retries      int
-	RetriesDelay time.Duration
+	Name        string
+	Description string
+	// Partitions количество партиций. Сообщения одной партиции обрабатываются
+	// консьюмером строго по очереди, разные партиции - параллельно
+	Partitions int
+	MaxAge     time.Duration
+	MaxBytes   int64
+	// Retry политика повторной обработки сообщений, которые консьюмер не смог
+	// обработать
+	Retry        RetryPolicy
 	DLQTopicName string
+	// DuplicateWindow время, в течение которого сервер отбрасывает повторно
+	// опубликованные сообщения с тем же ID события. По умолчанию 2 минуты
+	DuplicateWindow time.Duration
+}
+
+// BrokerPartition возвращает партицию для ключа сообщения, чтобы сообщения с
+// одним ключом попадали в одну партицию. Для топика без партиций возвращает nil
+func BrokerPartition(key string, partitions int) *int {
+	if partitions <= 0 {
+		return nil
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	partition := int(h.Sum32() % uint32(partitions))
+
+	return &partition
+}
+
+// BrokerMessage сообщение, сохраненное в топике
+type BrokerMessage struct {
+	// ID порядковый номер сообщения в топике
+	ID      uint64
+	Subject string
+	Headers map[string][]string
+	Data    []byte
+	Time    time.Time
+}
+
+// Header возвращает первое значение заголовка
+func (m *BrokerMessage) Header(name string) string {
+	if values := m.Headers[name]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// PublishOptions атрибуты CloudEvents публикуемого сообщения. Незаданные
+// атрибуты заполняются клиентом
+type PublishOptions struct {
+	// ID уникальный в пределах источника идентификатор события. Используется
+	// брокером для дедупликации повторных публикаций
+	ID string
+	// Type тип события. По умолчанию полное имя proto-сообщения или имя топика
+	Type string
+	// Time момент возникновения события
+	Time time.Time
+}
+
+type PublishOption func(*PublishOptions)
+
+func WithEventID(id string) PublishOption {
+	return func(o *PublishOptions) {
+		o.ID = id
+	}
+}
+
+func WithEventType(eventType string) PublishOption {
+	return func(o *PublishOptions) {
+		o.Type = eventType
+	}
+}
+
+func WithEventTime(t time.Time) PublishOption {
+	return func(o *PublishOptions) {
+		o.Time = t
+	}
+}
+
+// SubscribeOptions параметры обработки сообщений консьюмером. Применяются к
+// консьюмеру каждой партиции топика отдельно
+type SubscribeOptions struct {
+	// Workers количество сообщений, обрабатываемых одновременно. По умолчанию 1
+	Workers int
+	// MaxAckPending количество доставленных, но не подтвержденных сообщений.
+	// По умолчанию 1 для топика с партициями, чтобы сохранить порядок
+	// обработки, и значение сервера для остальных топиков
+	MaxAckPending int
+	// BatchSize количество сообщений, запрашиваемых у сервера за один раз
+	BatchSize int
+	// HandlerTimeout время, после которого отменяется контекст обработчика.
+	// По умолчанию не ограничено
+	HandlerTimeout time.Duration
+	// AckWait время, после которого неподтвержденное сообщение доставляется
+	// повторно. Пока обработчик работает, клиент продлевает его, отправляя
+	// серверу сигнал о ходе обработки
+	AckWait time.Duration
+}
+
+type SubscribeOption func(*SubscribeOptions)
+
+func WithWorkers(workers int) SubscribeOption {
+	return func(o *SubscribeOptions) {
+		o.Workers = workers
+	}
+}
+
+func WithMaxAckPending(maxAckPending int) SubscribeOption {
+	return func(o *SubscribeOptions) {
+		o.MaxAckPending = maxAckPending
+	}
+}
+
+func WithBatchSize(batchSize int) SubscribeOption {
+	return func(o *SubscribeOptions) {
+		o.BatchSize = batchSize
+	}
+}
+
+func WithHandlerTimeout(timeout time.Duration) SubscribeOption {
+	return func(o *SubscribeOptions) {
+		o.HandlerTimeout = timeout
+	}
+}
+
+func WithAckWait(ackWait time.Duration) SubscribeOption {
+	return func(o *SubscribeOptions) {
+		o.AckWait = ackWait
+	}
+}
+
+// BrokerStreamStats состояние хранилища сообщений топика
+type BrokerStreamStats struct {
+	Topic    string
+	Messages uint64
+	Bytes    uint64
+	// FirstID и LastID номера первого и последнего сохраненных сообщений
+	FirstID uint64
+	LastID  uint64
+	// LastTime время последнего сообщения, nil, если топик пуст
+	LastTime  *time.Time
+	Consumers int
+}
+
+// BrokerConsumerStats состояние консьюмера одной партиции топика
+type BrokerConsumerStats struct {
+	Topic string
+	// Consumer имя консьюмера, переданное в Subscribe
+	Consumer string
+	// Name имя консьюмера партиции на сервере
+	Name    string
+	Subject string
+	// Pending сообщения, которые еще не доставлены консьюмеру
+	Pending uint64
+	// AckPending доставленные сообщения, обработка которых не подтверждена
+	AckPending int
+	// Redelivered сообщения, которые доставлены повторно и еще не подтверждены
+	Redelivered int
+	// LastActive время последней доставки сообщения, nil, если доставок не было
+	LastActive *time.Time
 }
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy политика повторной обработки сообщений топика. Задержка перед
+// повторной попыткой растет в Multiplier раз с каждой попыткой, начиная с
+// InitialDelay, но не превышает MaxDelay
+type RetryPolicy struct {
+	// MaxAttempts количество попыток обработки сообщения, после которого оно
+	// перемещается в DLQ. При 0 сообщение не обрабатывается повторно
+	MaxAttempts  int
+	InitialDelay time.Duration
+	// Multiplier множитель задержки. Значение меньше 1 оставляет задержку
+	// постоянной
+	Multiplier float64
+	// MaxDelay максимальная задержка. При 0 не ограничена
+	MaxDelay time.Duration
+	// Jitter доля задержки от 0 до 1, на которую она случайно увеличивается или
+	// уменьшается, чтобы повторы разных сообщений не совпадали по времени
+	Jitter float64
+}
+
+// Delay возвращает задержку перед повторной обработкой после неудачной
+// попытки attempt, начиная с 1
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	delay := float64(p.InitialDelay)
+	for i := 1; i < attempt && p.Multiplier > 1; i++ {
+		delay *= p.Multiplier
+		if p.MaxDelay > 0 && delay >= float64(p.MaxDelay) {
+			break
+		}
+	}
+
+	if p.MaxDelay > 0 {
+		delay = min(delay, float64(p.MaxDelay))
+	}
+
+	if p.Jitter > 0 {
+		delay += delay * min(p.Jitter, 1) * (2*rand.Float64() - 1) // nolint: gosec
+	}
+
+	return time.Duration(delay)
+}
+
+// permanentError ошибка, повторная обработка после которой не имеет смысла
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// ErrPermanent помечает ошибку обработчика сообщения как постоянную: сообщение
+// сразу перемещается в DLQ без повторных попыток
+func ErrPermanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsErrPermanent проверяет, что ошибка помечена как постоянная
+func IsErrPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+// retryAfterError ошибка, после которой обработчик просит повторить обработку
+// через заданное время
+type retryAfterError struct {
+	delay time.Duration
+}
+
+func (e *retryAfterError) Error() string {
+	return fmt.Sprintf("retry after %s", e.delay)
+}
+
+// ErrRetryAfter возвращает ошибку обработчика сообщения, после которой
+// сообщение обрабатывается повторно через d вместо задержки политики топика.
+// Попытка учитывается в RetryPolicy.MaxAttempts
+func ErrRetryAfter(d time.Duration) error {
+	return &retryAfterError{delay: d}
+}
+
+// RetryAfter возвращает задержку, заданную ErrRetryAfter
+func RetryAfter(err error) (time.Duration, bool) {
+	var retryAfter *retryAfterError
+	if errors.As(err, &retryAfter) {
+		return retryAfter.delay, true
+	}
+	return 0, false
+}
@@ -10,6 +10,10 @@ type Config struct {
 	Chrome   ConfigChrome `yaml:"chrome" json:"chrome" mapstructure:"chrome" validate:"required"`
 	Nats     ConfigNats   `yaml:"nats" json:"nats" mapstructure:"nats" validate:"required"`
 	Mail     ConfigMail   `yaml:"mail" json:"mail" mapstructure:"mail" validate:"required"`
+	Users    ConfigUsers  `yaml:"users" json:"users" mapstructure:"users"`
+	Outbox   ConfigOutbox `yaml:"outbox" json:"outbox" mapstructure:"outbox"`
+	Inbox    ConfigInbox  `yaml:"inbox" json:"inbox" mapstructure:"inbox"`
+	Lock     ConfigLock   `yaml:"lock" json:"lock" mapstructure:"lock"`
 }
 
 type ConfigDB struct {
@@ -22,15 +26,29 @@ type ConfigDB struct {
 }
 
 type ConfigAPI struct {
-	Host             string `yaml:"host" json:"host" mapstructure:"host" validate:"required"`
-	HTTPPort         string `yaml:"http-port" json:"http-port" mapstructure:"http-port" validate:"required"`
-	GRPCPort         string `yaml:"grpc-port" json:"grpc-port" mapstructure:"grpc-port" validate:"required"`
+	Host     string `yaml:"host" json:"host" mapstructure:"host" validate:"required"`
+	HTTPPort string `yaml:"http-port" json:"http-port" mapstructure:"http-port" validate:"required"`
+	GRPCPort string `yaml:"grpc-port" json:"grpc-port" mapstructure:"grpc-port" validate:"required"`
+	// MetricsPort порт внутреннего HTTP сервера с метриками Prometheus, который
+	// не должен быть доступен извне
+	MetricsPort      string `yaml:"metrics-port" json:"metrics-port" mapstructure:"metrics-port" validate:"required"`
 	AccessPrivateKey string `yaml:"access-private-key" json:"access-private-key" mapstructure:"access-private-key" validate:"required"`
 	AccessTokenTTL   int    `yaml:"access-token-ttl" json:"token-ttl" mapstructure:"access-token-ttl" validate:"required"`
 	RefreshTokenTTL  int    `yaml:"refresh-token-ttl" json:"refresh-token-ttl" mapstructure:"refresh-token-ttl" validate:"required"`
 }
 
+// Бэкенды файлового хранилища
+const (
+	// S3BackendS3 S3-совместимый сервер, например MinIO
+	S3BackendS3 = "s3"
+	// S3BackendNats Object Store встроенного сервера NATS
+	S3BackendNats = "nats"
+)
+
 type ConfigS3 struct {
+	// Backend бэкенд файлового хранилища: s3 или nats. Для nats используется
+	// только Bucket
+	Backend   string `yaml:"backend" json:"backend" mapstructure:"backend" validate:"required,oneof=s3 nats"`
 	Host      string `yaml:"host" json:"host" mapstructure:"host" validate:"required"`
 	Port      string `yaml:"port" json:"port" mapstructure:"port" validate:"required"`
 	AccessKey string `yaml:"access-key" json:"access-key" mapstructure:"access-key" validate:"required"`
@@ -50,6 +68,9 @@ type ConfigNats struct {
 	HTTPPort string `yaml:"http-port" json:"http-port" mapstructure:"http-port" validate:"required"`
 	Domain   string `yaml:"domain" json:"domain" mapstructure:"domain" validate:"required"`
 	DataDir  string `yaml:"data-dir" json:"data-dir" mapstructure:"data-dir" validate:"required"`
+	// DrainTimeout время в секундах, в течение которого при остановке
+	// приложения консьюмеры завершают обработку полученных сообщений
+	DrainTimeout int `yaml:"drain-timeout" json:"drain-timeout" mapstructure:"drain-timeout" validate:"required"`
 }
 
 type ConfigMail struct {
@@ -62,6 +83,54 @@ type ConfigMail struct {
 	From     string `yaml:"from" json:"from" mapstructure:"from" validate:"required,email"`
 }
 
+type ConfigUsers struct {
+	// AttributesSchema путь к JSON Schema атрибутов пользователя. Если не
+	// указан, допускается любой JSON-объект
+	AttributesSchema string `yaml:"attributes-schema" json:"attributes-schema" mapstructure:"attributes-schema"`
+	// EmailConfirmURL адрес страницы подтверждения нового email, к нему
+	// добавляется параметр token
+	EmailConfirmURL string `yaml:"email-confirm-url" json:"email-confirm-url" mapstructure:"email-confirm-url" validate:"required,url"`
+	// EmailUndoURL адрес страницы отмены смены email, к нему добавляется
+	// параметр token
+	EmailUndoURL string `yaml:"email-undo-url" json:"email-undo-url" mapstructure:"email-undo-url" validate:"required,url"`
+	// EmailChangeTTL время в секундах, в течение которого можно подтвердить новый email
+	EmailChangeTTL int `yaml:"email-change-ttl" json:"email-change-ttl" mapstructure:"email-change-ttl" validate:"required"`
+	// EmailUndoTTL время в секундах с момента запроса, в течение которого
+	// владелец прежнего email может отменить смену
+	EmailUndoTTL int `yaml:"email-undo-ttl" json:"email-undo-ttl" mapstructure:"email-undo-ttl" validate:"required"`
+}
+
+type ConfigOutbox struct {
+	// PollInterval интервал в миллисекундах между проверками новых сообщений
+	PollInterval int `yaml:"poll-interval" json:"poll-interval" mapstructure:"poll-interval" validate:"required"`
+	// BatchSize количество сообщений, публикуемых за одну транзакцию
+	BatchSize int `yaml:"batch-size" json:"batch-size" mapstructure:"batch-size" validate:"required"`
+	// MaxAttempts количество попыток публикации, после которого сообщение
+	// помечается неудачным и больше не задерживает события агрегата
+	MaxAttempts int `yaml:"max-attempts" json:"max-attempts" mapstructure:"max-attempts" validate:"required"`
+	// RetryDelay задержка в секундах перед повторной публикацией, удваивается с
+	// каждой попыткой
+	RetryDelay int `yaml:"retry-delay" json:"retry-delay" mapstructure:"retry-delay" validate:"required"`
+	// MaxRetryDelay максимальная задержка в секундах перед повторной публикацией
+	MaxRetryDelay int `yaml:"max-retry-delay" json:"max-retry-delay" mapstructure:"max-retry-delay" validate:"required"`
+	// Retention время в секундах, в течение которого хранятся опубликованные сообщения
+	Retention int `yaml:"retention" json:"retention" mapstructure:"retention" validate:"required"`
+}
+
+type ConfigInbox struct {
+	// Retention время в секундах, в течение которого хранятся отметки об
+	// обработанных сообщениях. Сообщение, доставленное повторно позже, будет
+	// обработано снова
+	Retention int `yaml:"retention" json:"retention" mapstructure:"retention" validate:"required"`
+}
+
+type ConfigLock struct {
+	// TTL время аренды распределенной блокировки в секундах. Если экземпляр
+	// приложения остановился аварийно, другой станет лидером не позже чем
+	// через TTL
+	TTL int `yaml:"ttl" json:"ttl" mapstructure:"ttl" validate:"required"`
+}
+
 func (c ConfigDB) GetDSN() string {
 	sslMode := "disable"
 	if c.SslMode {
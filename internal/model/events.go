@@ -0,0 +1,17 @@
+package model
+
+type UserImportCreatedEvent struct {
+	ImportID int `json:"import_id"`
+}
+
+type UserExportCreatedEvent struct {
+	ExportID int `json:"export_id"`
+}
+
+// GroupMembersChangedEvent пользователи добавлены в группу или исключены из нее
+type GroupMembersChangedEvent struct {
+	GroupID   int                `json:"group_id"`
+	Action    GroupMembersAction `json:"action"`
+	UserIDs   []int              `json:"user_ids"`
+	ChangedBy *int               `json:"changed_by,omitempty"`
+}
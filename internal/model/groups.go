@@ -0,0 +1,8 @@
+package model
+
+type GroupMembersAction string
+
+const (
+	GroupMembersActionAdded   GroupMembersAction = "added"
+	GroupMembersActionRemoved GroupMembersAction = "removed"
+)
@@ -114,17 +114,355 @@ func (_c *BrokerClient_CreateOrUpdateTopic_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
-// Publish provides a mock function with given fields: ctx, topic, partition, key, data
-func (_m *BrokerClient) Publish(ctx context.Context, topic string, partition *int, key any, data any) error {
-	ret := _m.Called(ctx, topic, partition, key, data)
+// DeleteMessage provides a mock function with given fields: ctx, topic, id
+func (_m *BrokerClient) DeleteMessage(ctx context.Context, topic string, id uint64) error {
+	ret := _m.Called(ctx, topic, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) error); ok {
+		r0 = rf(ctx, topic, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BrokerClient_DeleteMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessage'
+type BrokerClient_DeleteMessage_Call struct {
+	*mock.Call
+}
+
+// DeleteMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - topic string
+//   - id uint64
+func (_e *BrokerClient_Expecter) DeleteMessage(ctx interface{}, topic interface{}, id interface{}) *BrokerClient_DeleteMessage_Call {
+	return &BrokerClient_DeleteMessage_Call{Call: _e.mock.On("DeleteMessage", ctx, topic, id)}
+}
+
+func (_c *BrokerClient_DeleteMessage_Call) Run(run func(ctx context.Context, topic string, id uint64)) *BrokerClient_DeleteMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *BrokerClient_DeleteMessage_Call) Return(_a0 error) *BrokerClient_DeleteMessage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BrokerClient_DeleteMessage_Call) RunAndReturn(run func(context.Context, string, uint64) error) *BrokerClient_DeleteMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Drain provides a mock function with given fields: ctx
+func (_m *BrokerClient) Drain(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Drain")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BrokerClient_Drain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Drain'
+type BrokerClient_Drain_Call struct {
+	*mock.Call
+}
+
+// Drain is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *BrokerClient_Expecter) Drain(ctx interface{}) *BrokerClient_Drain_Call {
+	return &BrokerClient_Drain_Call{Call: _e.mock.On("Drain", ctx)}
+}
+
+func (_c *BrokerClient_Drain_Call) Run(run func(ctx context.Context)) *BrokerClient_Drain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *BrokerClient_Drain_Call) Return(_a0 error) *BrokerClient_Drain_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BrokerClient_Drain_Call) RunAndReturn(run func(context.Context) error) *BrokerClient_Drain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConsumerStats provides a mock function with given fields: ctx
+func (_m *BrokerClient) GetConsumerStats(ctx context.Context) ([]*model.BrokerConsumerStats, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConsumerStats")
+	}
+
+	var r0 []*model.BrokerConsumerStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.BrokerConsumerStats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.BrokerConsumerStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BrokerConsumerStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BrokerClient_GetConsumerStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConsumerStats'
+type BrokerClient_GetConsumerStats_Call struct {
+	*mock.Call
+}
+
+// GetConsumerStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *BrokerClient_Expecter) GetConsumerStats(ctx interface{}) *BrokerClient_GetConsumerStats_Call {
+	return &BrokerClient_GetConsumerStats_Call{Call: _e.mock.On("GetConsumerStats", ctx)}
+}
+
+func (_c *BrokerClient_GetConsumerStats_Call) Run(run func(ctx context.Context)) *BrokerClient_GetConsumerStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *BrokerClient_GetConsumerStats_Call) Return(_a0 []*model.BrokerConsumerStats, _a1 error) *BrokerClient_GetConsumerStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BrokerClient_GetConsumerStats_Call) RunAndReturn(run func(context.Context) ([]*model.BrokerConsumerStats, error)) *BrokerClient_GetConsumerStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMessage provides a mock function with given fields: ctx, topic, id
+func (_m *BrokerClient) GetMessage(ctx context.Context, topic string, id uint64) (*model.BrokerMessage, error) {
+	ret := _m.Called(ctx, topic, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMessage")
+	}
+
+	var r0 *model.BrokerMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) (*model.BrokerMessage, error)); ok {
+		return rf(ctx, topic, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) *model.BrokerMessage); ok {
+		r0 = rf(ctx, topic, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BrokerMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64) error); ok {
+		r1 = rf(ctx, topic, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BrokerClient_GetMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMessage'
+type BrokerClient_GetMessage_Call struct {
+	*mock.Call
+}
+
+// GetMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - topic string
+//   - id uint64
+func (_e *BrokerClient_Expecter) GetMessage(ctx interface{}, topic interface{}, id interface{}) *BrokerClient_GetMessage_Call {
+	return &BrokerClient_GetMessage_Call{Call: _e.mock.On("GetMessage", ctx, topic, id)}
+}
+
+func (_c *BrokerClient_GetMessage_Call) Run(run func(ctx context.Context, topic string, id uint64)) *BrokerClient_GetMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *BrokerClient_GetMessage_Call) Return(_a0 *model.BrokerMessage, _a1 error) *BrokerClient_GetMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BrokerClient_GetMessage_Call) RunAndReturn(run func(context.Context, string, uint64) (*model.BrokerMessage, error)) *BrokerClient_GetMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMessages provides a mock function with given fields: ctx, topic, from, limit
+func (_m *BrokerClient) GetMessages(ctx context.Context, topic string, from uint64, limit int) ([]*model.BrokerMessage, error) {
+	ret := _m.Called(ctx, topic, from, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMessages")
+	}
+
+	var r0 []*model.BrokerMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, int) ([]*model.BrokerMessage, error)); ok {
+		return rf(ctx, topic, from, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, int) []*model.BrokerMessage); ok {
+		r0 = rf(ctx, topic, from, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BrokerMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, int) error); ok {
+		r1 = rf(ctx, topic, from, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BrokerClient_GetMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMessages'
+type BrokerClient_GetMessages_Call struct {
+	*mock.Call
+}
+
+// GetMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - topic string
+//   - from uint64
+//   - limit int
+func (_e *BrokerClient_Expecter) GetMessages(ctx interface{}, topic interface{}, from interface{}, limit interface{}) *BrokerClient_GetMessages_Call {
+	return &BrokerClient_GetMessages_Call{Call: _e.mock.On("GetMessages", ctx, topic, from, limit)}
+}
+
+func (_c *BrokerClient_GetMessages_Call) Run(run func(ctx context.Context, topic string, from uint64, limit int)) *BrokerClient_GetMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uint64), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *BrokerClient_GetMessages_Call) Return(_a0 []*model.BrokerMessage, _a1 error) *BrokerClient_GetMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BrokerClient_GetMessages_Call) RunAndReturn(run func(context.Context, string, uint64, int) ([]*model.BrokerMessage, error)) *BrokerClient_GetMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStreamStats provides a mock function with given fields: ctx
+func (_m *BrokerClient) GetStreamStats(ctx context.Context) ([]*model.BrokerStreamStats, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStreamStats")
+	}
+
+	var r0 []*model.BrokerStreamStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.BrokerStreamStats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.BrokerStreamStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BrokerStreamStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BrokerClient_GetStreamStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStreamStats'
+type BrokerClient_GetStreamStats_Call struct {
+	*mock.Call
+}
+
+// GetStreamStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *BrokerClient_Expecter) GetStreamStats(ctx interface{}) *BrokerClient_GetStreamStats_Call {
+	return &BrokerClient_GetStreamStats_Call{Call: _e.mock.On("GetStreamStats", ctx)}
+}
+
+func (_c *BrokerClient_GetStreamStats_Call) Run(run func(ctx context.Context)) *BrokerClient_GetStreamStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *BrokerClient_GetStreamStats_Call) Return(_a0 []*model.BrokerStreamStats, _a1 error) *BrokerClient_GetStreamStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BrokerClient_GetStreamStats_Call) RunAndReturn(run func(context.Context) ([]*model.BrokerStreamStats, error)) *BrokerClient_GetStreamStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function with given fields: ctx, topic, partition, key, data, opts
+func (_m *BrokerClient) Publish(ctx context.Context, topic string, partition *int, key any, data any, opts ...model.PublishOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, topic, partition, key, data)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Publish")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *int, any, any) error); ok {
-		r0 = rf(ctx, topic, partition, key, data)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int, any, any, ...model.PublishOption) error); ok {
+		r0 = rf(ctx, topic, partition, key, data, opts...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -143,13 +481,21 @@ type BrokerClient_Publish_Call struct {
 //   - partition *int
 //   - key any
 //   - data any
-func (_e *BrokerClient_Expecter) Publish(ctx interface{}, topic interface{}, partition interface{}, key interface{}, data interface{}) *BrokerClient_Publish_Call {
-	return &BrokerClient_Publish_Call{Call: _e.mock.On("Publish", ctx, topic, partition, key, data)}
+//   - opts ...model.PublishOption
+func (_e *BrokerClient_Expecter) Publish(ctx interface{}, topic interface{}, partition interface{}, key interface{}, data interface{}, opts ...interface{}) *BrokerClient_Publish_Call {
+	return &BrokerClient_Publish_Call{Call: _e.mock.On("Publish",
+		append([]interface{}{ctx, topic, partition, key, data}, opts...)...)}
 }
 
-func (_c *BrokerClient_Publish_Call) Run(run func(ctx context.Context, topic string, partition *int, key any, data any)) *BrokerClient_Publish_Call {
+func (_c *BrokerClient_Publish_Call) Run(run func(ctx context.Context, topic string, partition *int, key any, data any, opts ...model.PublishOption)) *BrokerClient_Publish_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(*int), args[3].(any), args[4].(any))
+		variadicArgs := make([]model.PublishOption, len(args)-5)
+		for i, a := range args[5:] {
+			if a != nil {
+				variadicArgs[i] = a.(model.PublishOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(*int), args[3].(any), args[4].(any), variadicArgs...)
 	})
 	return _c
 }
@@ -159,22 +505,229 @@ func (_c *BrokerClient_Publish_Call) Return(_a0 error) *BrokerClient_Publish_Cal
 	return _c
 }
 
-func (_c *BrokerClient_Publish_Call) RunAndReturn(run func(context.Context, string, *int, any, any) error) *BrokerClient_Publish_Call {
+func (_c *BrokerClient_Publish_Call) RunAndReturn(run func(context.Context, string, *int, any, any, ...model.PublishOption) error) *BrokerClient_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishMessage provides a mock function with given fields: ctx, message
+func (_m *BrokerClient) PublishMessage(ctx context.Context, message *model.BrokerMessage) error {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.BrokerMessage) error); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BrokerClient_PublishMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishMessage'
+type BrokerClient_PublishMessage_Call struct {
+	*mock.Call
+}
+
+// PublishMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message *model.BrokerMessage
+func (_e *BrokerClient_Expecter) PublishMessage(ctx interface{}, message interface{}) *BrokerClient_PublishMessage_Call {
+	return &BrokerClient_PublishMessage_Call{Call: _e.mock.On("PublishMessage", ctx, message)}
+}
+
+func (_c *BrokerClient_PublishMessage_Call) Run(run func(ctx context.Context, message *model.BrokerMessage)) *BrokerClient_PublishMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.BrokerMessage))
+	})
+	return _c
+}
+
+func (_c *BrokerClient_PublishMessage_Call) Return(_a0 error) *BrokerClient_PublishMessage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BrokerClient_PublishMessage_Call) RunAndReturn(run func(context.Context, *model.BrokerMessage) error) *BrokerClient_PublishMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeTopic provides a mock function with given fields: ctx, topic
+func (_m *BrokerClient) PurgeTopic(ctx context.Context, topic string) (int, error) {
+	ret := _m.Called(ctx, topic)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTopic")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, topic)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, topic)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, topic)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BrokerClient_PurgeTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeTopic'
+type BrokerClient_PurgeTopic_Call struct {
+	*mock.Call
+}
+
+// PurgeTopic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - topic string
+func (_e *BrokerClient_Expecter) PurgeTopic(ctx interface{}, topic interface{}) *BrokerClient_PurgeTopic_Call {
+	return &BrokerClient_PurgeTopic_Call{Call: _e.mock.On("PurgeTopic", ctx, topic)}
+}
+
+func (_c *BrokerClient_PurgeTopic_Call) Run(run func(ctx context.Context, topic string)) *BrokerClient_PurgeTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *BrokerClient_PurgeTopic_Call) Return(_a0 int, _a1 error) *BrokerClient_PurgeTopic_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BrokerClient_PurgeTopic_Call) RunAndReturn(run func(context.Context, string) (int, error)) *BrokerClient_PurgeTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Request provides a mock function with given fields: ctx, subject, req, resp
+func (_m *BrokerClient) Request(ctx context.Context, subject string, req any, resp any) error {
+	ret := _m.Called(ctx, subject, req, resp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Request")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, any, any) error); ok {
+		r0 = rf(ctx, subject, req, resp)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BrokerClient_Request_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Request'
+type BrokerClient_Request_Call struct {
+	*mock.Call
+}
+
+// Request is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject string
+//   - req any
+//   - resp any
+func (_e *BrokerClient_Expecter) Request(ctx interface{}, subject interface{}, req interface{}, resp interface{}) *BrokerClient_Request_Call {
+	return &BrokerClient_Request_Call{Call: _e.mock.On("Request", ctx, subject, req, resp)}
+}
+
+func (_c *BrokerClient_Request_Call) Run(run func(ctx context.Context, subject string, req any, resp any)) *BrokerClient_Request_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(any), args[3].(any))
+	})
+	return _c
+}
+
+func (_c *BrokerClient_Request_Call) Return(_a0 error) *BrokerClient_Request_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BrokerClient_Request_Call) RunAndReturn(run func(context.Context, string, any, any) error) *BrokerClient_Request_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Subscribe provides a mock function with given fields: ctx, consumerName, description, topic, handler
-func (_m *BrokerClient) Subscribe(ctx context.Context, consumerName string, description string, topic model.BrokerTopic, handler model.BrokerHandler) error {
-	ret := _m.Called(ctx, consumerName, description, topic, handler)
+// Respond provides a mock function with given fields: subject, handler
+func (_m *BrokerClient) Respond(subject string, handler model.BrokerRequestHandler) error {
+	ret := _m.Called(subject, handler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Respond")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, model.BrokerRequestHandler) error); ok {
+		r0 = rf(subject, handler)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BrokerClient_Respond_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Respond'
+type BrokerClient_Respond_Call struct {
+	*mock.Call
+}
+
+// Respond is a helper method to define mock.On call
+//   - subject string
+//   - handler model.BrokerRequestHandler
+func (_e *BrokerClient_Expecter) Respond(subject interface{}, handler interface{}) *BrokerClient_Respond_Call {
+	return &BrokerClient_Respond_Call{Call: _e.mock.On("Respond", subject, handler)}
+}
+
+func (_c *BrokerClient_Respond_Call) Run(run func(subject string, handler model.BrokerRequestHandler)) *BrokerClient_Respond_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(model.BrokerRequestHandler))
+	})
+	return _c
+}
+
+func (_c *BrokerClient_Respond_Call) Return(_a0 error) *BrokerClient_Respond_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BrokerClient_Respond_Call) RunAndReturn(run func(string, model.BrokerRequestHandler) error) *BrokerClient_Respond_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: ctx, consumerName, description, topic, handler, opts
+func (_m *BrokerClient) Subscribe(ctx context.Context, consumerName string, description string, topic model.BrokerTopic, handler model.BrokerHandler, opts ...model.SubscribeOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, consumerName, description, topic, handler)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Subscribe")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.BrokerTopic, model.BrokerHandler) error); ok {
-		r0 = rf(ctx, consumerName, description, topic, handler)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.BrokerTopic, model.BrokerHandler, ...model.SubscribeOption) error); ok {
+		r0 = rf(ctx, consumerName, description, topic, handler, opts...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -193,13 +746,21 @@ type BrokerClient_Subscribe_Call struct {
 //   - description string
 //   - topic model.BrokerTopic
 //   - handler model.BrokerHandler
-func (_e *BrokerClient_Expecter) Subscribe(ctx interface{}, consumerName interface{}, description interface{}, topic interface{}, handler interface{}) *BrokerClient_Subscribe_Call {
-	return &BrokerClient_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, consumerName, description, topic, handler)}
+//   - opts ...model.SubscribeOption
+func (_e *BrokerClient_Expecter) Subscribe(ctx interface{}, consumerName interface{}, description interface{}, topic interface{}, handler interface{}, opts ...interface{}) *BrokerClient_Subscribe_Call {
+	return &BrokerClient_Subscribe_Call{Call: _e.mock.On("Subscribe",
+		append([]interface{}{ctx, consumerName, description, topic, handler}, opts...)...)}
 }
 
-func (_c *BrokerClient_Subscribe_Call) Run(run func(ctx context.Context, consumerName string, description string, topic model.BrokerTopic, handler model.BrokerHandler)) *BrokerClient_Subscribe_Call {
+func (_c *BrokerClient_Subscribe_Call) Run(run func(ctx context.Context, consumerName string, description string, topic model.BrokerTopic, handler model.BrokerHandler, opts ...model.SubscribeOption)) *BrokerClient_Subscribe_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.BrokerTopic), args[4].(model.BrokerHandler))
+		variadicArgs := make([]model.SubscribeOption, len(args)-5)
+		for i, a := range args[5:] {
+			if a != nil {
+				variadicArgs[i] = a.(model.SubscribeOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.BrokerTopic), args[4].(model.BrokerHandler), variadicArgs...)
 	})
 	return _c
 }
@@ -209,7 +770,7 @@ func (_c *BrokerClient_Subscribe_Call) Return(_a0 error) *BrokerClient_Subscribe
 	return _c
 }
 
-func (_c *BrokerClient_Subscribe_Call) RunAndReturn(run func(context.Context, string, string, model.BrokerTopic, model.BrokerHandler) error) *BrokerClient_Subscribe_Call {
+func (_c *BrokerClient_Subscribe_Call) RunAndReturn(run func(context.Context, string, string, model.BrokerTopic, model.BrokerHandler, ...model.SubscribeOption) error) *BrokerClient_Subscribe_Call {
 	_c.Call.Return(run)
 	return _c
 }
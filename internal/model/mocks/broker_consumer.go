@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	model "boilerplate/internal/model"
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
@@ -249,6 +250,53 @@ func (_c *BrokerConsumer_Name_Call) RunAndReturn(run func() string) *BrokerConsu
 	return _c
 }
 
+// SubscribeOptions provides a mock function with no fields
+func (_m *BrokerConsumer) SubscribeOptions() []model.SubscribeOption {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeOptions")
+	}
+
+	var r0 []model.SubscribeOption
+	if rf, ok := ret.Get(0).(func() []model.SubscribeOption); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SubscribeOption)
+		}
+	}
+
+	return r0
+}
+
+// BrokerConsumer_SubscribeOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeOptions'
+type BrokerConsumer_SubscribeOptions_Call struct {
+	*mock.Call
+}
+
+// SubscribeOptions is a helper method to define mock.On call
+func (_e *BrokerConsumer_Expecter) SubscribeOptions() *BrokerConsumer_SubscribeOptions_Call {
+	return &BrokerConsumer_SubscribeOptions_Call{Call: _e.mock.On("SubscribeOptions")}
+}
+
+func (_c *BrokerConsumer_SubscribeOptions_Call) Run(run func()) *BrokerConsumer_SubscribeOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BrokerConsumer_SubscribeOptions_Call) Return(_a0 []model.SubscribeOption) *BrokerConsumer_SubscribeOptions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BrokerConsumer_SubscribeOptions_Call) RunAndReturn(run func() []model.SubscribeOption) *BrokerConsumer_SubscribeOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewBrokerConsumer creates a new instance of BrokerConsumer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBrokerConsumer(t interface {
@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BrokerRequestHandler is an autogenerated mock type for the BrokerRequestHandler type
+type BrokerRequestHandler struct {
+	mock.Mock
+}
+
+type BrokerRequestHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BrokerRequestHandler) EXPECT() *BrokerRequestHandler_Expecter {
+	return &BrokerRequestHandler_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, subject, data
+func (_m *BrokerRequestHandler) Execute(ctx context.Context, subject string, data []byte) (any, error) {
+	ret := _m.Called(ctx, subject, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) (any, error)); ok {
+		return rf(ctx, subject, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) any); ok {
+		r0 = rf(ctx, subject, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, subject, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BrokerRequestHandler_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type BrokerRequestHandler_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject string
+//   - data []byte
+func (_e *BrokerRequestHandler_Expecter) Execute(ctx interface{}, subject interface{}, data interface{}) *BrokerRequestHandler_Execute_Call {
+	return &BrokerRequestHandler_Execute_Call{Call: _e.mock.On("Execute", ctx, subject, data)}
+}
+
+func (_c *BrokerRequestHandler_Execute_Call) Run(run func(ctx context.Context, subject string, data []byte)) *BrokerRequestHandler_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *BrokerRequestHandler_Execute_Call) Return(_a0 any, _a1 error) *BrokerRequestHandler_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BrokerRequestHandler_Execute_Call) RunAndReturn(run func(context.Context, string, []byte) (any, error)) *BrokerRequestHandler_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBrokerRequestHandler creates a new instance of BrokerRequestHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBrokerRequestHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BrokerRequestHandler {
+	mock := &BrokerRequestHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
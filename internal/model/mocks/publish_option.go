@@ -0,0 +1,69 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "boilerplate/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PublishOption is an autogenerated mock type for the PublishOption type
+type PublishOption struct {
+	mock.Mock
+}
+
+type PublishOption_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PublishOption) EXPECT() *PublishOption_Expecter {
+	return &PublishOption_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: _a0
+func (_m *PublishOption) Execute(_a0 *model.PublishOptions) {
+	_m.Called(_a0)
+}
+
+// PublishOption_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type PublishOption_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - _a0 *model.PublishOptions
+func (_e *PublishOption_Expecter) Execute(_a0 interface{}) *PublishOption_Execute_Call {
+	return &PublishOption_Execute_Call{Call: _e.mock.On("Execute", _a0)}
+}
+
+func (_c *PublishOption_Execute_Call) Run(run func(_a0 *model.PublishOptions)) *PublishOption_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.PublishOptions))
+	})
+	return _c
+}
+
+func (_c *PublishOption_Execute_Call) Return() *PublishOption_Execute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PublishOption_Execute_Call) RunAndReturn(run func(*model.PublishOptions)) *PublishOption_Execute_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewPublishOption creates a new instance of PublishOption. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPublishOption(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PublishOption {
+	mock := &PublishOption{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
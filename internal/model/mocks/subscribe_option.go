@@ -0,0 +1,69 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "boilerplate/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SubscribeOption is an autogenerated mock type for the SubscribeOption type
+type SubscribeOption struct {
+	mock.Mock
+}
+
+type SubscribeOption_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SubscribeOption) EXPECT() *SubscribeOption_Expecter {
+	return &SubscribeOption_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: _a0
+func (_m *SubscribeOption) Execute(_a0 *model.SubscribeOptions) {
+	_m.Called(_a0)
+}
+
+// SubscribeOption_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type SubscribeOption_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - _a0 *model.SubscribeOptions
+func (_e *SubscribeOption_Expecter) Execute(_a0 interface{}) *SubscribeOption_Execute_Call {
+	return &SubscribeOption_Execute_Call{Call: _e.mock.On("Execute", _a0)}
+}
+
+func (_c *SubscribeOption_Execute_Call) Run(run func(_a0 *model.SubscribeOptions)) *SubscribeOption_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.SubscribeOptions))
+	})
+	return _c
+}
+
+func (_c *SubscribeOption_Execute_Call) Return() *SubscribeOption_Execute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SubscribeOption_Execute_Call) RunAndReturn(run func(*model.SubscribeOptions)) *SubscribeOption_Execute_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewSubscribeOption creates a new instance of SubscribeOption. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSubscribeOption(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SubscribeOption {
+	mock := &SubscribeOption{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
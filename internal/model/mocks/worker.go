@@ -0,0 +1,114 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Worker is an autogenerated mock type for the Worker type
+type Worker struct {
+	mock.Mock
+}
+
+type Worker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Worker) EXPECT() *Worker_Expecter {
+	return &Worker_Expecter{mock: &_m.Mock}
+}
+
+// Name provides a mock function with no fields
+func (_m *Worker) Name() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Worker_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type Worker_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *Worker_Expecter) Name() *Worker_Name_Call {
+	return &Worker_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *Worker_Name_Call) Run(run func()) *Worker_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Worker_Name_Call) Return(_a0 string) *Worker_Name_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Worker_Name_Call) RunAndReturn(run func() string) *Worker_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Run provides a mock function with given fields: ctx
+func (_m *Worker) Run(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// Worker_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type Worker_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Worker_Expecter) Run(ctx interface{}) *Worker_Run_Call {
+	return &Worker_Run_Call{Call: _e.mock.On("Run", ctx)}
+}
+
+func (_c *Worker_Run_Call) Run(run func(ctx context.Context)) *Worker_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Worker_Run_Call) Return() *Worker_Run_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Worker_Run_Call) RunAndReturn(run func(context.Context)) *Worker_Run_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewWorker creates a new instance of Worker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWorker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Worker {
+	mock := &Worker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
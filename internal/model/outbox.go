@@ -0,0 +1,12 @@
+package model
+
+// OutboxAggregate тип агрегата, в пределах ключа которого события outbox
+// публикуются в порядке записи
+type OutboxAggregate string
+
+const (
+	OutboxAggregateUser       OutboxAggregate = "user"
+	OutboxAggregateGroup      OutboxAggregate = "group"
+	OutboxAggregateUserImport OutboxAggregate = "user_import"
+	OutboxAggregateUserExport OutboxAggregate = "user_export"
+)
@@ -0,0 +1,15 @@
+package model
+
+type UserEmailChangeStatus string
+
+const (
+	// UserEmailChangeStatusPending ожидает подтверждения нового email
+	UserEmailChangeStatusPending UserEmailChangeStatus = "pending"
+	// UserEmailChangeStatusConfirmed новый email подтвержден и установлен
+	UserEmailChangeStatusConfirmed UserEmailChangeStatus = "confirmed"
+	// UserEmailChangeStatusCancelled смена отменена до подтверждения
+	UserEmailChangeStatusCancelled UserEmailChangeStatus = "cancelled"
+	// UserEmailChangeStatusUndone подтвержденная смена отменена владельцем
+	// прежнего email
+	UserEmailChangeStatusUndone UserEmailChangeStatus = "undone"
+)
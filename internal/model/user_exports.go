@@ -0,0 +1,18 @@
+package model
+
+type UserExportStatus string
+
+const (
+	UserExportStatusPending    UserExportStatus = "pending"
+	UserExportStatusProcessing UserExportStatus = "processing"
+	UserExportStatusCompleted  UserExportStatus = "completed"
+	UserExportStatusFailed     UserExportStatus = "failed"
+)
+
+type UserExportFormat string
+
+const (
+	UserExportFormatCSV  UserExportFormat = "csv"
+	UserExportFormatXLSX UserExportFormat = "xlsx"
+	UserExportFormatPDF  UserExportFormat = "pdf"
+)
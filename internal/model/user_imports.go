@@ -0,0 +1,10 @@
+package model
+
+type UserImportStatus string
+
+const (
+	UserImportStatusPending    UserImportStatus = "pending"
+	UserImportStatusProcessing UserImportStatus = "processing"
+	UserImportStatusCompleted  UserImportStatus = "completed"
+	UserImportStatusFailed     UserImportStatus = "failed"
+)
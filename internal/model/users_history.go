@@ -0,0 +1,9 @@
+package model
+
+type UserHistoryOperation string
+
+const (
+	UserHistoryOperationCreate UserHistoryOperation = "create"
+	UserHistoryOperationUpdate UserHistoryOperation = "update"
+	UserHistoryOperationDelete UserHistoryOperation = "delete"
+)
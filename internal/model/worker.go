@@ -0,0 +1,9 @@
+package model
+
+import "context"
+
+// Worker фоновый процесс приложения, Run блокируется до отмены ctx
+type Worker interface {
+	Name() string
+	Run(ctx context.Context)
+}
@@ -0,0 +1,79 @@
+package broker_headers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"boilerplate/internal/model"
+	errors_pkg "boilerplate/internal/pkg/errors"
+)
+
+// Коды ошибок пакета errors в заголовке ответа
+const (
+	errorCodeBadRequest         = "bad_request"
+	errorCodeUnauthorized       = "unauthorized"
+	errorCodeForbidden          = "forbidden"
+	errorCodeNotFound           = "not_found"
+	errorCodePreconditionFailed = "precondition_failed"
+	errorCodeDeadlineExceeded   = "deadline_exceeded"
+)
+
+// EncodeError кодирует ошибку обработчика запроса с кодом, по которому
+// запрашивающий восстановит ее тип
+func EncodeError(err error) string {
+	var requestErr *model.BrokerRequestError
+	if !errors.As(err, &requestErr) {
+		requestErr = &model.BrokerRequestError{
+			Code:    errorCode(err),
+			Message: err.Error(),
+		}
+	}
+
+	data, _ := json.Marshal(requestErr) // nolint: errchkjson
+	return Value(string(data))
+}
+
+func errorCode(err error) string {
+	switch {
+	case errors_pkg.IsErrBadRequest(err):
+		return errorCodeBadRequest
+	case errors_pkg.IsErrUnauthorized(err):
+		return errorCodeUnauthorized
+	case errors_pkg.IsErrForbidden(err):
+		return errorCodeForbidden
+	case errors_pkg.IsErrNotFound(err):
+		return errorCodeNotFound
+	case errors_pkg.IsErrPreconditionFailed(err):
+		return errorCodePreconditionFailed
+	case errors.Is(err, context.DeadlineExceeded):
+		return errorCodeDeadlineExceeded
+	}
+	return model.BrokerRequestErrorInternal
+}
+
+// DecodeError восстанавливает ошибку обработчика запроса из заголовка ответа
+func DecodeError(value string) error {
+	requestErr := &model.BrokerRequestError{}
+	if err := json.Unmarshal([]byte(value), requestErr); err != nil {
+		return &model.BrokerRequestError{Code: model.BrokerRequestErrorInternal, Message: value}
+	}
+
+	switch requestErr.Code {
+	case errorCodeBadRequest:
+		return errors_pkg.NewBadRequestError(requestErr.Message)
+	case errorCodeUnauthorized:
+		return errors_pkg.NewUnauthorizedError(requestErr.Message)
+	case errorCodeForbidden:
+		return errors_pkg.NewForbiddenError(requestErr.Message)
+	case errorCodeNotFound:
+		return errors_pkg.NewNotFoundError(requestErr.Message)
+	case errorCodePreconditionFailed:
+		return errors_pkg.NewPreconditionFailedError(requestErr.Message)
+	case errorCodeDeadlineExceeded:
+		return fmt.Errorf("%s: %w", requestErr.Message, context.DeadlineExceeded)
+	}
+
+	return requestErr
+}
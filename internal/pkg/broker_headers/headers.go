@@ -0,0 +1,47 @@
+// Package broker_headers заголовки сообщений брокера, общие для клиента NATS и
+// брокера в памяти: метаданные запроса, контекст трассировки и ошибки
+// обработчиков запросов
+package broker_headers
+
+import "strings"
+
+const (
+	// Key ключ партиционирования сообщения
+	Key       = "X-Key"
+	RequestID = "X-Request-ID"
+	UserID    = "X-User-ID"
+	IP        = "X-IP"
+	// Deadline момент в формате RFC 3339, после которого запрашивающий
+	// перестает ждать ответ
+	Deadline = "X-Deadline"
+	// Error ошибка обработчика запроса в формате JSON
+	Error = "X-Error"
+)
+
+// Header заголовки сообщения. Ключи чувствительны к регистру, как в
+// nats.Header, в который Header приводится без копирования
+type Header map[string][]string
+
+func (h Header) Get(key string) string {
+	if values := h[key]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (h Header) Set(key, value string) {
+	h[key] = []string{value}
+}
+
+func (h Header) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for key := range h {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Value заменяет переводы строк, которые недопустимы в значении заголовка
+func Value(value string) string {
+	return strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(value)
+}
@@ -0,0 +1,86 @@
+package broker_headers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/trace"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/broker_headers"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/metadata"
+)
+
+func TestMetadata(t *testing.T) {
+	t.Parallel()
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	member, err := baggage.NewMember("tenant", "acme")
+	require.NoError(t, err)
+	bag, err := baggage.New(member)
+	require.NoError(t, err)
+
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), spanContext)
+	ctx = baggage.ContextWithBaggage(ctx, bag)
+	ctx = metadata.WithRequestID(ctx, "request-1")
+	ctx = metadata.WithUserID(ctx, 7)
+	ctx = metadata.WithIP(ctx, "127.0.0.1")
+
+	h := broker_headers.Header{}
+	broker_headers.WriteMetadata(ctx, h)
+	require.Equal(t, "request-1", h.Get(broker_headers.RequestID))
+	require.Equal(t, "7", h.Get(broker_headers.UserID))
+	require.NotEmpty(t, h.Get("traceparent"))
+
+	got, err := broker_headers.ReadMetadata(context.Background(), h)
+	require.NoError(t, err)
+
+	requestID, _ := metadata.GetRequestID(got)
+	userID, _ := metadata.GetUserID(got)
+	ip, _ := metadata.GetIP(got)
+	require.Equal(t, "request-1", requestID)
+	require.Equal(t, 7, userID)
+	require.Equal(t, "127.0.0.1", ip)
+	require.Equal(t, spanContext.TraceID(), trace.SpanContextFromContext(got).TraceID())
+	require.Equal(t, "acme", baggage.FromContext(got).Member("tenant").Value())
+
+	// Некорректный id пользователя не мешает перенести остальные метаданные
+	h.Set(broker_headers.UserID, "broken")
+	got, err = broker_headers.ReadMetadata(context.Background(), h)
+	require.Error(t, err)
+
+	requestID, _ = metadata.GetRequestID(got)
+	_, ok := metadata.GetUserID(got)
+	require.Equal(t, "request-1", requestID)
+	require.False(t, ok)
+}
+
+func TestError(t *testing.T) {
+	t.Parallel()
+
+	// roundTrip передает ошибку обработчика через заголовок ответа
+	roundTrip := func(err error) error {
+		return broker_headers.DecodeError(broker_headers.EncodeError(err))
+	}
+
+	err := roundTrip(errors_pkg.NewNotFoundError("user not found"))
+	require.True(t, errors_pkg.IsErrNotFound(err))
+	require.Equal(t, "user not found", err.Error())
+
+	require.ErrorIs(t, roundTrip(context.DeadlineExceeded), context.DeadlineExceeded)
+
+	var requestErr *model.BrokerRequestError
+	require.ErrorAs(t, roundTrip(errors.New("broken")), &requestErr)
+	require.Equal(t, model.BrokerRequestErrorInternal, requestErr.Code)
+
+	require.ErrorAs(t, roundTrip(&model.BrokerRequestError{Code: model.BrokerRequestErrorUnavailable, Message: "busy"}), &requestErr)
+	require.Equal(t, model.BrokerRequestErrorUnavailable, requestErr.Code)
+}
@@ -0,0 +1,50 @@
+package broker_headers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"boilerplate/internal/pkg/metadata"
+)
+
+// WriteMetadata добавляет метаданные запроса, контекст трассировки и baggage из
+// контекста в заголовки сообщения
+func WriteMetadata(ctx context.Context, h Header) {
+	metadata.Propagator.Inject(ctx, h)
+
+	if requestID, ok := metadata.GetRequestID(ctx); ok {
+		h.Set(RequestID, requestID)
+	}
+	if userID, ok := metadata.GetUserID(ctx); ok {
+		h.Set(UserID, strconv.Itoa(userID))
+	}
+	if ip, ok := metadata.GetIP(ctx); ok {
+		h.Set(IP, ip)
+	}
+}
+
+// ReadMetadata переносит метаданные запроса, контекст трассировки и baggage из
+// заголовков сообщения в контекст. Некорректный id пользователя пропускается,
+// остальные метаданные переносятся вместе с ошибкой
+func ReadMetadata(ctx context.Context, h Header) (context.Context, error) {
+	ctx = metadata.Propagator.Extract(ctx, h)
+
+	if requestID := h.Get(RequestID); requestID != "" {
+		ctx = metadata.WithRequestID(ctx, requestID)
+	}
+
+	if ip := h.Get(IP); ip != "" {
+		ctx = metadata.WithIP(ctx, ip)
+	}
+
+	if value := h.Get(UserID); value != "" {
+		userID, err := strconv.Atoi(value)
+		if err != nil {
+			return ctx, fmt.Errorf("invalid user ID: %w", err)
+		}
+		ctx = metadata.WithUserID(ctx, userID)
+	}
+
+	return ctx, nil
+}
@@ -23,6 +23,8 @@ const maxConns = 20
 // Client предоставляет методы для работы с базой данных.
 // Методы Exec, Query, QueryRow автоматически используют транзакцию,
 // если она присутствует в контексте (через метод Transaction).
+// Transaction внутри транзакции создает точку сохранения: ошибка откатывает
+// только ее, а изменения фиксируются вместе с внешней транзакцией.
 type Client interface {
 	GetPool() *pgxpool.Pool
 	Ping(ctx context.Context) error
@@ -161,17 +163,25 @@ func (c *client) Transaction(ctx context.Context, fn TxFunc) error {
 		c.logger.DebugKV(ctx, "end transaction", "ended at", time.Now().UTC().Format(time.RFC3339Nano), "duration", time.Since(now).String(), "error", err.Error())
 	}()
 
-	var conn *pgxpool.Conn
-	conn, err = c.pool.Acquire(ctx)
-	if err != nil {
-		return fmt.Errorf("get connection from pool: %w", err)
-	}
-	defer conn.Release()
-
 	var tx pgx.Tx
-	tx, err = conn.Begin(ctx)
-	if err != nil {
-		return fmt.Errorf("begin transaction: %w", err)
+	if outer, exists := ctx.Value(txKey{}).(pgx.Tx); exists {
+		// Вложенная транзакция выполняется в точке сохранения внешней
+		tx, err = outer.Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("begin nested transaction: %w", err)
+		}
+	} else {
+		var conn *pgxpool.Conn
+		conn, err = c.pool.Acquire(ctx)
+		if err != nil {
+			return fmt.Errorf("get connection from pool: %w", err)
+		}
+		defer conn.Release()
+
+		tx, err = conn.Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("begin transaction: %w", err)
+		}
 	}
 	defer tx.Rollback(ctx)
 
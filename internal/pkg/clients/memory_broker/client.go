@@ -0,0 +1,324 @@
+package memory_broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/broker_headers"
+	"boilerplate/internal/pkg/cloudevents"
+	logger_pkg "boilerplate/internal/pkg/logger"
+)
+
+const defaultSource = "boilerplate"
+
+// defaultDuplicateWindow окно дедупликации топика, для которого оно не задано
+const defaultDuplicateWindow = 2 * time.Minute
+
+// partitionSuffix отделяет номер партиции в subject топика
+const partitionSuffix = ".p"
+
+// ErrTopicNotFound топик не создан через CreateOrUpdateTopic
+var ErrTopicNotFound = errors.New("topic not found")
+
+// ErrClientClosed клиент закрыт через Close
+var ErrClientClosed = errors.New("broker client closed")
+
+// Client брокер сообщений в памяти процесса для тестов. Сообщения хранятся в
+// топиках так же, как в NATS JetStream, но доставляются подписчикам синхронно:
+// Publish возвращается после того, как все консьюмеры обработали сообщение,
+// включая повторные попытки и перемещение в DLQ. Исключение составляет
+// публикация из обработчика с его контекстом: консьюмер, который уже
+// обрабатывает сообщения, обработает новое после текущего. Повторные попытки
+// выполняются без задержки политики топика, а ограничения хранения топика не
+// применяются
+type Client interface {
+	model.BrokerClient
+	// Published возвращает сообщения, сохраненные в топике, в порядке
+	// публикации, включая удаленные из топика после этого
+	Published(topic string) []*Message
+	// Consumed возвращает сообщения, которые консьюмер с именем из Subscribe
+	// успешно обработал, в порядке обработки
+	Consumed(consumerName string) []*Message
+	// SetPublishHook задает функцию, которая вызывается перед сохранением
+	// сообщения. Ошибка функции возвращается из Publish, и сообщение не
+	// сохраняется. nil отключает функцию
+	SetPublishHook(hook PublishHook)
+	// Reset очищает сообщения, возвращаемые Published и Consumed
+	Reset()
+}
+
+// PublishHook проверяет сообщение перед сохранением в топике
+type PublishHook func(ctx context.Context, message *Message) error
+
+type client struct {
+	logger logger_pkg.Logger
+	source string
+
+	mu sync.Mutex
+	// topics топики, созданные клиентом, в порядке создания
+	topics []*topic
+	// consumers консьюмеры партиций, созданные клиентом
+	consumers []*consumer
+	// cancels отменяют контексты обработчиков подписок и запросов
+	cancels []context.CancelFunc
+	// responders обработчики запросов по subject
+	responders map[string][]*responder
+	// turns номер обработчика subject, который получит следующий запрос
+	turns map[string]int
+	// inFlight сообщения и запросы, которые обрабатываются в данный момент
+	inFlight sync.WaitGroup
+	// draining устанавливается, когда клиент перестает обрабатывать новые
+	// сообщения и запросы
+	draining bool
+	closed   bool
+
+	publishHook PublishHook
+	// published сообщения, сохраненные в топиках, по имени топика
+	published map[string][]*Message
+	// consumed обработанные сообщения по имени консьюмера
+	consumed map[string][]*Message
+}
+
+// topic хранилище сообщений топика
+type topic struct {
+	config   model.BrokerTopic
+	subjects []string
+	messages []*model.BrokerMessage
+	lastID   uint64
+	lastTime time.Time
+	// eventIDs время публикации событий в пределах окна дедупликации
+	eventIDs map[string]time.Time
+}
+
+func NewClient(logger logger_pkg.Logger, opts ...Option) Client {
+	c := &client{
+		logger:     logger,
+		responders: map[string][]*responder{},
+		turns:      map[string]int{},
+		published:  map[string][]*Message{},
+		consumed:   map[string][]*Message{},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.source == "" {
+		c.source = defaultSource
+	}
+
+	return c
+}
+
+func (c *client) Publish(ctx context.Context, topicName string, partition *int, key, data any, opts ...model.PublishOption) error {
+	c.logger.DebugKV(ctx, "publish to topic", "topic", topicName, "key", key)
+
+	event, err := cloudevents.New(c.source, topicName, data, opts...)
+	if err != nil {
+		return fmt.Errorf("create event for topic %s: %w", topicName, err)
+	}
+
+	keyValue := fmt.Sprintf("%v", key)
+
+	// Сообщения с одним ключом направляются в одну партицию, как в клиенте NATS
+	if partition == nil {
+		c.mu.Lock()
+		t := c.topic(topicName)
+		c.mu.Unlock()
+		if t == nil {
+			return fmt.Errorf("get topic %s: %w", topicName, ErrTopicNotFound)
+		}
+		partition = model.BrokerPartition(keyValue, t.config.Partitions)
+	}
+
+	subject := topicName
+	if partition != nil {
+		subject = partitionSubject(topicName, *partition)
+	}
+
+	headers := broker_headers.Header{}
+	event.WriteHeaders(headers)
+	headers.Set(broker_headers.Key, keyValue)
+	broker_headers.WriteMetadata(ctx, headers)
+
+	return c.publish(ctx, &model.BrokerMessage{
+		Subject: subject,
+		Headers: headers,
+		Data:    event.Data,
+	}, event.ID)
+}
+
+func (c *client) Subscribe(ctx context.Context, consumerName, _ string, topic model.BrokerTopic, handler model.BrokerHandler, opts ...model.SubscribeOption) error {
+	c.logger.InfoKV(ctx, "subscribing to topic", "consumer", consumerName, "topic", topic.Name)
+
+	options := model.SubscribeOptions{
+		Workers: 1,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return ErrClientClosed
+	}
+
+	t := c.topic(topic.Name)
+	if t == nil {
+		c.mu.Unlock()
+		return fmt.Errorf("get topic %s: %w", topic.Name, ErrTopicNotFound)
+	}
+
+	// Контекст обработчиков отменяется при закрытии клиента
+	ctx, cancel := context.WithCancel(ctx)
+	c.cancels = append(c.cancels, cancel)
+
+	consumers := make([]*consumer, 0, len(t.subjects))
+	for _, subject := range t.subjects {
+		cn := strings.ReplaceAll(consumerName+"-"+subject, ".", "-")
+
+		// Консьюмер с тем же именем продолжает обработку с места остановки
+		s := c.consumer(cn)
+		if s == nil {
+			s = &consumer{
+				consumerName: consumerName,
+				name:         cn,
+				subject:      subject,
+			}
+			c.consumers = append(c.consumers, s)
+		}
+		s.ctx = ctx
+		s.topic = topic
+		s.options = options
+		s.handler = handler
+
+		consumers = append(consumers, s)
+	}
+	c.mu.Unlock()
+
+	// Сообщения, опубликованные до подписки, обрабатываются сразу
+	for _, s := range consumers {
+		c.logger.DebugKV(ctx, "subscribed to subject", "consumer", s.name, "subject", s.subject)
+		c.deliver(ctx, s)
+	}
+
+	return nil
+}
+
+func (c *client) CreateOrUpdateTopic(_ context.Context, config model.BrokerTopic) error {
+	subjects := []string{}
+	if config.Partitions > 0 {
+		for i := 0; i < config.Partitions; i++ {
+			subjects = append(subjects, partitionSubject(config.Name, i))
+		}
+	} else {
+		subjects = append(subjects, config.Name)
+	}
+
+	if config.DuplicateWindow <= 0 {
+		config.DuplicateWindow = defaultDuplicateWindow
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if t := c.topic(config.Name); t != nil {
+		t.config = config
+		t.subjects = subjects
+		return nil
+	}
+
+	c.topics = append(c.topics, &topic{
+		config:   config,
+		subjects: subjects,
+		eventIDs: map[string]time.Time{},
+	})
+
+	return nil
+}
+
+// track учитывает начало обработки сообщения или запроса и возвращает false,
+// если клиент уже начал Drain. Вызывается под c.mu
+func (c *client) track() bool {
+	if c.draining || c.closed {
+		return false
+	}
+	c.inFlight.Add(1)
+	return true
+}
+
+func (c *client) Drain(ctx context.Context) error {
+	c.mu.Lock()
+	c.draining = true
+	c.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.inFlight.Wait()
+	}()
+
+	select {
+	case <-done:
+		c.logger.Info(ctx, "memory broker consumers drained")
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("wait for in-flight messages: %w", ctx.Err())
+	}
+}
+
+func (c *client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, cancel := range c.cancels {
+		cancel()
+	}
+	c.cancels = nil
+	c.closed = true
+
+	return nil
+}
+
+// topic возвращает топик по имени. Вызывается под c.mu
+func (c *client) topic(name string) *topic {
+	for _, t := range c.topics {
+		if t.config.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// subjectTopic возвращает топик, которому принадлежит subject. Вызывается под c.mu
+func (c *client) subjectTopic(subject string) *topic {
+	for _, t := range c.topics {
+		for _, s := range t.subjects {
+			if s == subject {
+				return t
+			}
+		}
+	}
+	return nil
+}
+
+// consumer возвращает консьюмера партиции по имени. Вызывается под c.mu
+func (c *client) consumer(name string) *consumer {
+	for _, s := range c.consumers {
+		if s.name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+func partitionSubject(topic string, partition int) string {
+	return topic + partitionSuffix + strconv.Itoa(partition)
+}
@@ -0,0 +1,368 @@
+package memory_broker_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/memory_broker"
+	"boilerplate/internal/pkg/cloudevents"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	logger_pkg "boilerplate/internal/pkg/logger"
+	"boilerplate/internal/pkg/metadata"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/pkg/pb/events"
+)
+
+func newClient(t *testing.T, topics ...model.BrokerTopic) memory_broker.Client {
+	t.Helper()
+
+	logger, err := logger_pkg.New()
+	require.NoError(t, err)
+
+	client := memory_broker.NewClient(logger, memory_broker.WithSource("test-source"))
+	t.Cleanup(func() {
+		require.NoError(t, client.Close())
+	})
+
+	for _, topic := range topics {
+		require.NoError(t, client.CreateOrUpdateTopic(context.Background(), topic))
+	}
+
+	return client
+}
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	topic := model.BrokerTopic{Name: "publish-test", Partitions: 3}
+	client := newClient(t, topic)
+
+	message := &events.UserCreated{UserId: 42, Name: "name"}
+	err := client.Publish(metadata.WithUserID(ctx, 7), topic.Name, nil, 42, message, model.WithEventID("event-1"))
+	require.NoError(t, err)
+
+	// Повторная публикация события с тем же ID отбрасывается
+	err = client.Publish(ctx, topic.Name, nil, 42, message, model.WithEventID("event-1"))
+	require.NoError(t, err)
+
+	// Явная партиция имеет приоритет
+	err = client.Publish(ctx, topic.Name, utils.Ptr(2), 1, map[string]int{"key": 1})
+	require.NoError(t, err)
+
+	published := client.Published(topic.Name)
+	require.Len(t, published, 2)
+
+	require.Equal(t, "42", published[0].Key)
+	require.Equal(t, topic.Name+".p"+strconv.Itoa(*model.BrokerPartition("42", topic.Partitions)), published[0].Subject)
+	require.Equal(t, "event-1", published[0].Event.ID)
+	require.Equal(t, "test-source", published[0].Event.Source)
+	require.Equal(t, "events.UserCreated", published[0].Event.Type)
+	require.Equal(t, "7", published[0].Header("X-User-ID"))
+
+	got := &events.UserCreated{}
+	require.NoError(t, published[0].Decode(got))
+	require.True(t, proto.Equal(message, got))
+
+	require.Equal(t, topic.Name+".p2", published[1].Subject)
+	require.Equal(t, uint64(2), published[1].ID)
+
+	// Топик должен быть создан заранее
+	err = client.Publish(ctx, "unknown", nil, 1, map[string]int{})
+	require.ErrorIs(t, err, memory_broker.ErrTopicNotFound)
+
+	client.Reset()
+	require.Empty(t, client.Published(topic.Name))
+}
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	topic := model.BrokerTopic{Name: "subscribe-test", Partitions: 2}
+	client := newClient(t, topic)
+
+	// Сообщения, опубликованные до подписки, обрабатываются при подписке
+	require.NoError(t, client.Publish(ctx, topic.Name, nil, 1, map[string]int{"seq": 1}))
+
+	type received struct {
+		seq     int
+		userID  int
+		traceID trace.TraceID
+	}
+	handled := []received{}
+	err := cloudevents.Subscribe(ctx, client, "test-consumer", "", topic, func(ctx context.Context, _ string, data *map[string]int) error {
+		userID, _ := metadata.GetUserID(ctx)
+		traceID := trace.SpanContextFromContext(ctx).TraceID()
+		handled = append(handled, received{seq: (*data)["seq"], userID: userID, traceID: traceID})
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []received{{seq: 1}}, handled)
+
+	// Publish возвращается после обработки сообщения. Обработчик получает
+	// метаданные и контекст трассировки публикации, как через NATS
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	publishCtx := trace.ContextWithRemoteSpanContext(metadata.WithUserID(ctx, 7), spanContext)
+	require.NoError(t, client.Publish(publishCtx, topic.Name, nil, 2, map[string]int{"seq": 2}))
+	require.Equal(t, []received{{seq: 1}, {seq: 2, userID: 7, traceID: spanContext.TraceID()}}, handled)
+
+	consumed := client.Consumed("test-consumer")
+	require.Len(t, consumed, 2)
+	require.Equal(t, topic.Name, consumed[1].Topic)
+	require.Equal(t, "2", consumed[1].Key)
+
+	stats, err := client.GetConsumerStats(ctx)
+	require.NoError(t, err)
+	require.Len(t, stats, topic.Partitions)
+	for _, s := range stats {
+		require.Equal(t, "test-consumer", s.Consumer)
+		require.Zero(t, s.Pending)
+	}
+}
+
+func TestSubscribeRetries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dlq := model.BrokerTopic{Name: "retries-test-dlq"}
+	topic := model.BrokerTopic{
+		Name: "retries-test",
+		// Задержка политики не соблюдается
+		Retry: model.RetryPolicy{
+			MaxAttempts:  3,
+			InitialDelay: time.Hour,
+		},
+		DLQTopicName: dlq.Name,
+	}
+	client := newClient(t, dlq, topic)
+
+	attempts := map[string]int{}
+	err := client.Subscribe(ctx, "test-consumer", "", topic, func(ctx context.Context, _ string, _ []byte) error {
+		event, _ := cloudevents.FromContext(ctx)
+		attempts[event.ID]++
+
+		switch {
+		case event.ID == "permanent":
+			return model.ErrPermanent(errors.New("invalid payload"))
+		case event.ID == "failing", event.ID == "retry" && attempts[event.ID] == 1:
+			return errors.New("temporary error")
+		default:
+			return nil
+		}
+	})
+	require.NoError(t, err)
+
+	for _, id := range []string{"permanent", "failing", "retry"} {
+		require.NoError(t, client.Publish(ctx, topic.Name, nil, id, map[string]string{}, model.WithEventID(id)))
+	}
+
+	require.Equal(t, map[string]int{"permanent": 1, "failing": 3, "retry": 2}, attempts)
+
+	consumed := client.Consumed("test-consumer")
+	require.Len(t, consumed, 1)
+	require.Equal(t, "retry", consumed[0].Event.ID)
+
+	// Исчерпавшие попытки сообщения перемещены в DLQ с исходными заголовками
+	messages := client.Published(dlq.Name)
+	require.Len(t, messages, 2)
+
+	require.Equal(t, "permanent", messages[0].Event.ID)
+	require.Equal(t, "1", messages[0].Header(model.BrokerHeaderDLQAttempts))
+	require.Equal(t, "invalid payload", messages[0].Header(model.BrokerHeaderDLQError))
+	require.Equal(t, topic.Name, messages[0].Header(model.BrokerHeaderDLQSubject))
+	require.Equal(t, "test-consumer", messages[0].Header(model.BrokerHeaderDLQConsumer))
+
+	require.Equal(t, "failing", messages[1].Event.ID)
+	require.Equal(t, "3", messages[1].Header(model.BrokerHeaderDLQAttempts))
+	require.Equal(t, "failing", messages[1].Key)
+}
+
+func TestSubscribeNestedPublish(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	topic := model.BrokerTopic{Name: "nested-test"}
+	client := newClient(t, topic)
+
+	// Сообщение, опубликованное обработчиком в свой топик, обрабатывается
+	// после текущего
+	order := []int{}
+	err := cloudevents.Subscribe(ctx, client, "test-consumer", "", topic, func(ctx context.Context, _ string, data *map[string]int) error {
+		seq := (*data)["seq"]
+		if seq < 3 {
+			if err := client.Publish(ctx, topic.Name, nil, 1, map[string]int{"seq": seq + 1}); err != nil {
+				return err
+			}
+		}
+		order = append(order, seq)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, client.Publish(ctx, topic.Name, nil, 1, map[string]int{"seq": 1}))
+	require.Equal(t, []int{1, 2, 3}, order)
+}
+
+func TestSubscribeConcurrentPublish(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	topic := model.BrokerTopic{Name: "concurrent-test"}
+	client := newClient(t, topic)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var handled atomic.Int32
+	err := cloudevents.Subscribe(ctx, client, "test-consumer", "", topic, func(_ context.Context, _ string, data *map[string]int) error {
+		if (*data)["seq"] == 1 {
+			close(started)
+			<-release
+		}
+		handled.Add(1)
+		return nil
+	})
+	require.NoError(t, err)
+
+	go func() {
+		assert.NoError(t, client.Publish(ctx, topic.Name, nil, 1, map[string]int{"seq": 1}))
+	}()
+	<-started
+
+	// Publish ждет, пока консьюмер закончит обработку другого сообщения
+	published := make(chan error, 1)
+	go func() {
+		published <- client.Publish(ctx, topic.Name, nil, 1, map[string]int{"seq": 2})
+	}()
+
+	select {
+	case <-published:
+		require.FailNow(t, "publish returned before message was handled")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-published)
+	require.Equal(t, int32(2), handled.Load())
+}
+
+func TestPublishHook(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	topic := model.BrokerTopic{Name: "hook-test"}
+	client := newClient(t, topic)
+
+	client.SetPublishHook(func(_ context.Context, message *memory_broker.Message) error {
+		if message.Key == "fail" {
+			return errors.New("unavailable")
+		}
+		return nil
+	})
+
+	require.NoError(t, client.Publish(ctx, topic.Name, nil, "ok", map[string]int{}))
+	err := client.Publish(ctx, topic.Name, nil, "fail", map[string]int{})
+	require.ErrorContains(t, err, "unavailable")
+
+	published := client.Published(topic.Name)
+	require.Len(t, published, 1)
+	require.Equal(t, "ok", published[0].Key)
+
+	client.SetPublishHook(nil)
+	require.NoError(t, client.Publish(ctx, topic.Name, nil, "fail", map[string]int{}))
+}
+
+func TestMessages(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	topic := model.BrokerTopic{Name: "messages-test"}
+	client := newClient(t, topic)
+
+	for i := range 3 {
+		require.NoError(t, client.Publish(ctx, topic.Name, nil, i, map[string]int{"seq": i}))
+	}
+
+	require.NoError(t, client.DeleteMessage(ctx, topic.Name, 2))
+	require.ErrorIs(t, client.DeleteMessage(ctx, topic.Name, 2), model.ErrBrokerMessageNotFound)
+
+	messages, err := client.GetMessages(ctx, topic.Name, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.Equal(t, uint64(3), messages[0].ID)
+
+	_, err = client.GetMessage(ctx, topic.Name, 2)
+	require.ErrorIs(t, err, model.ErrBrokerMessageNotFound)
+
+	require.NoError(t, client.PublishMessage(ctx, messages[0]))
+
+	stats, err := client.GetStreamStats(ctx)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, uint64(3), stats[0].Messages)
+	require.Equal(t, uint64(1), stats[0].FirstID)
+	require.Equal(t, uint64(4), stats[0].LastID)
+
+	count, err := client.PurgeTopic(ctx, topic.Name)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	// Удаленные из топика сообщения остаются в истории публикаций
+	require.Len(t, client.Published(topic.Name), 4)
+}
+
+func TestRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newClient(t)
+
+	err := client.Request(ctx, "echo", map[string]string{}, nil)
+	require.ErrorIs(t, err, model.ErrBrokerNoResponders)
+
+	err = cloudevents.Respond(client, "echo", func(ctx context.Context, _ string, req *map[string]string) (any, error) {
+		switch (*req)["value"] {
+		case "missing":
+			return nil, errors_pkg.NewNotFoundError("not found")
+		case "broken":
+			return nil, errors.New("broken")
+		}
+
+		userID, _ := metadata.GetUserID(ctx)
+		return map[string]string{"value": (*req)["value"], "user_id": strconv.Itoa(userID)}, nil
+	})
+	require.NoError(t, err)
+
+	resp := map[string]string{}
+	err = client.Request(metadata.WithUserID(ctx, 7), "echo", map[string]string{"value": "hello"}, &resp)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"value": "hello", "user_id": "7"}, resp)
+
+	err = client.Request(ctx, "echo", map[string]string{"value": "missing"}, &resp)
+	require.True(t, errors_pkg.IsErrNotFound(err))
+
+	var requestErr *model.BrokerRequestError
+	err = client.Request(ctx, "echo", map[string]string{"value": "broken"}, &resp)
+	require.ErrorAs(t, err, &requestErr)
+	require.Equal(t, model.BrokerRequestErrorInternal, requestErr.Code)
+
+	// После Drain запросы отклоняются
+	require.NoError(t, client.Drain(ctx))
+	err = client.Request(ctx, "echo", map[string]string{"value": "hello"}, &resp)
+	require.ErrorAs(t, err, &requestErr)
+	require.Equal(t, model.BrokerRequestErrorUnavailable, requestErr.Code)
+}
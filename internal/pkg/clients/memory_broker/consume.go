@@ -0,0 +1,197 @@
+package memory_broker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/broker_headers"
+	"boilerplate/internal/pkg/cloudevents"
+)
+
+// consumer консьюмер одной партиции топика. Поля, кроме mu, защищены client.mu
+type consumer struct {
+	consumerName string
+	// name имя консьюмера партиции, как на сервере NATS
+	name    string
+	subject string
+	topic   model.BrokerTopic
+	options model.SubscribeOptions
+	handler model.BrokerHandler
+	ctx     context.Context
+
+	// mu удерживается, пока консьюмер обрабатывает сообщения, чтобы сообщения
+	// партиции обрабатывались по очереди
+	mu sync.Mutex
+	// delivered номер последнего обработанного сообщения
+	delivered  uint64
+	lastActive *time.Time
+}
+
+// delivery сообщение и параметры подписки, с которыми оно обрабатывается
+type delivery struct {
+	consumer *consumer
+	message  *model.BrokerMessage
+	topic    model.BrokerTopic
+	options  model.SubscribeOptions
+	handler  model.BrokerHandler
+	ctx      context.Context
+}
+
+// handlingKey ключ контекста, который отмечает вызов из обработчика сообщения
+type handlingKey struct{}
+
+func withHandling(ctx context.Context) context.Context {
+	return context.WithValue(ctx, handlingKey{}, true)
+}
+
+func isHandling(ctx context.Context) bool {
+	handling, ok := ctx.Value(handlingKey{}).(bool)
+	return ok && handling
+}
+
+// deliver обрабатывает сообщения консьюмера, которые еще не обработаны. Вызов
+// не из обработчика ждет, пока консьюмер закончит обработку в другом вызове.
+// Из обработчика ждать нельзя: консьюмер может ждать окончания этого же
+// обработчика, например когда обработчик публикует в свой топик, поэтому новое
+// сообщение занятый консьюмер обработает после текущего
+func (c *client) deliver(ctx context.Context, s *consumer) {
+	for {
+		if isHandling(ctx) {
+			if !s.mu.TryLock() {
+				return
+			}
+		} else {
+			s.mu.Lock()
+		}
+		c.consume(s)
+		s.mu.Unlock()
+
+		// Сообщение могло быть сохранено после того, как консьюмер проверил
+		// очередь, но до того, как он ее освободил
+		c.mu.Lock()
+		pending := !c.draining && !c.closed && c.next(s) != nil
+		c.mu.Unlock()
+		if !pending {
+			return
+		}
+	}
+}
+
+func (c *client) consume(s *consumer) {
+	for {
+		c.mu.Lock()
+		d := c.next(s)
+		if d == nil || !c.track() {
+			c.mu.Unlock()
+			return
+		}
+		now := time.Now()
+		s.lastActive = &now
+		c.mu.Unlock()
+
+		done := c.handleMessage(d)
+		c.inFlight.Done()
+
+		// Обработка, прерванная закрытием клиента, не подтверждается
+		if !done {
+			return
+		}
+
+		c.mu.Lock()
+		s.delivered = d.message.ID
+		c.mu.Unlock()
+	}
+}
+
+// next возвращает следующее необработанное сообщение консьюмера или nil.
+// Вызывается под c.mu
+func (c *client) next(s *consumer) *delivery {
+	if s.ctx.Err() != nil {
+		return nil
+	}
+
+	t := c.topic(s.topic.Name)
+	if t == nil {
+		return nil
+	}
+
+	for _, message := range t.messages {
+		if message.ID > s.delivered && message.Subject == s.subject {
+			return &delivery{
+				consumer: s,
+				message:  message,
+				topic:    s.topic,
+				options:  s.options,
+				handler:  s.handler,
+				ctx:      s.ctx,
+			}
+		}
+	}
+
+	return nil
+}
+
+// handleMessage обрабатывает сообщение с повторными попытками по политике
+// топика без задержки между ними и возвращает false, если обработка прервана
+// закрытием клиента
+func (c *client) handleMessage(d *delivery) bool {
+	s := d.consumer
+	msg := d.message
+
+	ctx := withHandling(c.readMetadata(d.ctx, msg.Headers, msg.Subject))
+
+	event := cloudevents.FromHeaders(broker_headers.Header(msg.Headers), msg.Data)
+	ctx = cloudevents.WithEvent(ctx, event)
+
+	c.logger.DebugKV(ctx, "message received", "consumer", s.name, "subject", msg.Subject, "type", event.Type, "id", event.ID)
+
+	for attempts := uint64(1); ; attempts++ {
+		handleErr := c.callHandler(ctx, d)
+		if handleErr == nil {
+			c.mu.Lock()
+			c.consumed[s.consumerName] = append(c.consumed[s.consumerName], newMessage(d.topic.Name, msg))
+			c.mu.Unlock()
+
+			c.logger.DebugKV(ctx, "message processed successfully", "consumer", s.name, "subject", msg.Subject)
+			return true
+		}
+
+		if ctx.Err() != nil {
+			return false
+		}
+
+		c.logger.ErrorKV(ctx, "handle message error", "consumer", s.name, "subject", msg.Subject, "error", handleErr.Error())
+
+		// Постоянная ошибка не исправится при повторной обработке
+		permanent := model.IsErrPermanent(handleErr)
+		if !permanent && attempts < uint64(d.topic.Retry.MaxAttempts) {
+			c.logger.DebugKV(ctx, "retry message", "consumer", s.name, "subject", msg.Subject, "attempts", attempts)
+			continue
+		}
+
+		if d.topic.DLQTopicName == "" {
+			c.logger.WarnKV(ctx, "message terminated", "consumer", s.name, "subject", msg.Subject, "attempts", attempts)
+			return true
+		}
+
+		if err := c.moveToDLQ(ctx, d, attempts, handleErr); err != nil {
+			c.logger.ErrorKV(ctx, "publish to DLQ error", "consumer", s.name, "subject", msg.Subject, "error", err.Error())
+			return true
+		}
+		c.logger.InfoKV(ctx, "message sent to DLQ", "consumer", s.name, "subject", msg.Subject, "dlq_topic", d.topic.DLQTopicName)
+
+		return true
+	}
+}
+
+func (c *client) callHandler(ctx context.Context, d *delivery) error {
+	if d.options.HandlerTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.options.HandlerTimeout)
+		defer cancel()
+	}
+
+	return d.handler(ctx, d.message.Subject, d.message.Data)
+}
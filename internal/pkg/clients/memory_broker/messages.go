@@ -0,0 +1,182 @@
+package memory_broker
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"time"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/broker_headers"
+)
+
+// publish сохраняет сообщение в топике его subject и доставляет консьюмерам.
+// Сообщение с eventID, уже опубликованным в пределах окна дедупликации топика,
+// отбрасывается
+func (c *client) publish(ctx context.Context, message *model.BrokerMessage, eventID string) error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return ErrClientClosed
+	}
+	t := c.subjectTopic(message.Subject)
+	hook := c.publishHook
+	c.mu.Unlock()
+
+	if t == nil {
+		return fmt.Errorf("publish to subject %s: %w", message.Subject, ErrTopicNotFound)
+	}
+
+	if hook != nil {
+		if err := hook(ctx, newMessage(t.config.Name, message)); err != nil {
+			return fmt.Errorf("publish to subject %s: %w", message.Subject, err)
+		}
+	}
+
+	c.mu.Lock()
+	now := time.Now()
+	if eventID != "" {
+		for id, publishedAt := range t.eventIDs {
+			if now.Sub(publishedAt) > t.config.DuplicateWindow {
+				delete(t.eventIDs, id)
+			}
+		}
+
+		if _, ok := t.eventIDs[eventID]; ok {
+			c.mu.Unlock()
+			c.logger.DebugKV(ctx, "duplicate message skipped", "subject", message.Subject, "id", eventID)
+			return nil
+		}
+		t.eventIDs[eventID] = now
+	}
+
+	t.lastID++
+	t.lastTime = now
+	stored := &model.BrokerMessage{
+		ID:      t.lastID,
+		Subject: message.Subject,
+		Headers: maps.Clone(message.Headers),
+		Data:    message.Data,
+		Time:    now,
+	}
+	t.messages = append(t.messages, stored)
+	c.published[t.config.Name] = append(c.published[t.config.Name], newMessage(t.config.Name, stored))
+
+	consumers := []*consumer{}
+	for _, s := range c.consumers {
+		if s.subject == stored.Subject {
+			consumers = append(consumers, s)
+		}
+	}
+	c.mu.Unlock()
+
+	c.logger.DebugKV(ctx, "published to topic", "subject", stored.Subject, "id", eventID, "sequence", stored.ID)
+
+	for _, s := range consumers {
+		c.deliver(ctx, s)
+	}
+
+	return nil
+}
+
+func (c *client) GetMessages(_ context.Context, topicName string, from uint64, limit int) ([]*model.BrokerMessage, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := c.topic(topicName)
+	if t == nil {
+		return nil, fmt.Errorf("get topic %s: %w", topicName, ErrTopicNotFound)
+	}
+
+	messages := []*model.BrokerMessage{}
+	for _, message := range t.messages {
+		if len(messages) >= limit {
+			break
+		}
+		if message.ID >= from {
+			messages = append(messages, cloneMessage(message))
+		}
+	}
+
+	return messages, nil
+}
+
+func (c *client) GetMessage(_ context.Context, topicName string, id uint64) (*model.BrokerMessage, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := c.topic(topicName)
+	if t == nil {
+		return nil, fmt.Errorf("get topic %s: %w", topicName, ErrTopicNotFound)
+	}
+
+	for _, message := range t.messages {
+		if message.ID == id {
+			return cloneMessage(message), nil
+		}
+	}
+
+	return nil, model.ErrBrokerMessageNotFound
+}
+
+func (c *client) PublishMessage(ctx context.Context, message *model.BrokerMessage) error {
+	return c.publish(ctx, message, "")
+}
+
+func (c *client) DeleteMessage(_ context.Context, topicName string, id uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := c.topic(topicName)
+	if t == nil {
+		return fmt.Errorf("get topic %s: %w", topicName, ErrTopicNotFound)
+	}
+
+	for i, message := range t.messages {
+		if message.ID == id {
+			t.messages = append(t.messages[:i], t.messages[i+1:]...)
+			return nil
+		}
+	}
+
+	return model.ErrBrokerMessageNotFound
+}
+
+func (c *client) PurgeTopic(_ context.Context, topicName string) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := c.topic(topicName)
+	if t == nil {
+		return 0, fmt.Errorf("get topic %s: %w", topicName, ErrTopicNotFound)
+	}
+
+	count := len(t.messages)
+	t.messages = nil
+
+	return count, nil
+}
+
+// moveToDLQ публикует необработанное сообщение в DLQ топика без изменений,
+// добавляя к его заголовкам исходный subject, консьюмера и причину ошибки
+func (c *client) moveToDLQ(ctx context.Context, d *delivery, attempts uint64, handleErr error) error {
+	headers := maps.Clone(d.message.Headers)
+	headers[model.BrokerHeaderDLQSubject] = []string{d.message.Subject}
+	headers[model.BrokerHeaderDLQConsumer] = []string{d.consumer.consumerName}
+	headers[model.BrokerHeaderDLQError] = []string{broker_headers.Value(handleErr.Error())}
+	headers[model.BrokerHeaderDLQAttempts] = []string{strconv.FormatUint(attempts, 10)}
+	headers[model.BrokerHeaderDLQFailedAt] = []string{time.Now().UTC().Format(time.RFC3339Nano)}
+
+	return c.PublishMessage(ctx, &model.BrokerMessage{
+		Subject: d.topic.DLQTopicName,
+		Headers: headers,
+		Data:    d.message.Data,
+	})
+}
+
+func cloneMessage(message *model.BrokerMessage) *model.BrokerMessage {
+	clone := *message
+	clone.Headers = maps.Clone(message.Headers)
+	return &clone
+}
@@ -0,0 +1,18 @@
+package memory_broker
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/broker_headers"
+)
+
+// readMetadata переносит метаданные запроса, контекст трассировки и baggage из
+// заголовков сообщения в контекст
+func (c *client) readMetadata(ctx context.Context, headers map[string][]string, subject string) context.Context {
+	ctx, err := broker_headers.ReadMetadata(ctx, headers)
+	if err != nil {
+		c.logger.ErrorKV(ctx, "invalid message metadata", "subject", subject, "error", err.Error())
+	}
+
+	return ctx
+}
@@ -0,0 +1,10 @@
+package memory_broker
+
+type Option func(*client)
+
+// WithSource задает атрибут source публикуемых событий CloudEvents
+func WithSource(source string) Option {
+	return func(c *client) {
+		c.source = source
+	}
+}
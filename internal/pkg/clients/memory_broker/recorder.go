@@ -0,0 +1,64 @@
+package memory_broker
+
+import (
+	"context"
+	"slices"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/broker_headers"
+	"boilerplate/internal/pkg/cloudevents"
+)
+
+// Message сообщение топика с атрибутами события CloudEvents для проверок в тестах
+type Message struct {
+	*model.BrokerMessage
+	Topic string
+	// Key ключ, с которым сообщение опубликовано через Publish
+	Key   string
+	Event *cloudevents.Event
+}
+
+func newMessage(topic string, message *model.BrokerMessage) *Message {
+	message = cloneMessage(message)
+	return &Message{
+		BrokerMessage: message,
+		Topic:         topic,
+		Key:           message.Header(broker_headers.Key),
+		Event:         cloudevents.FromHeaders(broker_headers.Header(message.Headers), message.Data),
+	}
+}
+
+// Decode декодирует данные сообщения в значение по указателю v так же, как
+// подписчик через cloudevents.DecodeInto
+func (m *Message) Decode(v any) error {
+	return cloudevents.DecodeInto(cloudevents.WithEvent(context.Background(), m.Event), m.Data, v)
+}
+
+func (c *client) Published(topic string) []*Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.Clone(c.published[topic])
+}
+
+func (c *client) Consumed(consumerName string) []*Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.Clone(c.consumed[consumerName])
+}
+
+func (c *client) SetPublishHook(hook PublishHook) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.publishHook = hook
+}
+
+func (c *client) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.published = map[string][]*Message{}
+	c.consumed = map[string][]*Message{}
+}
@@ -0,0 +1,128 @@
+package memory_broker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/broker_headers"
+	"boilerplate/internal/pkg/cloudevents"
+)
+
+// defaultRequestTimeout время ожидания ответа на запрос без дедлайна
+const defaultRequestTimeout = 10 * time.Second
+
+// responder обработчик запросов subject
+type responder struct {
+	ctx     context.Context
+	handler model.BrokerRequestHandler
+}
+
+func (c *client) Request(ctx context.Context, subject string, req, resp any) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultRequestTimeout)
+		defer cancel()
+	}
+
+	event, err := cloudevents.New(c.source, subject, req)
+	if err != nil {
+		return fmt.Errorf("create request for subject %s: %w", subject, err)
+	}
+
+	headers := broker_headers.Header{}
+	event.WriteHeaders(headers)
+	broker_headers.WriteMetadata(ctx, headers)
+
+	r, err := c.responder(subject)
+	if err != nil {
+		return err
+	}
+	defer c.inFlight.Done()
+
+	c.logger.DebugKV(ctx, "send request", "subject", subject, "type", event.Type, "id", event.ID)
+
+	// Обработчик получает контекст своей подписки с дедлайном запроса, как
+	// при доставке запроса через NATS
+	deadline, _ := ctx.Deadline()
+	handlerCtx, cancel := context.WithDeadline(c.readMetadata(r.ctx, headers, subject), deadline)
+	defer cancel()
+	handlerCtx = cloudevents.WithEvent(handlerCtx, cloudevents.FromHeaders(headers, event.Data))
+	// Запрос из обработчика сообщения выполняется в том же вызове
+	if isHandling(ctx) {
+		handlerCtx = withHandling(handlerCtx)
+	}
+
+	res, err := r.handler(handlerCtx, subject, event.Data)
+	if err != nil {
+		c.logger.ErrorKV(ctx, "handle request error", "subject", subject, "error", err.Error())
+		return requestError(err)
+	}
+
+	if resp == nil {
+		return nil
+	}
+
+	reply, err := cloudevents.New(c.source, subject, res)
+	if err != nil {
+		return requestError(fmt.Errorf("encode reply: %w", err))
+	}
+
+	if err := cloudevents.DecodeInto(cloudevents.WithEvent(ctx, reply), reply.Data, resp); err != nil {
+		return fmt.Errorf("decode reply from subject %s: %w", subject, err)
+	}
+
+	return nil
+}
+
+func (c *client) Respond(subject string, handler model.BrokerRequestHandler) error {
+	// Контекст обработчиков отменяется при закрытии клиента
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		cancel()
+		return ErrClientClosed
+	}
+
+	c.responders[subject] = append(c.responders[subject], &responder{
+		ctx:     ctx,
+		handler: handler,
+	})
+	c.cancels = append(c.cancels, cancel)
+
+	return nil
+}
+
+// responder выбирает обработчик запроса по очереди и учитывает начало
+// обработки. После начала Drain запросы отклоняются
+func (c *client) responder(subject string) (*responder, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	responders := c.responders[subject]
+	if len(responders) == 0 || c.closed {
+		return nil, fmt.Errorf("request to subject %s: %w", subject, model.ErrBrokerNoResponders)
+	}
+
+	if !c.track() {
+		return nil, &model.BrokerRequestError{
+			Code:    model.BrokerRequestErrorUnavailable,
+			Message: "responder is shutting down",
+		}
+	}
+
+	r := responders[c.turns[subject]%len(responders)]
+	c.turns[subject]++
+
+	return r, nil
+}
+
+// requestError приводит ошибку обработчика к ошибке, которую вернул бы клиент
+// NATS, передав ее через заголовок ответа так же, как он
+func requestError(err error) error {
+	return broker_headers.DecodeError(broker_headers.EncodeError(err))
+}
@@ -0,0 +1,77 @@
+package memory_broker
+
+import (
+	"context"
+
+	"boilerplate/internal/model"
+)
+
+func (c *client) GetStreamStats(_ context.Context) ([]*model.BrokerStreamStats, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res := make([]*model.BrokerStreamStats, 0, len(c.topics))
+	for _, t := range c.topics {
+		stats := &model.BrokerStreamStats{
+			Topic:    t.config.Name,
+			Messages: uint64(len(t.messages)),
+			LastID:   t.lastID,
+		}
+
+		// Как в JetStream, после очистки первым считается следующий номер
+		if len(t.messages) > 0 {
+			stats.FirstID = t.messages[0].ID
+		} else if t.lastID > 0 {
+			stats.FirstID = t.lastID + 1
+		}
+
+		for _, message := range t.messages {
+			stats.Bytes += uint64(len(message.Data))
+		}
+
+		if !t.lastTime.IsZero() {
+			lastTime := t.lastTime
+			stats.LastTime = &lastTime
+		}
+
+		for _, s := range c.consumers {
+			if s.topic.Name == t.config.Name {
+				stats.Consumers++
+			}
+		}
+
+		res = append(res, stats)
+	}
+
+	return res, nil
+}
+
+// GetConsumerStats возвращает состояние консьюмеров. Сообщения обрабатываются
+// синхронно, поэтому неподтвержденных и доставленных повторно нет
+func (c *client) GetConsumerStats(_ context.Context) ([]*model.BrokerConsumerStats, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res := make([]*model.BrokerConsumerStats, 0, len(c.consumers))
+	for _, s := range c.consumers {
+		stats := &model.BrokerConsumerStats{
+			Topic:      s.topic.Name,
+			Consumer:   s.consumerName,
+			Name:       s.name,
+			Subject:    s.subject,
+			LastActive: s.lastActive,
+		}
+
+		if t := c.topic(s.topic.Name); t != nil {
+			for _, message := range t.messages {
+				if message.ID > s.delivered && message.Subject == s.subject {
+					stats.Pending++
+				}
+			}
+		}
+
+		res = append(res, stats)
+	}
+
+	return res, nil
+}
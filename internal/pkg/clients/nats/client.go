@@ -2,47 +2,90 @@ package nats
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 
 	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/broker_headers"
+	"boilerplate/internal/pkg/cloudevents"
 	logger_pkg "boilerplate/internal/pkg/logger"
-	"boilerplate/internal/pkg/metadata"
 )
 
-const (
-	headerKey       = "X-Key"
-	headerRequestID = "X-Request-ID"
-	headerOrgID     = "X-Org-ID"
-	headerUserID    = "X-User-ID"
-	headerIP        = "X-IP"
-)
+const defaultSource = "boilerplate"
+
+// partitionSuffix отделяет номер партиции в subject топика
+const partitionSuffix = ".p"
+
+// Client клиент брокера сообщений с доступом к JetStream для хранилищ
+// ключ-значение и объектов на том же сервере
+type Client interface {
+	model.BrokerClient
+	JetStream() jetstream.JetStream
+}
 
 type client struct {
 	logger   logger_pkg.Logger
 	name     string
+	source   string
 	url      string
 	conn     net.Conn
 	nc       *nats.Conn
 	js       jetstream.JetStream
 	contexts []jetstream.ConsumeContext
+
+	tracerProvider trace.TracerProvider
+	tracer         trace.Tracer
+	// requestWorkers количество запросов subject, обрабатываемых одновременно
+	requestWorkers int
+
+	mu sync.RWMutex
+	// partitions количество партиций топиков, в которые публикует клиент
+	partitions map[string]int
+	// topics топики, созданные клиентом, в порядке создания
+	topics []string
+	// subscriptions консьюмеры партиций, созданные клиентом
+	subscriptions []*subscription
+	// cancels отменяют контексты обработчиков подписок
+	cancels []context.CancelFunc
+	// responders подписки обработчиков запросов
+	responders []*nats.Subscription
+	// inFlight сообщения и запросы, которые обрабатываются в данный момент
+	inFlight sync.WaitGroup
+	// draining устанавливается, когда клиент перестает принимать новые
+	// сообщения и запросы
+	draining bool
 }
 
-func NewClient(logger logger_pkg.Logger, opts ...Option) (model.BrokerClient, error) {
+func NewClient(logger logger_pkg.Logger, opts ...Option) (Client, error) {
 	c := &client{
-		logger: logger,
+		logger:     logger,
+		partitions: map[string]int{},
 	}
 
 	for _, opt := range opts {
 		opt(c)
 	}
 
+	if c.source == "" {
+		c.source = defaultSource
+	}
+	if c.requestWorkers <= 0 {
+		c.requestWorkers = defaultRequestWorkers
+	}
+	if c.tracerProvider == nil {
+		c.tracerProvider = otel.GetTracerProvider()
+	}
+	c.tracer = c.tracerProvider.Tracer(tracerName)
+
 	var err error
 	if c.conn == nil {
 		c.nc, err = nats.Connect(c.url, nats.Name(c.name))
@@ -64,49 +107,89 @@ func NewClient(logger logger_pkg.Logger, opts ...Option) (model.BrokerClient, er
 	return c, nil
 }
 
-func (c *client) Publish(ctx context.Context, topic string, partition *int, key, data any) error {
-	c.logger.DebugKV(ctx, "publish to topic", "topic", topic, "partition", partition, "key", key)
+func (c *client) JetStream() jetstream.JetStream {
+	return c.js
+}
 
-	if partition != nil {
-		topic = fmt.Sprintf("%s.p%d", topic, *partition)
-	}
+func (c *client) Publish(ctx context.Context, topic string, partition *int, key, data any, opts ...model.PublishOption) (err error) {
+	c.logger.DebugKV(ctx, "publish to topic", "topic", topic, "key", key)
+
+	// Контекст спана публикации передается консьюмерам в заголовках сообщения
+	ctx, span := c.tracer.Start(ctx, "send "+topic, trace.WithSpanKind(trace.SpanKindProducer))
+	defer func() {
+		if err != nil {
+			recordError(span, err)
+		}
+		span.End()
+	}()
 
-	dataBytes, err := json.Marshal(data)
+	event, err := cloudevents.New(c.source, topic, data, opts...)
 	if err != nil {
-		return fmt.Errorf("marshal data for subject %s: %w", topic, err)
+		return fmt.Errorf("create event for topic %s: %w", topic, err)
 	}
 
-	// Создаем сообщение с заголовками из контекста
-	msg := nats.NewMsg(topic)
-	msg.Data = dataBytes
-
-	msg.Header.Add(headerKey, fmt.Sprintf("%v", key))
+	keyValue := fmt.Sprintf("%v", key)
 
-	// Извлекаем метаданные из контекста и добавляем в заголовки
-	if requestID, ok := metadata.GetRequestID(ctx); ok {
-		msg.Header.Add(headerRequestID, requestID)
-	}
-	if userID, ok := metadata.GetUserID(ctx); ok {
-		msg.Header.Add(headerUserID, fmt.Sprintf("%d", userID))
+	// Сообщения с одним ключом направляются в одну партицию, чтобы консьюмер
+	// обрабатывал их в порядке публикации
+	if partition == nil {
+		partitions, err := c.topicPartitions(ctx, topic)
+		if err != nil {
+			return err
+		}
+		partition = model.BrokerPartition(keyValue, partitions)
 	}
-	if ip, ok := metadata.GetIP(ctx); ok {
-		msg.Header.Add(headerIP, ip)
+
+	subject := topic
+	if partition != nil {
+		subject = partitionSubject(topic, *partition)
 	}
+	span.SetAttributes(sendAttributes(subject, event.ID, len(event.Data))...)
+
+	// Создаем сообщение с атрибутами события и заголовками из контекста
+	msg := nats.NewMsg(subject)
+	msg.Data = event.Data
+	event.WriteHeaders(msg.Header)
+
+	// Сервер отбрасывает сообщение с тем же ID, опубликованное повторно в
+	// пределах окна дедупликации топика
+	msg.Header.Set(jetstream.MsgIDHeader, event.ID)
+
+	msg.Header.Add(broker_headers.Key, keyValue)
+
+	// Извлекаем метаданные из контекста и добавляем в заголовки
+	broker_headers.WriteMetadata(ctx, broker_headers.Header(msg.Header))
 
 	pa, err := c.js.PublishMsg(ctx, msg)
 	if err != nil {
-		return fmt.Errorf("publish to topic %s: %w", topic, err)
+		return fmt.Errorf("publish to subject %s: %w", subject, err)
 	}
 
-	c.logger.DebugKV(ctx, "published to topic", "topic", topic, "sequence", pa.Sequence)
+	if pa.Duplicate {
+		c.logger.DebugKV(ctx, "duplicate message skipped", "subject", subject, "type", event.Type, "id", event.ID, "sequence", pa.Sequence)
+		return nil
+	}
+
+	c.logger.DebugKV(ctx, "published to topic", "subject", subject, "type", event.Type, "id", event.ID, "sequence", pa.Sequence)
 
 	return nil
 }
 
-// nolint: gocognit
-func (c *client) Subscribe(ctx context.Context, consumerName, description string, topic model.BrokerTopic, handler model.BrokerHandler) error {
+func (c *client) Subscribe(ctx context.Context, consumerName, description string, topic model.BrokerTopic, handler model.BrokerHandler, opts ...model.SubscribeOption) error {
 	c.logger.InfoKV(ctx, "subscribing to topic", "consumer", consumerName, "topic", topic.Name)
 
+	options := model.SubscribeOptions{
+		Workers: 1,
+	}
+	// Следующее сообщение партиции доставляется только после подтверждения
+	// предыдущего, в том числе когда оно ждет повторной доставки
+	if topic.Partitions > 0 {
+		options.MaxAckPending = 1
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	stream, err := c.js.Stream(ctx, topic.Name)
 	if err != nil {
 		return fmt.Errorf("get stream for topic %s: %w", topic.Name, err)
@@ -117,6 +200,12 @@ func (c *client) Subscribe(ctx context.Context, consumerName, description string
 		return fmt.Errorf("get stream info %s: %w", topic.Name, err)
 	}
 
+	// Контекст обработчиков отменяется при закрытии клиента
+	ctx, cancel := context.WithCancel(ctx)
+	c.mu.Lock()
+	c.cancels = append(c.cancels, cancel)
+	c.mu.Unlock()
+
 	for _, subject := range info.Config.Subjects {
 		cn := consumerName + "-" + subject
 		cn = strings.ReplaceAll(cn, ".", "-")
@@ -126,106 +215,112 @@ func (c *client) Subscribe(ctx context.Context, consumerName, description string
 			Description:   description,
 			FilterSubject: subject,
 			AckPolicy:     jetstream.AckExplicitPolicy,
-			MaxDeliver:    topic.Retries + 1,
+			AckWait:       options.AckWait,
+			MaxDeliver:    topic.Retry.MaxAttempts + 1,
+			MaxAckPending: options.MaxAckPending,
 			DeliverPolicy: jetstream.DeliverAllPolicy,
 		})
 		if err != nil {
 			return fmt.Errorf("create or update consumer for subject %s: %w", subject, err)
 		}
 
+		consumeOpts := []jetstream.PullConsumeOpt{}
+		if options.BatchSize > 0 {
+			consumeOpts = append(consumeOpts, jetstream.PullMaxMessages(options.BatchSize))
+		}
+
+		s := &subscription{
+			consumerName: consumerName,
+			name:         cn,
+			subject:      subject,
+			topic:        topic,
+			options:      options,
+			handler:      handler,
+			// Сигнал о ходе обработки отправляется чаще, чем истекает AckWait
+			heartbeat: natsConsumer.CachedInfo().Config.AckWait / 2,
+			workers:   make(chan struct{}, max(options.Workers, 1)),
+		}
+
 		natsContext, err := natsConsumer.Consume(func(msg jetstream.Msg) {
-			md, err := msg.Metadata()
-			if err != nil {
-				c.logger.ErrorKV(ctx, "get message metadata error", "consumer", consumerName, "subject", msg.Subject(), "error", err.Error())
-				return
-			}
-
-			key := msg.Headers().Get(headerKey)
-
-			requestID := msg.Headers().Get(headerRequestID)
-			if requestID != "" {
-				ctx = metadata.WithRequestID(ctx, requestID)
-			}
-
-			userIDstr := msg.Headers().Get(headerUserID)
-			if userIDstr != "" {
-				userID, err := strconv.Atoi(userIDstr)
-				if err != nil {
-					c.logger.ErrorKV(ctx, "invalid user ID in message header", "consumer", consumerName, "subject", msg.Subject(), "error", err.Error())
-				} else {
-					ctx = metadata.WithUserID(ctx, userID)
-				}
-			}
-
-			ip := msg.Headers().Get(headerIP)
-			if ip != "" {
-				ctx = metadata.WithIP(ctx, ip)
-			}
-
-			c.logger.DebugKV(ctx, "message received", "consumer", cn, "subject", msg.Subject())
-
-			err = handler(ctx, msg.Subject(), msg.Data())
-			if err != nil {
-				c.logger.ErrorKV(ctx, "handle message error", "consumer", cn, "subject", msg.Subject(), "error", err.Error())
-				if md.NumDelivered >= uint64(topic.Retries) {
-					c.logger.WarnKV(ctx, "message reached max delivery attempts", "consumer", cn, "subject", msg.Subject(), "attempts", md.NumDelivered)
-
-					// Публикуем в DLQ перед Ack
-					err = c.Publish(ctx, topic.DLQTopicName, nil, key, msg.Data())
-					if err != nil {
-						c.logger.ErrorKV(ctx, "publish to DLQ error", "consumer", cn, "subject", msg.Subject(), "error", err.Error())
-						return
-					}
-					c.logger.InfoKV(ctx, "message sent to DLQ", "consumer", cn, "subject", msg.Subject(), "dlq_topic", topic.DLQTopicName)
-
-					if err = msg.Ack(); err != nil {
-						c.logger.ErrorKV(ctx, "ack message error", "consumer", cn, "subject", msg.Subject(), "error", err.Error())
-						return
-					}
-
-					return
-				}
-
-				if topic.Retries > 0 && md.NumDelivered < uint64(topic.Retries) {
-					if err := msg.NakWithDelay(topic.RetriesDelay); err != nil {
-						c.logger.ErrorKV(ctx, "nak with delay message", "consumer", cn, "subject", msg.Subject(), "error", err.Error())
-						return
-					}
-					c.logger.DebugKV(ctx, "nak message with delay", "consumer", cn, "subject", msg.Subject(), "attempts", md.NumDelivered)
-					return
-				}
-
-				if err := msg.TermWithReason(err.Error()); err != nil {
-					c.logger.ErrorKV(ctx, "term message error", "consumer", cn, "subject", msg.Subject(), "error", err.Error())
-				}
-
-				return
-			}
-
-			if err = msg.Ack(); err != nil {
-				c.logger.ErrorKV(ctx, "ack message error", "consumer", cn, "subject", msg.Subject(), "error", err.Error())
-				return
-			}
-
-			c.logger.DebugKV(ctx, "message processed successfully", "consumer", cn, "subject", msg.Subject())
-		})
+			c.dispatch(ctx, s, msg)
+		}, consumeOpts...)
 		if err != nil {
 			return fmt.Errorf("start to consume messages for subject %s: %w", subject, err)
 		}
 
-		c.logger.DebugKV(ctx, "subscribed to subject", "consumer", cn, "subject", subject)
+		c.logger.DebugKV(ctx, "subscribed to subject", "consumer", cn, "subject", subject, "workers", options.Workers)
 
+		c.mu.Lock()
 		c.contexts = append(c.contexts, natsContext)
+		c.subscriptions = append(c.subscriptions, s)
+		c.mu.Unlock()
 	}
 
 	return nil
 }
 
+// track учитывает начало обработки сообщения или запроса и возвращает false,
+// если клиент уже начал Drain
+func (c *client) track() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.draining {
+		return false
+	}
+	c.inFlight.Add(1)
+	return true
+}
+
 func (c *client) InProcessConn() (net.Conn, error) {
 	return c.conn, nil
 }
 
+func (c *client) Drain(ctx context.Context) error {
+	c.mu.Lock()
+	c.draining = true
+	contexts := c.contexts
+	responders := c.responders
+	c.mu.Unlock()
+
+	for _, natsContext := range contexts {
+		natsContext.Drain()
+	}
+	for _, sub := range responders {
+		if err := sub.Drain(); err != nil {
+			c.logger.ErrorKV(ctx, "drain responder error", "subject", sub.Subject, "error", err.Error())
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, natsContext := range contexts {
+			<-natsContext.Closed()
+		}
+		c.inFlight.Wait()
+	}()
+
+	select {
+	case <-done:
+		c.logger.Info(ctx, "nats consumers drained")
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("wait for in-flight messages: %w", ctx.Err())
+	}
+}
+
 func (c *client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Обработчики, не завершившиеся при Drain, получают отмену контекста, а их
+	// сообщения будут доставлены повторно
+	for _, cancel := range c.cancels {
+		cancel()
+	}
+	c.cancels = nil
+
 	for _, ctx := range c.contexts {
 		ctx.Stop()
 	}
@@ -238,7 +333,7 @@ func (c *client) CreateOrUpdateTopic(ctx context.Context, topic model.BrokerTopi
 	subjects := []string{}
 	if topic.Partitions > 0 {
 		for i := 0; i < topic.Partitions; i++ {
-			subjects = append(subjects, fmt.Sprintf("%s.p%d", topic.Name, i))
+			subjects = append(subjects, partitionSubject(topic.Name, i))
 		}
 	} else {
 		subjects = append(subjects, topic.Name)
@@ -254,12 +349,18 @@ func (c *client) CreateOrUpdateTopic(ctx context.Context, topic model.BrokerTopi
 		info.Config.Subjects = subjects
 		info.Config.MaxBytes = topic.MaxBytes
 		info.Config.MaxAge = topic.MaxAge
+		if topic.DuplicateWindow > 0 {
+			info.Config.Duplicates = topic.DuplicateWindow
+		}
 
 		_, err = c.js.UpdateStream(ctx, info.Config)
 		if err != nil {
 			return fmt.Errorf("update stream %s with new subject: %w", topic.Name, err)
 		}
 
+		c.setTopicPartitions(topic.Name, topic.Partitions)
+		c.addTopic(topic.Name)
+
 		return nil
 	}
 
@@ -269,10 +370,66 @@ func (c *client) CreateOrUpdateTopic(ctx context.Context, topic model.BrokerTopi
 		Subjects:    subjects,
 		MaxBytes:    topic.MaxBytes,
 		MaxAge:      topic.MaxAge,
+		Duplicates:  topic.DuplicateWindow,
 	})
 	if err != nil {
 		return fmt.Errorf("create stream %s: %w", topic.Name, err)
 	}
 
+	c.setTopicPartitions(topic.Name, topic.Partitions)
+	c.addTopic(topic.Name)
+
 	return nil
 }
+
+// topicPartitions возвращает количество партиций топика. Топик, созданный
+// другим процессом, определяется по subject его стрима
+func (c *client) topicPartitions(ctx context.Context, topic string) (int, error) {
+	c.mu.RLock()
+	partitions, ok := c.partitions[topic]
+	c.mu.RUnlock()
+	if ok {
+		return partitions, nil
+	}
+
+	stream, err := c.js.Stream(ctx, topic)
+	if err != nil {
+		return 0, fmt.Errorf("get stream for topic %s: %w", topic, err)
+	}
+
+	info, err := stream.Info(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get stream info %s: %w", topic, err)
+	}
+
+	partitions = 0
+	for _, subject := range info.Config.Subjects {
+		if strings.HasPrefix(subject, topic+partitionSuffix) {
+			partitions++
+		}
+	}
+
+	c.setTopicPartitions(topic, partitions)
+
+	return partitions, nil
+}
+
+func (c *client) setTopicPartitions(topic string, partitions int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.partitions[topic] = partitions
+}
+
+func (c *client) addTopic(topic string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !slices.Contains(c.topics, topic) {
+		c.topics = append(c.topics, topic)
+	}
+}
+
+func partitionSubject(topic string, partition int) string {
+	return topic + partitionSuffix + strconv.Itoa(partition)
+}
@@ -0,0 +1,801 @@
+package nats_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+
+	"boilerplate/internal/model"
+	nats_client "boilerplate/internal/pkg/clients/nats"
+	"boilerplate/internal/pkg/cloudevents"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	logger_pkg "boilerplate/internal/pkg/logger"
+	"boilerplate/internal/pkg/metadata"
+	nats_server "boilerplate/internal/pkg/servers/nats"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/pkg/pb/events"
+)
+
+// newClient запускает встроенный сервер NATS с JetStream и подключает к нему клиента
+func newClient(t *testing.T, opts ...nats_client.Option) model.BrokerClient {
+	t.Helper()
+
+	server, err := nats_server.NewServer(&model.ConfigNats{
+		Host:     "127.0.0.1",
+		Port:     "-1",
+		HTTPPort: "0",
+		DataDir:  t.TempDir(),
+	}, nats_server.WithJetStream("TEST"))
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	t.Cleanup(func() {
+		require.NoError(t, server.Stop())
+	})
+
+	conn, err := server.GetConn()
+	require.NoError(t, err)
+
+	logger, err := logger_pkg.New()
+	require.NoError(t, err)
+
+	opts = append([]nats_client.Option{nats_client.WithConn(conn), nats_client.WithSource("test-source")}, opts...)
+	client, err := nats_client.NewClient(logger, opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close())
+	})
+
+	return client
+}
+
+func TestPublishCloudEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newClient(t)
+
+	topic := model.BrokerTopic{
+		Name:       "events-test",
+		Partitions: 2,
+		MaxAge:     time.Hour,
+		MaxBytes:   1024 * 1024,
+	}
+	require.NoError(t, client.CreateOrUpdateTopic(ctx, topic))
+
+	type received struct {
+		event  *cloudevents.Event
+		data   *events.UserCreated
+		userID int
+	}
+	receivedCh := make(chan received, 1)
+
+	err := cloudevents.Subscribe(ctx, client, "test-consumer", "", topic, func(ctx context.Context, _ string, data *events.UserCreated) error {
+		event, _ := cloudevents.FromContext(ctx)
+		userID, _ := metadata.GetUserID(ctx)
+		receivedCh <- received{event: event, data: data, userID: userID}
+		return nil
+	})
+	require.NoError(t, err)
+
+	message := &events.UserCreated{UserId: 42, Name: "name"}
+	eventTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	err = client.Publish(metadata.WithUserID(ctx, 7), topic.Name, utils.Ptr(1), "42", message,
+		model.WithEventID("event-1"), model.WithEventTime(eventTime))
+	require.NoError(t, err)
+
+	select {
+	case r := <-receivedCh:
+		require.True(t, proto.Equal(message, r.data))
+		require.Equal(t, "event-1", r.event.ID)
+		require.Equal(t, "test-source", r.event.Source)
+		require.Equal(t, "events.UserCreated", r.event.Type)
+		require.Equal(t, cloudevents.SpecVersion, r.event.SpecVersion)
+		require.Equal(t, cloudevents.ContentTypeProtobuf, r.event.DataContentType)
+		require.True(t, eventTime.Equal(r.event.Time))
+		require.Equal(t, 7, r.userID)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "message not received")
+	}
+}
+
+func TestPublishDeduplication(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newClient(t)
+
+	topic := model.BrokerTopic{
+		Name:            "dedup-test",
+		MaxAge:          time.Hour,
+		MaxBytes:        1024 * 1024,
+		DuplicateWindow: time.Minute,
+	}
+	require.NoError(t, client.CreateOrUpdateTopic(ctx, topic))
+
+	// Повторная публикация события с тем же ID отбрасывается сервером
+	for _, id := range []string{"event-1", "event-1", "event-2"} {
+		err := client.Publish(ctx, topic.Name, nil, "key", map[string]string{"id": id}, model.WithEventID(id))
+		require.NoError(t, err)
+	}
+
+	messages, err := client.GetMessages(ctx, topic.Name, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	require.Equal(t, "event-1", messages[0].Header("Nats-Msg-Id"))
+	require.Equal(t, "event-2", messages[1].Header("Nats-Msg-Id"))
+
+	// Сохраненное сообщение публикуется повторно без ID дедупликации
+	require.NoError(t, client.PublishMessage(ctx, messages[0]))
+
+	messages, err = client.GetMessages(ctx, topic.Name, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+	require.Equal(t, "event-1", messages[2].Header(cloudevents.HeaderID))
+	require.Empty(t, messages[2].Header("Nats-Msg-Id"))
+}
+
+func TestPublishPartitionRouting(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newClient(t)
+
+	topic := model.BrokerTopic{
+		Name:       "routing-test",
+		Partitions: 3,
+		MaxAge:     time.Hour,
+		MaxBytes:   1024 * 1024,
+	}
+	require.NoError(t, client.CreateOrUpdateTopic(ctx, topic))
+
+	// Без явной партиции сообщения с одним ключом попадают в одну партицию
+	keys := []int{1, 2, 3, 4, 5, 1, 2}
+	for _, key := range keys {
+		require.NoError(t, client.Publish(ctx, topic.Name, nil, key, map[string]int{"key": key}))
+	}
+
+	messages, err := client.GetMessages(ctx, topic.Name, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, messages, len(keys))
+
+	for i, message := range messages {
+		key := strconv.Itoa(keys[i])
+		require.Equal(t, key, message.Header("X-Key"))
+		require.Equal(t, topic.Name+".p"+strconv.Itoa(*model.BrokerPartition(key, topic.Partitions)), message.Subject)
+	}
+
+	// Явная партиция имеет приоритет
+	require.NoError(t, client.Publish(ctx, topic.Name, utils.Ptr(2), 1, map[string]int{"key": 1}))
+
+	message, err := client.GetMessage(ctx, topic.Name, uint64(len(keys)+1))
+	require.NoError(t, err)
+	require.Equal(t, topic.Name+".p2", message.Subject)
+}
+
+func TestSubscribePartitionOrdering(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newClient(t)
+
+	topic := model.BrokerTopic{
+		Name:       "ordering-test",
+		Partitions: 1,
+		MaxAge:     time.Hour,
+		MaxBytes:   1024 * 1024,
+		Retry: model.RetryPolicy{
+			MaxAttempts:  3,
+			InitialDelay: 50 * time.Millisecond,
+		},
+	}
+	require.NoError(t, client.CreateOrUpdateTopic(ctx, topic))
+
+	// Первое сообщение обрабатывается со второй попытки, а следующее за ним
+	// сообщение партиции ждет его подтверждения
+	var failed atomic.Bool
+	handled := make(chan int, 10)
+	err := client.Subscribe(ctx, "test-consumer", "", topic, func(_ context.Context, _ string, data []byte) error {
+		var value map[string]int
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		if value["seq"] == 1 && failed.CompareAndSwap(false, true) {
+			handled <- -1
+			return errors.New("temporary error")
+		}
+		handled <- value["seq"]
+		return nil
+	})
+	require.NoError(t, err)
+
+	for seq := 1; seq <= 3; seq++ {
+		require.NoError(t, client.Publish(ctx, topic.Name, nil, "key", map[string]int{"seq": seq}))
+	}
+
+	order := []int{}
+	for range 4 {
+		select {
+		case seq := <-handled:
+			order = append(order, seq)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "message not handled", "handled: %v", order)
+		}
+	}
+	require.Equal(t, []int{-1, 1, 2, 3}, order)
+}
+
+func TestSubscribeErrorClassification(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newClient(t)
+
+	dlq := model.BrokerTopic{Name: "classification-test-dlq", MaxAge: time.Hour, MaxBytes: 1024 * 1024}
+	topic := model.BrokerTopic{
+		Name:     "classification-test",
+		MaxAge:   time.Hour,
+		MaxBytes: 1024 * 1024,
+		// Задержка политики заведомо больше времени теста
+		Retry: model.RetryPolicy{
+			MaxAttempts:  5,
+			InitialDelay: time.Hour,
+		},
+		DLQTopicName: dlq.Name,
+	}
+	require.NoError(t, client.CreateOrUpdateTopic(ctx, dlq))
+	require.NoError(t, client.CreateOrUpdateTopic(ctx, topic))
+
+	var mu sync.Mutex
+	attempts := map[string]int{}
+	handled := make(chan string, 10)
+	err := client.Subscribe(ctx, "test-consumer", "", topic, func(ctx context.Context, _ string, _ []byte) error {
+		event, _ := cloudevents.FromContext(ctx)
+
+		mu.Lock()
+		attempts[event.ID]++
+		attempt := attempts[event.ID]
+		mu.Unlock()
+
+		switch {
+		case event.ID == "permanent":
+			return model.ErrPermanent(errors.New("invalid payload"))
+		case event.ID == "retry-after" && attempt == 1:
+			return fmt.Errorf("rate limited: %w", model.ErrRetryAfter(10*time.Millisecond))
+		}
+
+		handled <- event.ID
+		return nil
+	})
+	require.NoError(t, err)
+
+	for _, id := range []string{"permanent", "retry-after"} {
+		require.NoError(t, client.Publish(ctx, topic.Name, nil, id, map[string]string{}, model.WithEventID(id)))
+	}
+
+	// Обработчик выбирает задержку вместо политики топика
+	select {
+	case id := <-handled:
+		require.Equal(t, "retry-after", id)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "message not retried")
+	}
+
+	// Постоянная ошибка перемещает сообщение в DLQ после первой попытки
+	var messages []*model.BrokerMessage
+	require.Eventually(t, func() bool {
+		messages, err = client.GetMessages(ctx, dlq.Name, 0, 10)
+		require.NoError(t, err)
+		return len(messages) == 1
+	}, 5*time.Second, 20*time.Millisecond)
+
+	require.Equal(t, "permanent", messages[0].Header(cloudevents.HeaderID))
+	require.Equal(t, "1", messages[0].Header(model.BrokerHeaderDLQAttempts))
+	require.Equal(t, "invalid payload", messages[0].Header(model.BrokerHeaderDLQError))
+}
+
+func TestSubscribeWorkers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newClient(t)
+
+	topic := model.BrokerTopic{Name: "workers-test", MaxAge: time.Hour, MaxBytes: 1024 * 1024}
+	require.NoError(t, client.CreateOrUpdateTopic(ctx, topic))
+
+	// Обработчики ждут, пока одновременно не будут заняты все три
+	var active, maxActive atomic.Int32
+	release := make(chan struct{})
+	handled := make(chan struct{}, 10)
+	err := client.Subscribe(ctx, "test-consumer", "", topic, func(context.Context, string, []byte) error {
+		current := active.Add(1)
+		defer active.Add(-1)
+		for {
+			prev := maxActive.Load()
+			if current <= prev || maxActive.CompareAndSwap(prev, current) {
+				break
+			}
+		}
+
+		<-release
+		handled <- struct{}{}
+		return nil
+	}, model.WithWorkers(3))
+	require.NoError(t, err)
+
+	for i := range 5 {
+		require.NoError(t, client.Publish(ctx, topic.Name, nil, i, map[string]int{"i": i}))
+	}
+
+	require.Eventually(t, func() bool {
+		return active.Load() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	close(release)
+
+	for range 5 {
+		select {
+		case <-handled:
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "message not handled")
+		}
+	}
+	require.Equal(t, int32(3), maxActive.Load())
+}
+
+func TestSubscribeHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newClient(t)
+
+	topic := model.BrokerTopic{
+		Name:     "heartbeat-test",
+		MaxAge:   time.Hour,
+		MaxBytes: 1024 * 1024,
+		Retry:    model.RetryPolicy{MaxAttempts: 3},
+	}
+	require.NoError(t, client.CreateOrUpdateTopic(ctx, topic))
+
+	// Обработка длится дольше AckWait, но сообщение не доставляется повторно
+	var deliveries atomic.Int32
+	handled := make(chan error, 1)
+	err := client.Subscribe(ctx, "test-consumer", "", topic, func(ctx context.Context, _ string, _ []byte) error {
+		deliveries.Add(1)
+		select {
+		case <-time.After(time.Second):
+			handled <- nil
+		case <-ctx.Done():
+			handled <- ctx.Err()
+		}
+		return nil
+	}, model.WithWorkers(2), model.WithAckWait(200*time.Millisecond), model.WithHandlerTimeout(5*time.Second))
+	require.NoError(t, err)
+
+	require.NoError(t, client.Publish(ctx, topic.Name, nil, "key", map[string]string{}))
+
+	select {
+	case err := <-handled:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "message not handled")
+	}
+
+	time.Sleep(300 * time.Millisecond)
+	require.Equal(t, int32(1), deliveries.Load())
+}
+
+func TestSubscribeHandlerTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newClient(t)
+
+	topic := model.BrokerTopic{Name: "timeout-test", MaxAge: time.Hour, MaxBytes: 1024 * 1024}
+	require.NoError(t, client.CreateOrUpdateTopic(ctx, topic))
+
+	handled := make(chan error, 1)
+	err := client.Subscribe(ctx, "test-consumer", "", topic, func(ctx context.Context, _ string, _ []byte) error {
+		<-ctx.Done()
+		handled <- ctx.Err()
+		return ctx.Err()
+	}, model.WithHandlerTimeout(50*time.Millisecond))
+	require.NoError(t, err)
+
+	require.NoError(t, client.Publish(ctx, topic.Name, nil, "key", map[string]string{}))
+
+	select {
+	case err := <-handled:
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "handler not cancelled")
+	}
+}
+
+func TestDrain(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newClient(t)
+
+	topic := model.BrokerTopic{Name: "drain-test", MaxAge: time.Hour, MaxBytes: 1024 * 1024}
+	require.NoError(t, client.CreateOrUpdateTopic(ctx, topic))
+
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	var handled atomic.Int32
+	err := client.Subscribe(ctx, "test-consumer", "", topic, func(context.Context, string, []byte) error {
+		started <- struct{}{}
+		<-release
+		handled.Add(1)
+		return nil
+	})
+	require.NoError(t, err)
+
+	for i := range 3 {
+		require.NoError(t, client.Publish(ctx, topic.Name, nil, i, map[string]int{"i": i}))
+	}
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "message not received")
+	}
+
+	// Время ожидания истекает, пока сообщение обрабатывается
+	drainCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, client.Drain(drainCtx), context.DeadlineExceeded)
+
+	// Drain дожидается обрабатываемого сообщения, а остальные сообщения
+	// возвращаются на сервер необработанными
+	drained := make(chan error, 1)
+	go func() {
+		drained <- client.Drain(ctx)
+	}()
+	close(release)
+
+	select {
+	case err := <-drained:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "drain not finished")
+	}
+	require.Equal(t, int32(1), handled.Load())
+}
+
+func TestRequest(t *testing.T) {
+	t.Parallel()
+
+	client := newClient(t)
+
+	type received struct {
+		requestID   string
+		userID      int
+		hasDeadline bool
+	}
+	receivedCh := make(chan received, 10)
+
+	err := cloudevents.Respond(client, "users.get", func(ctx context.Context, _ string, req *events.UserDeleted) (any, error) {
+		requestID, _ := metadata.GetRequestID(ctx)
+		userID, _ := metadata.GetUserID(ctx)
+		_, hasDeadline := ctx.Deadline()
+		receivedCh <- received{requestID: requestID, userID: userID, hasDeadline: hasDeadline}
+
+		switch req.GetUserId() {
+		case 404:
+			return nil, errors_pkg.NewNotFoundError("user not found")
+		case 500:
+			return nil, errors.New("database is down")
+		}
+		return &events.UserCreated{UserId: req.GetUserId(), Name: "name"}, nil
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.WithUserID(metadata.WithRequestID(context.Background(), "request-1"), 7)
+
+	// Ответ декодируется в переданное значение, метаданные и дедлайн
+	// передаются обработчику
+	resp := &events.UserCreated{}
+	require.NoError(t, client.Request(ctx, "users.get", &events.UserDeleted{UserId: 42}, resp))
+	require.True(t, proto.Equal(&events.UserCreated{UserId: 42, Name: "name"}, resp))
+	require.Equal(t, received{requestID: "request-1", userID: 7, hasDeadline: true}, <-receivedCh)
+
+	// Ошибки пакета errors восстанавливаются по коду
+	err = client.Request(ctx, "users.get", &events.UserDeleted{UserId: 404}, resp)
+	require.True(t, errors_pkg.IsErrNotFound(err))
+	require.Equal(t, "user not found", err.Error())
+
+	err = client.Request(ctx, "users.get", &events.UserDeleted{UserId: 500}, resp)
+	var requestErr *model.BrokerRequestError
+	require.ErrorAs(t, err, &requestErr)
+	require.Equal(t, model.BrokerRequestErrorInternal, requestErr.Code)
+	require.Equal(t, "database is down", requestErr.Message)
+
+	// Запрос, который обработчик не смог декодировать
+	err = client.Request(ctx, "users.get", map[string]string{"user_id": "invalid"}, resp)
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+
+	err = client.Request(ctx, "users.unknown", &events.UserDeleted{UserId: 42}, resp)
+	require.ErrorIs(t, err, model.ErrBrokerNoResponders)
+}
+
+func TestRequestDeadline(t *testing.T) {
+	t.Parallel()
+
+	client := newClient(t)
+
+	handled := make(chan error, 1)
+	err := client.Respond("slow", func(ctx context.Context, _ string, _ []byte) (any, error) {
+		<-ctx.Done()
+		handled <- ctx.Err()
+		return nil, ctx.Err()
+	})
+	require.NoError(t, err)
+
+	// Обработчик получает дедлайн запрашивающего
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err = client.Request(ctx, "slow", map[string]string{}, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	select {
+	case err := <-handled:
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "handler deadline not exceeded")
+	}
+}
+
+func TestRespondQueueGroup(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newClient(t)
+
+	// Каждый запрос обрабатывает только один обработчик группы
+	var calls atomic.Int32
+	for range 2 {
+		err := client.Respond("queue", func(context.Context, string, []byte) (any, error) {
+			calls.Add(1)
+			return map[string]bool{"ok": true}, nil
+		})
+		require.NoError(t, err)
+	}
+
+	for range 10 {
+		resp := map[string]bool{}
+		require.NoError(t, client.Request(ctx, "queue", map[string]string{}, &resp))
+		require.True(t, resp["ok"])
+	}
+	require.Equal(t, int32(10), calls.Load())
+}
+
+func TestRespondWorkers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newClient(t, nats_client.WithRequestWorkers(2))
+
+	// Обработчики ждут, пока одновременно не будут заняты оба
+	var active, maxActive atomic.Int32
+	release := make(chan struct{})
+	err := client.Respond("workers", func(context.Context, string, []byte) (any, error) {
+		current := active.Add(1)
+		defer active.Add(-1)
+		for {
+			prev := maxActive.Load()
+			if current <= prev || maxActive.CompareAndSwap(prev, current) {
+				break
+			}
+		}
+
+		<-release
+		return map[string]bool{"ok": true}, nil
+	})
+	require.NoError(t, err)
+
+	errs := make(chan error, 5)
+	for range 5 {
+		go func() {
+			errs <- client.Request(ctx, "workers", map[string]string{}, nil)
+		}()
+	}
+
+	require.Eventually(t, func() bool {
+		return active.Load() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	close(release)
+
+	for range 5 {
+		select {
+		case err := <-errs:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "request not handled")
+		}
+	}
+	require.Equal(t, int32(2), maxActive.Load())
+}
+
+func TestStats(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newClient(t)
+
+	topic := model.BrokerTopic{Name: "stats-test", Partitions: 2, MaxAge: time.Hour, MaxBytes: 1024 * 1024}
+	require.NoError(t, client.CreateOrUpdateTopic(ctx, topic))
+
+	release := make(chan struct{})
+	err := client.Subscribe(ctx, "test-consumer", "", topic, func(context.Context, string, []byte) error {
+		<-release
+		return nil
+	})
+	require.NoError(t, err)
+
+	for i := range 5 {
+		require.NoError(t, client.Publish(ctx, topic.Name, nil, i, map[string]int{"i": i}))
+	}
+
+	streams, err := client.GetStreamStats(ctx)
+	require.NoError(t, err)
+	require.Len(t, streams, 1)
+	require.Equal(t, topic.Name, streams[0].Topic)
+	require.Equal(t, uint64(5), streams[0].Messages)
+	require.Equal(t, uint64(5), streams[0].LastID)
+	require.Equal(t, 2, streams[0].Consumers)
+	require.NotNil(t, streams[0].LastTime)
+	require.Positive(t, streams[0].Bytes)
+
+	// unhandled сообщения партиций, которые ждут доставки или подтверждения
+	unhandled := func() (uint64, []*model.BrokerConsumerStats) {
+		consumers, err := client.GetConsumerStats(ctx)
+		require.NoError(t, err)
+
+		total := uint64(0)
+		for _, consumer := range consumers {
+			total += consumer.Pending + uint64(consumer.AckPending)
+		}
+		return total, consumers
+	}
+
+	total, consumers := unhandled()
+	require.Equal(t, uint64(5), total)
+	require.Len(t, consumers, 2)
+	for i, consumer := range consumers {
+		require.Equal(t, topic.Name, consumer.Topic)
+		require.Equal(t, "test-consumer", consumer.Consumer)
+		require.Equal(t, fmt.Sprintf("%s.p%d", topic.Name, i), consumer.Subject)
+		require.LessOrEqual(t, consumer.AckPending, 1)
+	}
+
+	close(release)
+	require.Eventually(t, func() bool {
+		total, _ = unhandled()
+		return total == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, consumers = unhandled()
+	for _, consumer := range consumers {
+		require.NotNil(t, consumer.LastActive)
+		require.Zero(t, consumer.Redelivered)
+	}
+}
+
+func TestTracePropagation(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	client := newClient(t, nats_client.WithTracerProvider(provider))
+
+	topic := model.BrokerTopic{
+		Name:       "trace-test",
+		Partitions: 2,
+		MaxAge:     time.Hour,
+		MaxBytes:   1024 * 1024,
+		Retry:      model.RetryPolicy{MaxAttempts: 1},
+	}
+	require.NoError(t, client.CreateOrUpdateTopic(context.Background(), topic))
+
+	type received struct {
+		spanContext trace.SpanContext
+		baggage     string
+	}
+	receivedCh := make(chan received, 2)
+	err := client.Subscribe(context.Background(), "test-consumer", "", topic, func(ctx context.Context, _ string, data []byte) error {
+		receivedCh <- received{
+			spanContext: trace.SpanContextFromContext(ctx),
+			baggage:     baggage.FromContext(ctx).Member("tenant").Value(),
+		}
+		if string(data) == `"fail"` {
+			return model.ErrPermanent(errors.New("invalid payload"))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	member, err := baggage.NewMember("tenant", "acme")
+	require.NoError(t, err)
+	bag, err := baggage.New(member)
+	require.NoError(t, err)
+
+	ctx, parent := provider.Tracer("test").Start(baggage.ContextWithBaggage(context.Background(), bag), "request")
+	require.NoError(t, client.Publish(ctx, topic.Name, nil, "key", "ok"))
+	parent.End()
+
+	var got received
+	select {
+	case got = <-receivedCh:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "message not handled")
+	}
+	require.Equal(t, parent.SpanContext().TraceID(), got.spanContext.TraceID())
+	require.Equal(t, "acme", got.baggage)
+
+	// Спан обработки - дочерний для спана публикации, который дочерний для
+	// спана запроса
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	require.Eventually(t, func() bool {
+		for _, span := range recorder.Ended() {
+			spans[span.Name()] = span
+		}
+		return spans["process trace-test"] != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	send := spans["send trace-test"]
+	require.NotNil(t, send)
+	require.Equal(t, trace.SpanKindProducer, send.SpanKind())
+	require.Equal(t, parent.SpanContext().SpanID(), send.Parent().SpanID())
+
+	process := spans["process trace-test"]
+	require.Equal(t, trace.SpanKindConsumer, process.SpanKind())
+	require.Equal(t, send.SpanContext().SpanID(), process.Parent().SpanID())
+	require.True(t, process.Parent().IsRemote())
+	require.Equal(t, process.SpanContext().SpanID(), got.spanContext.SpanID())
+	require.Equal(t, codes.Unset, process.Status().Code)
+
+	attributes := map[attribute.Key]attribute.Value{}
+	for _, kv := range process.Attributes() {
+		attributes[kv.Key] = kv.Value
+	}
+	require.Equal(t, "nats", attributes["messaging.system"].AsString())
+	require.Equal(t, "process", attributes["messaging.operation.type"].AsString())
+	require.Equal(t, "test-consumer", attributes["messaging.consumer.group.name"].AsString())
+	require.Contains(t, attributes["messaging.destination.name"].AsString(), "trace-test.p")
+	require.NotEmpty(t, attributes["messaging.message.id"].AsString())
+
+	// Ошибка обработчика отмечается в спане обработки
+	require.NoError(t, client.Publish(context.Background(), topic.Name, nil, "key", "fail"))
+	select {
+	case got = <-receivedCh:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "message not handled")
+	}
+	require.NotEqual(t, parent.SpanContext().TraceID(), got.spanContext.TraceID())
+
+	require.Eventually(t, func() bool {
+		for _, span := range recorder.Ended() {
+			if span.Name() == "process trace-test" && span.SpanContext().TraceID() == got.spanContext.TraceID() {
+				return span.Status().Code == codes.Error
+			}
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,183 @@
+package nats
+
+import (
+	"context"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/trace"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/cloudevents"
+)
+
+// subscription консьюмер одной партиции топика
+type subscription struct {
+	consumerName string
+	// name имя консьюмера партиции на сервере
+	name    string
+	subject string
+	topic   model.BrokerTopic
+	options model.SubscribeOptions
+	handler model.BrokerHandler
+	// heartbeat интервал сигналов о ходе обработки сообщения
+	heartbeat time.Duration
+	// workers ограничивает количество одновременно обрабатываемых сообщений
+	workers chan struct{}
+}
+
+// dispatch передает сообщение свободному обработчику подписки. Пока все
+// обработчики заняты, следующие сообщения ждут в буфере клиента
+func (c *client) dispatch(ctx context.Context, s *subscription, msg jetstream.Msg) {
+	s.workers <- struct{}{}
+
+	// Сообщения, полученные после начала Drain, возвращаются на сервер, чтобы
+	// их обработал другой экземпляр приложения
+	if !c.track() {
+		<-s.workers
+		if err := msg.Nak(); err != nil {
+			c.logger.ErrorKV(ctx, "nak message error", "consumer", s.name, "subject", msg.Subject(), "error", err.Error())
+		}
+		return
+	}
+
+	go func() {
+		defer func() {
+			<-s.workers
+			c.inFlight.Done()
+		}()
+
+		c.handleMessage(ctx, s, msg)
+	}()
+}
+
+// nolint: gocognit
+func (c *client) handleMessage(ctx context.Context, s *subscription, msg jetstream.Msg) {
+	cn := s.name
+	topic := s.topic
+
+	md, err := msg.Metadata()
+	if err != nil {
+		c.logger.ErrorKV(ctx, "get message metadata error", "consumer", s.consumerName, "subject", msg.Subject(), "error", err.Error())
+		return
+	}
+
+	ctx = c.readMetadata(ctx, msg.Headers(), msg.Subject())
+
+	event := cloudevents.FromHeaders(msg.Headers(), msg.Data())
+	ctx = cloudevents.WithEvent(ctx, event)
+
+	// Спан обработки продолжает трассировку, начатую при публикации
+	ctx, span := c.tracer.Start(ctx, "process "+topic.Name,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(processAttributes(s, msg.Subject(), event.ID, len(msg.Data()), md.NumDelivered)...),
+	)
+	defer span.End()
+
+	c.logger.DebugKV(ctx, "message received", "consumer", cn, "subject", msg.Subject(), "type", event.Type, "id", event.ID)
+
+	handlerCtx := ctx
+	if s.options.HandlerTimeout > 0 {
+		var cancel context.CancelFunc
+		handlerCtx, cancel = context.WithTimeout(ctx, s.options.HandlerTimeout)
+		defer cancel()
+	}
+
+	stopHeartbeat := c.startHeartbeat(ctx, s, msg)
+	handleErr := s.handler(handlerCtx, msg.Subject(), msg.Data())
+	stopHeartbeat()
+
+	if handleErr != nil {
+		recordError(span, handleErr)
+		c.logger.ErrorKV(ctx, "handle message error", "consumer", cn, "subject", msg.Subject(), "error", handleErr.Error())
+
+		// Постоянная ошибка не исправится при повторной обработке
+		permanent := model.IsErrPermanent(handleErr)
+		exhausted := permanent || md.NumDelivered >= uint64(topic.Retry.MaxAttempts)
+
+		if topic.DLQTopicName != "" && exhausted {
+			if permanent {
+				c.logger.WarnKV(ctx, "message failed permanently", "consumer", cn, "subject", msg.Subject(), "attempts", md.NumDelivered)
+			} else {
+				c.logger.WarnKV(ctx, "message reached max delivery attempts", "consumer", cn, "subject", msg.Subject(), "attempts", md.NumDelivered)
+			}
+
+			// Публикуем в DLQ перед Ack
+			err = c.moveToDLQ(ctx, topic, s.consumerName, msg, md.NumDelivered, handleErr)
+			if err != nil {
+				c.logger.ErrorKV(ctx, "publish to DLQ error", "consumer", cn, "subject", msg.Subject(), "error", err.Error())
+				return
+			}
+			c.logger.InfoKV(ctx, "message sent to DLQ", "consumer", cn, "subject", msg.Subject(), "dlq_topic", topic.DLQTopicName)
+
+			if err = msg.Ack(); err != nil {
+				c.logger.ErrorKV(ctx, "ack message error", "consumer", cn, "subject", msg.Subject(), "error", err.Error())
+				return
+			}
+
+			return
+		}
+
+		if !exhausted {
+			// Обработчик может сам выбрать задержку вместо политики топика
+			delay, ok := model.RetryAfter(handleErr)
+			if !ok {
+				delay = topic.Retry.Delay(int(md.NumDelivered))
+			}
+
+			if err := msg.NakWithDelay(delay); err != nil {
+				c.logger.ErrorKV(ctx, "nak with delay message", "consumer", cn, "subject", msg.Subject(), "error", err.Error())
+				return
+			}
+			c.logger.DebugKV(ctx, "nak message with delay", "consumer", cn, "subject", msg.Subject(), "attempts", md.NumDelivered, "delay", delay)
+			return
+		}
+
+		if err := msg.TermWithReason(handleErr.Error()); err != nil {
+			c.logger.ErrorKV(ctx, "term message error", "consumer", cn, "subject", msg.Subject(), "error", err.Error())
+		}
+
+		return
+	}
+
+	if err = msg.Ack(); err != nil {
+		c.logger.ErrorKV(ctx, "ack message error", "consumer", cn, "subject", msg.Subject(), "error", err.Error())
+		return
+	}
+
+	c.logger.DebugKV(ctx, "message processed successfully", "consumer", cn, "subject", msg.Subject())
+}
+
+// startHeartbeat периодически сообщает серверу, что сообщение еще
+// обрабатывается, чтобы оно не было доставлено повторно по истечении AckWait.
+// Возвращает функцию, которая останавливает сигналы
+func (c *client) startHeartbeat(ctx context.Context, s *subscription, msg jetstream.Msg) func() {
+	if s.heartbeat <= 0 {
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(s.heartbeat)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := msg.InProgress(); err != nil {
+					c.logger.ErrorKV(ctx, "message in progress error", "consumer", s.name, "subject", msg.Subject(), "error", err.Error())
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
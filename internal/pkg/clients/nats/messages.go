@@ -0,0 +1,144 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/broker_headers"
+)
+
+// headerNatsPrefix служебные заголовки JetStream, которые не переносятся при
+// повторной публикации сохраненного сообщения
+const headerNatsPrefix = "Nats-"
+
+func (c *client) GetMessages(ctx context.Context, topic string, from uint64, limit int) ([]*model.BrokerMessage, error) {
+	stream, err := c.js.Stream(ctx, topic)
+	if err != nil {
+		return nil, fmt.Errorf("get stream for topic %s: %w", topic, err)
+	}
+
+	messages := []*model.BrokerMessage{}
+	for seq := max(from, 1); len(messages) < limit; {
+		// Номера удаленных сообщений пропускаются: возвращается ближайшее
+		// следующее сообщение любого subject топика
+		msg, err := stream.GetMsg(ctx, seq, jetstream.WithGetMsgSubject(">"))
+		if err != nil {
+			if errors.Is(err, jetstream.ErrMsgNotFound) {
+				break
+			}
+			return nil, fmt.Errorf("get message %d from topic %s: %w", seq, topic, err)
+		}
+
+		messages = append(messages, toBrokerMessage(msg))
+		seq = msg.Sequence + 1
+	}
+
+	return messages, nil
+}
+
+func (c *client) GetMessage(ctx context.Context, topic string, id uint64) (*model.BrokerMessage, error) {
+	stream, err := c.js.Stream(ctx, topic)
+	if err != nil {
+		return nil, fmt.Errorf("get stream for topic %s: %w", topic, err)
+	}
+
+	msg, err := stream.GetMsg(ctx, id)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			return nil, model.ErrBrokerMessageNotFound
+		}
+		return nil, fmt.Errorf("get message %d from topic %s: %w", id, topic, err)
+	}
+
+	return toBrokerMessage(msg), nil
+}
+
+func (c *client) PublishMessage(ctx context.Context, message *model.BrokerMessage) error {
+	msg := nats.NewMsg(message.Subject)
+	msg.Data = message.Data
+	for name, values := range message.Headers {
+		if strings.HasPrefix(name, headerNatsPrefix) {
+			continue
+		}
+		msg.Header[name] = values
+	}
+
+	if _, err := c.js.PublishMsg(ctx, msg); err != nil {
+		return fmt.Errorf("publish to subject %s: %w", message.Subject, err)
+	}
+
+	return nil
+}
+
+func (c *client) DeleteMessage(ctx context.Context, topic string, id uint64) error {
+	stream, err := c.js.Stream(ctx, topic)
+	if err != nil {
+		return fmt.Errorf("get stream for topic %s: %w", topic, err)
+	}
+
+	if err := stream.DeleteMsg(ctx, id); err != nil {
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			return model.ErrBrokerMessageNotFound
+		}
+		return fmt.Errorf("delete message %d from topic %s: %w", id, topic, err)
+	}
+
+	return nil
+}
+
+func (c *client) PurgeTopic(ctx context.Context, topic string) (int, error) {
+	stream, err := c.js.Stream(ctx, topic)
+	if err != nil {
+		return 0, fmt.Errorf("get stream for topic %s: %w", topic, err)
+	}
+
+	info, err := stream.Info(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get stream info %s: %w", topic, err)
+	}
+
+	if err := stream.Purge(ctx); err != nil {
+		return 0, fmt.Errorf("purge stream %s: %w", topic, err)
+	}
+
+	return int(info.State.Msgs), nil
+}
+
+// moveToDLQ публикует необработанное сообщение в DLQ топика без изменений,
+// добавляя к его заголовкам исходный subject, консьюмера и причину ошибки
+func (c *client) moveToDLQ(ctx context.Context, topic model.BrokerTopic, consumerName string, msg jetstream.Msg, attempts uint64, handleErr error) error {
+	headers := map[string][]string{}
+	for name, values := range msg.Headers() {
+		headers[name] = values
+	}
+
+	headers[model.BrokerHeaderDLQSubject] = []string{msg.Subject()}
+	headers[model.BrokerHeaderDLQConsumer] = []string{consumerName}
+	headers[model.BrokerHeaderDLQError] = []string{broker_headers.Value(handleErr.Error())}
+	headers[model.BrokerHeaderDLQAttempts] = []string{strconv.FormatUint(attempts, 10)}
+	headers[model.BrokerHeaderDLQFailedAt] = []string{time.Now().UTC().Format(time.RFC3339Nano)}
+
+	return c.PublishMessage(ctx, &model.BrokerMessage{
+		Subject: topic.DLQTopicName,
+		Headers: headers,
+		Data:    msg.Data(),
+	})
+}
+
+func toBrokerMessage(msg *jetstream.RawStreamMsg) *model.BrokerMessage {
+	return &model.BrokerMessage{
+		ID:      msg.Sequence,
+		Subject: msg.Subject,
+		Headers: msg.Header,
+		Data:    msg.Data,
+		Time:    msg.Time,
+	}
+}
@@ -0,0 +1,20 @@
+package nats
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+
+	"boilerplate/internal/pkg/broker_headers"
+)
+
+// readMetadata переносит метаданные запроса, контекст трассировки и baggage из
+// заголовков сообщения в контекст
+func (c *client) readMetadata(ctx context.Context, header nats.Header, subject string) context.Context {
+	ctx, err := broker_headers.ReadMetadata(ctx, broker_headers.Header(header))
+	if err != nil {
+		c.logger.ErrorKV(ctx, "invalid message metadata", "subject", subject, "error", err.Error())
+	}
+
+	return ctx
+}
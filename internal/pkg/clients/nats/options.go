@@ -2,6 +2,8 @@ package nats
 
 import (
 	"net"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Option func(*client)
@@ -23,3 +25,26 @@ func WithConn(conn net.Conn) Option {
 		c.conn = conn
 	}
 }
+
+// WithSource задает атрибут source публикуемых событий CloudEvents
+func WithSource(source string) Option {
+	return func(c *client) {
+		c.source = source
+	}
+}
+
+// WithTracerProvider задает провайдер спанов публикации и обработки сообщений.
+// По умолчанию используется глобальный провайдер OpenTelemetry
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *client) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithRequestWorkers задает количество запросов subject, которые обработчик из
+// Respond обрабатывает одновременно. По умолчанию 64
+func WithRequestWorkers(workers int) Option {
+	return func(c *client) {
+		c.requestWorkers = workers
+	}
+}
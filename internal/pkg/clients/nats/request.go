@@ -0,0 +1,158 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/broker_headers"
+	"boilerplate/internal/pkg/cloudevents"
+)
+
+// defaultRequestTimeout время ожидания ответа на запрос без дедлайна
+const defaultRequestTimeout = 10 * time.Second
+
+// defaultRequestWorkers количество запросов subject, обрабатываемых
+// одновременно, если оно не задано через WithRequestWorkers
+const defaultRequestWorkers = 64
+
+func (c *client) Request(ctx context.Context, subject string, req, resp any) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultRequestTimeout)
+		defer cancel()
+	}
+
+	event, err := cloudevents.New(c.source, subject, req)
+	if err != nil {
+		return fmt.Errorf("create request for subject %s: %w", subject, err)
+	}
+
+	msg := nats.NewMsg(subject)
+	msg.Data = event.Data
+	event.WriteHeaders(msg.Header)
+	broker_headers.WriteMetadata(ctx, broker_headers.Header(msg.Header))
+
+	// Обработчик не продолжает работу после того, как ответ перестанут ждать
+	deadline, _ := ctx.Deadline()
+	msg.Header.Set(broker_headers.Deadline, deadline.UTC().Format(time.RFC3339Nano))
+
+	c.logger.DebugKV(ctx, "send request", "subject", subject, "type", event.Type, "id", event.ID)
+
+	reply, err := c.nc.RequestMsgWithContext(ctx, msg)
+	if err != nil {
+		if errors.Is(err, nats.ErrNoResponders) {
+			return fmt.Errorf("request to subject %s: %w", subject, model.ErrBrokerNoResponders)
+		}
+		return fmt.Errorf("request to subject %s: %w", subject, err)
+	}
+
+	if value := reply.Header.Get(broker_headers.Error); value != "" {
+		return broker_headers.DecodeError(value)
+	}
+
+	if resp == nil {
+		return nil
+	}
+
+	replyEvent := cloudevents.FromHeaders(reply.Header, reply.Data)
+	if err := cloudevents.DecodeInto(cloudevents.WithEvent(ctx, replyEvent), reply.Data, resp); err != nil {
+		return fmt.Errorf("decode reply from subject %s: %w", subject, err)
+	}
+
+	return nil
+}
+
+func (c *client) Respond(subject string, handler model.BrokerRequestHandler) error {
+	// Контекст обработчиков отменяется при закрытии клиента
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Пока все обработчики заняты, новые запросы ждут в очереди подписки
+	workers := make(chan struct{}, c.requestWorkers)
+
+	sub, err := c.nc.QueueSubscribe(subject, c.source, func(msg *nats.Msg) {
+		workers <- struct{}{}
+
+		if !c.track() {
+			<-workers
+			c.reply(ctx, msg, nil, &model.BrokerRequestError{
+				Code:    model.BrokerRequestErrorUnavailable,
+				Message: "responder is shutting down",
+			})
+			return
+		}
+
+		go func() {
+			defer func() {
+				<-workers
+				c.inFlight.Done()
+			}()
+
+			c.handleRequest(ctx, msg, handler)
+		}()
+	})
+	if err != nil {
+		cancel()
+		return fmt.Errorf("subscribe to requests for subject %s: %w", subject, err)
+	}
+
+	c.mu.Lock()
+	c.responders = append(c.responders, sub)
+	c.cancels = append(c.cancels, cancel)
+	c.mu.Unlock()
+
+	c.logger.DebugKV(ctx, "responding to subject", "subject", subject, "queue", c.source, "workers", c.requestWorkers)
+
+	return nil
+}
+
+func (c *client) handleRequest(ctx context.Context, msg *nats.Msg, handler model.BrokerRequestHandler) {
+	ctx = c.readMetadata(ctx, msg.Header, msg.Subject)
+
+	event := cloudevents.FromHeaders(msg.Header, msg.Data)
+	ctx = cloudevents.WithEvent(ctx, event)
+
+	if value := msg.Header.Get(broker_headers.Deadline); value != "" {
+		if deadline, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithDeadline(ctx, deadline)
+			defer cancel()
+		}
+	}
+
+	c.logger.DebugKV(ctx, "request received", "subject", msg.Subject, "type", event.Type, "id", event.ID)
+
+	resp, err := handler(ctx, msg.Subject, msg.Data)
+	if err != nil {
+		c.logger.ErrorKV(ctx, "handle request error", "subject", msg.Subject, "error", err.Error())
+	}
+
+	c.reply(ctx, msg, resp, err)
+}
+
+// reply отправляет ответ на запрос или ошибку обработчика в заголовке
+func (c *client) reply(ctx context.Context, msg *nats.Msg, resp any, handleErr error) {
+	reply := nats.NewMsg(msg.Reply)
+
+	if handleErr == nil {
+		event, err := cloudevents.New(c.source, msg.Subject, resp)
+		if err != nil {
+			handleErr = fmt.Errorf("encode reply: %w", err)
+		} else {
+			reply.Data = event.Data
+			event.WriteHeaders(reply.Header)
+		}
+	}
+
+	if handleErr != nil {
+		reply.Header.Set(broker_headers.Error, broker_headers.EncodeError(handleErr))
+	}
+
+	if err := msg.RespondMsg(reply); err != nil {
+		c.logger.ErrorKV(ctx, "send reply error", "subject", msg.Subject, "error", err.Error())
+	}
+}
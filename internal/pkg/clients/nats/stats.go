@@ -0,0 +1,77 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"boilerplate/internal/model"
+)
+
+func (c *client) GetStreamStats(ctx context.Context) ([]*model.BrokerStreamStats, error) {
+	c.mu.RLock()
+	topics := slices.Clone(c.topics)
+	c.mu.RUnlock()
+
+	res := make([]*model.BrokerStreamStats, 0, len(topics))
+	for _, topic := range topics {
+		stream, err := c.js.Stream(ctx, topic)
+		if err != nil {
+			return nil, fmt.Errorf("get stream for topic %s: %w", topic, err)
+		}
+
+		info, err := stream.Info(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get stream info %s: %w", topic, err)
+		}
+
+		stats := &model.BrokerStreamStats{
+			Topic:     topic,
+			Messages:  info.State.Msgs,
+			Bytes:     info.State.Bytes,
+			FirstID:   info.State.FirstSeq,
+			LastID:    info.State.LastSeq,
+			Consumers: info.State.Consumers,
+		}
+		if !info.State.LastTime.IsZero() {
+			lastTime := info.State.LastTime
+			stats.LastTime = &lastTime
+		}
+
+		res = append(res, stats)
+	}
+
+	return res, nil
+}
+
+func (c *client) GetConsumerStats(ctx context.Context) ([]*model.BrokerConsumerStats, error) {
+	c.mu.RLock()
+	subscriptions := slices.Clone(c.subscriptions)
+	c.mu.RUnlock()
+
+	res := make([]*model.BrokerConsumerStats, 0, len(subscriptions))
+	for _, s := range subscriptions {
+		consumer, err := c.js.Consumer(ctx, s.topic.Name, s.name)
+		if err != nil {
+			return nil, fmt.Errorf("get consumer %s: %w", s.name, err)
+		}
+
+		info, err := consumer.Info(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get consumer info %s: %w", s.name, err)
+		}
+
+		res = append(res, &model.BrokerConsumerStats{
+			Topic:       s.topic.Name,
+			Consumer:    s.consumerName,
+			Name:        s.name,
+			Subject:     s.subject,
+			Pending:     info.NumPending,
+			AckPending:  info.NumAckPending,
+			Redelivered: info.NumRedelivered,
+			LastActive:  info.Delivered.Last,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,46 @@
+package nats
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName имя инструментирования в спанах клиента
+const tracerName = "boilerplate/internal/pkg/clients/nats"
+
+// messagingSystem значение атрибута messaging.system
+var messagingSystem = semconv.MessagingSystemKey.String("nats")
+
+// sendAttributes атрибуты спана публикации сообщения в subject
+func sendAttributes(subject, messageID string, bodySize int) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		messagingSystem,
+		semconv.MessagingOperationName("send"),
+		semconv.MessagingOperationTypeSend,
+		semconv.MessagingDestinationName(subject),
+		semconv.MessagingMessageID(messageID),
+		semconv.MessagingMessageBodySize(bodySize),
+	}
+}
+
+// processAttributes атрибуты спана обработки сообщения консьюмером
+func processAttributes(s *subscription, subject, messageID string, bodySize int, delivered uint64) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		messagingSystem,
+		semconv.MessagingOperationName("process"),
+		semconv.MessagingOperationTypeProcess,
+		semconv.MessagingDestinationName(subject),
+		semconv.MessagingConsumerGroupName(s.consumerName),
+		semconv.MessagingMessageID(messageID),
+		semconv.MessagingMessageBodySize(bodySize),
+		attribute.Int64("messaging.nats.delivery_count", int64(delivered)),
+	}
+}
+
+// recordError отмечает спан как завершившийся ошибкой
+func recordError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
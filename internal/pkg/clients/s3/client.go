@@ -1,7 +1,6 @@
 package s3
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -11,14 +10,20 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
-
-	logger_pkg "boilerplate/internal/pkg/logger"
 )
 
+// ErrFileNotFound файл по указанному пути не существует
+var ErrFileNotFound = errors.New("file not found")
+
+// Client файловое хранилище. Реализации: S3-совместимое хранилище (NewClient)
+// и Object Store встроенного сервера NATS (NewObjectStoreClient)
 type Client interface {
 	CreateBucket(ctx context.Context, bucket string) (bool, error)
-	UploadFile(ctx context.Context, path string, content *bytes.Reader) error
+	// UploadFile загружает содержимое по указанному пути. Content должен
+	// поддерживать Seek, чтобы не читать файл в память целиком
+	UploadFile(ctx context.Context, path string, content io.ReadSeeker) error
 	// DownloadFile загружает файл по указанному пути
 	// ВАЖНО: Вызывающий должен закрыть возвращаемый io.ReadCloser
 	DownloadFile(ctx context.Context, path string) (io.ReadCloser, error)
@@ -26,7 +31,7 @@ type Client interface {
 }
 
 type client struct {
-	logger   logger_pkg.Logger
+	options
 	s3client *s3.Client
 	bucket   string
 }
@@ -51,7 +56,7 @@ func NewClient(ctx context.Context, host, port, accessKey, secretKey, bucket str
 	}
 
 	for _, opt := range opts {
-		opt(c)
+		opt(&c.options)
 	}
 
 	return c, nil
@@ -77,7 +82,7 @@ func (c *client) CreateBucket(ctx context.Context, bucket string) (bool, error)
 	return true, nil
 }
 
-func (c *client) UploadFile(ctx context.Context, path string, content *bytes.Reader) error {
+func (c *client) UploadFile(ctx context.Context, path string, content io.ReadSeeker) error {
 	if c.logger != nil {
 		c.logger.DebugKV(ctx, "s3 upload", "path", path)
 	}
@@ -104,6 +109,10 @@ func (c *client) DownloadFile(ctx context.Context, path string) (io.ReadCloser,
 		Key:    aws.String(path),
 	})
 	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, fmt.Errorf("s3 get object %s: %w", path, ErrFileNotFound)
+		}
 		return nil, fmt.Errorf("s3 get object: %w", err)
 	}
 
@@ -0,0 +1,82 @@
+package s3_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/s3"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+)
+
+func TestS3Client(t *testing.T) {
+	t.Parallel()
+
+	testClientContract(t, model.S3BackendS3)
+}
+
+func TestObjectStoreClient(t *testing.T) {
+	t.Parallel()
+
+	testClientContract(t, model.S3BackendNats)
+}
+
+// testClientContract проверяет поведение, одинаковое для всех бэкендов
+// хранилища
+func testClientContract(t *testing.T, backend string) {
+	t.Helper()
+
+	sp, cleanup := suite_provider.NewProvider()
+	t.Cleanup(cleanup)
+
+	sp.GetConfig().S3.Backend = backend
+	client := sp.GetS3Client()
+	ctx := sp.Context()
+
+	// Бакет уже создан провайдером
+	created, err := client.CreateBucket(ctx, sp.GetConfig().S3.Bucket)
+	require.NoError(t, err)
+	require.False(t, created)
+
+	path := "contract/" + utils.UUID().String() + "/file.bin"
+
+	// Файл больше одного блока хранилища
+	content := make([]byte, 300*1024)
+	_, err = rand.Read(content)
+	require.NoError(t, err)
+
+	require.NoError(t, client.UploadFile(ctx, path, bytes.NewReader(content)))
+	require.Equal(t, content, download(t, client, path))
+
+	// Повторная загрузка заменяет содержимое
+	require.NoError(t, client.UploadFile(ctx, path, bytes.NewReader([]byte("replaced"))))
+	require.Equal(t, []byte("replaced"), download(t, client, path))
+
+	require.NoError(t, client.DeleteFile(ctx, path))
+
+	_, err = client.DownloadFile(ctx, path)
+	require.ErrorIs(t, err, s3.ErrFileNotFound)
+
+	// Удаление несуществующего файла не является ошибкой
+	require.NoError(t, client.DeleteFile(ctx, path))
+}
+
+func download(t *testing.T, client s3.Client, path string) []byte {
+	t.Helper()
+
+	reader, err := client.DownloadFile(t.Context(), path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, reader.Close())
+	}()
+
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	return data
+}
@@ -0,0 +1,144 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// objectStoreClient хранит файлы в JetStream Object Store. Бакет хранилища
+// соответствует бакету S3, путь файла - имени объекта
+type objectStoreClient struct {
+	options
+	js     jetstream.JetStream
+	bucket string
+
+	mu    sync.Mutex
+	store jetstream.ObjectStore
+}
+
+// NewObjectStoreClient создает клиент хранилища поверх JetStream Object Store,
+// чтобы небольшие установки обходились без отдельного S3-совместимого сервера
+func NewObjectStoreClient(js jetstream.JetStream, bucket string, opts ...option) Client {
+	c := &objectStoreClient{
+		js:     js,
+		bucket: bucket,
+	}
+
+	for _, opt := range opts {
+		opt(&c.options)
+	}
+
+	return c
+}
+
+func (c *objectStoreClient) CreateBucket(ctx context.Context, bucket string) (bool, error) {
+	if c.logger != nil {
+		c.logger.DebugKV(ctx, "object store create bucket", "bucket", bucket)
+	}
+
+	// Сервер не возвращает ошибку при создании бакета с той же конфигурацией,
+	// поэтому существование бакета проверяется заранее
+	_, err := c.js.ObjectStore(ctx, bucket)
+	if err == nil {
+		return false, nil
+	}
+	if !errors.Is(err, jetstream.ErrBucketNotFound) {
+		return false, fmt.Errorf("object store get bucket: %w", err)
+	}
+
+	_, err = c.js.CreateObjectStore(ctx, jetstream.ObjectStoreConfig{
+		Bucket:  bucket,
+		Storage: jetstream.FileStorage,
+	})
+	if err != nil {
+		if errors.Is(err, jetstream.ErrBucketExists) {
+			return false, nil
+		}
+		return false, fmt.Errorf("object store create bucket: %w", err)
+	}
+
+	return true, nil
+}
+
+func (c *objectStoreClient) UploadFile(ctx context.Context, path string, content io.ReadSeeker) error {
+	if c.logger != nil {
+		c.logger.DebugKV(ctx, "object store upload", "path", path)
+	}
+
+	store, err := c.objectStore(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Содержимое передается на сервер частями по мере чтения
+	_, err = store.Put(ctx, jetstream.ObjectMeta{Name: path}, content)
+	if err != nil {
+		return fmt.Errorf("object store put object: %w", err)
+	}
+
+	return nil
+}
+
+func (c *objectStoreClient) DownloadFile(ctx context.Context, path string) (io.ReadCloser, error) {
+	if c.logger != nil {
+		c.logger.DebugKV(ctx, "object store download", "path", path)
+	}
+
+	store, err := c.objectStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := store.Get(ctx, path)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrObjectNotFound) {
+			return nil, fmt.Errorf("object store get object %s: %w", path, ErrFileNotFound)
+		}
+		return nil, fmt.Errorf("object store get object: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *objectStoreClient) DeleteFile(ctx context.Context, path string) error {
+	if c.logger != nil {
+		c.logger.DebugKV(ctx, "object store delete", "path", path)
+	}
+
+	store, err := c.objectStore(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Как и в S3, удаление несуществующего файла не является ошибкой
+	err = store.Delete(ctx, path)
+	if err != nil && !errors.Is(err, jetstream.ErrObjectNotFound) {
+		return fmt.Errorf("object store delete object: %w", err)
+	}
+
+	return nil
+}
+
+// objectStore возвращает бакет клиента. Бакет может быть создан после
+// клиента, поэтому он открывается при первом обращении
+func (c *objectStoreClient) objectStore(ctx context.Context) (jetstream.ObjectStore, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.store != nil {
+		return c.store, nil
+	}
+
+	store, err := c.js.ObjectStore(ctx, c.bucket)
+	if err != nil {
+		return nil, fmt.Errorf("object store open bucket %s: %w", c.bucket, err)
+	}
+	c.store = store
+
+	return store, nil
+}
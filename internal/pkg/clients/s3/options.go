@@ -2,10 +2,14 @@ package s3
 
 import logger_pkg "boilerplate/internal/pkg/logger"
 
-type option func(*client)
+type options struct {
+	logger logger_pkg.Logger
+}
+
+type option func(*options)
 
 func WithLogger(logger logger_pkg.Logger) option {
-	return func(c *client) {
-		c.logger = logger
+	return func(o *options) {
+		o.logger = logger
 	}
 }
@@ -5,21 +5,33 @@ import (
 	"os"
 	"os/signal"
 	"sync"
+	"time"
 
 	logger_pkg "boilerplate/internal/pkg/logger"
 )
 
 type Closer interface {
 	Add(f ...func() error)
+	// AddDrain добавляет функции, которые завершают текущую работу до вызова
+	// функций закрытия. Они выполняются одновременно и получают контекст,
+	// который отменяется через timeout
+	AddDrain(timeout time.Duration, f ...func(ctx context.Context) error)
 	Wait()
 	CloseAll()
 }
 
+// drain функция завершения работы с ограничением по времени
+type drain struct {
+	timeout time.Duration
+	f       func(ctx context.Context) error
+}
+
 type closer struct {
 	ctx      context.Context
 	logger   logger_pkg.Logger
 	once     sync.Once
 	done     chan struct{}
+	drains   []drain
 	funcs    []func() error
 	shutdown chan os.Signal
 	sync.Mutex
@@ -54,6 +66,14 @@ func (c *closer) Add(f ...func() error) {
 	c.funcs = append(c.funcs, f...)
 }
 
+func (c *closer) AddDrain(timeout time.Duration, f ...func(ctx context.Context) error) {
+	c.Lock()
+	defer c.Unlock()
+	for _, fn := range f {
+		c.drains = append(c.drains, drain{timeout: timeout, f: fn})
+	}
+}
+
 // nolint: revive
 func (c *closer) Wait() {
 	if r := recover(); r != nil {
@@ -69,14 +89,34 @@ func (c *closer) CloseAll() {
 		defer close(c.done)
 
 		c.Lock()
+		drains := c.drains
 		funcs := c.funcs
 		c.Unlock()
 
+		c.drainAll(drains)
+
 		for i := len(funcs) - 1; i >= 0; i-- {
-			err := c.funcs[i]()
+			err := funcs[i]()
 			if err != nil {
 				c.logger.Errorf(c.ctx, "close: %s", err.Error())
 			}
 		}
 	})
 }
+
+// drainAll выполняет функции завершения работы и ждет их окончания или
+// истечения их времени
+func (c *closer) drainAll(drains []drain) {
+	var wg sync.WaitGroup
+	for _, d := range drains {
+		wg.Go(func() {
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(c.ctx), d.timeout)
+			defer cancel()
+
+			if err := d.f(ctx); err != nil {
+				c.logger.Errorf(c.ctx, "drain: %s", err.Error())
+			}
+		})
+	}
+	wg.Wait()
+}
@@ -0,0 +1,144 @@
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/utils"
+)
+
+const SpecVersion = "1.0"
+
+const (
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/protobuf"
+)
+
+// Заголовки сообщения по привязке CloudEvents к NATS (binary content mode)
+const (
+	HeaderID          = "ce-id"
+	HeaderSource      = "ce-source"
+	HeaderType        = "ce-type"
+	HeaderSpecVersion = "ce-specversion"
+	HeaderTime        = "ce-time"
+	HeaderContentType = "content-type"
+)
+
+// Event сообщение брокера в формате CloudEvents
+type Event struct {
+	ID              string
+	Source          string
+	Type            string
+	SpecVersion     string
+	Time            time.Time
+	DataContentType string
+	Data            []byte
+}
+
+// Header заголовки сообщения, например nats.Header
+type Header interface {
+	Get(key string) string
+	Set(key, value string)
+}
+
+type eventKey struct{}
+
+// New кодирует data и заполняет атрибуты события. proto.Message кодируется в
+// protobuf с типом по полному имени сообщения, остальные значения - в JSON с
+// типом по имени топика
+func New(source, topic string, data any, opts ...model.PublishOption) (*Event, error) {
+	options := &model.PublishOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	event := &Event{
+		ID:          options.ID,
+		Source:      source,
+		Type:        options.Type,
+		SpecVersion: SpecVersion,
+		Time:        options.Time,
+	}
+
+	if event.ID == "" {
+		event.ID = utils.UUID().String()
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	var err error
+	if message, ok := data.(proto.Message); ok {
+		event.DataContentType = ContentTypeProtobuf
+		if event.Type == "" {
+			event.Type = string(proto.MessageName(message))
+		}
+
+		event.Data, err = proto.Marshal(message)
+		if err != nil {
+			return nil, fmt.Errorf("marshal protobuf data: %w", err)
+		}
+
+		return event, nil
+	}
+
+	event.DataContentType = ContentTypeJSON
+	if event.Type == "" {
+		event.Type = topic
+	}
+
+	event.Data, err = json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("marshal json data: %w", err)
+	}
+
+	return event, nil
+}
+
+// WriteHeaders записывает атрибуты события в заголовки сообщения
+func (e *Event) WriteHeaders(h Header) {
+	h.Set(HeaderID, e.ID)
+	h.Set(HeaderSource, e.Source)
+	h.Set(HeaderType, e.Type)
+	h.Set(HeaderSpecVersion, e.SpecVersion)
+	h.Set(HeaderTime, e.Time.UTC().Format(time.RFC3339Nano))
+	h.Set(HeaderContentType, e.DataContentType)
+}
+
+// FromHeaders восстанавливает событие из заголовков и данных сообщения.
+// Сообщения без заголовков CloudEvents считаются JSON
+func FromHeaders(h Header, data []byte) *Event {
+	event := &Event{
+		ID:              h.Get(HeaderID),
+		Source:          h.Get(HeaderSource),
+		Type:            h.Get(HeaderType),
+		SpecVersion:     h.Get(HeaderSpecVersion),
+		DataContentType: h.Get(HeaderContentType),
+		Data:            data,
+	}
+
+	if event.DataContentType == "" {
+		event.DataContentType = ContentTypeJSON
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, h.Get(HeaderTime)); err == nil {
+		event.Time = t
+	}
+
+	return event
+}
+
+// WithEvent сохраняет в контексте событие, которое обрабатывает подписчик
+func WithEvent(ctx context.Context, event *Event) context.Context {
+	return context.WithValue(ctx, eventKey{}, event)
+}
+
+func FromContext(ctx context.Context) (*Event, bool) {
+	event, ok := ctx.Value(eventKey{}).(*Event)
+	return event, ok
+}
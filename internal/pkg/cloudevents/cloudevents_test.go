@@ -0,0 +1,117 @@
+package cloudevents
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"boilerplate/internal/model"
+	"boilerplate/pkg/pb/events"
+)
+
+func TestNew(t *testing.T) {
+	message := &events.UserDeleted{UserId: 42}
+
+	event, err := New("test", "user-deleted", message)
+	require.NoError(t, err)
+	require.NotEmpty(t, event.ID)
+	require.Equal(t, "test", event.Source)
+	require.Equal(t, "events.UserDeleted", event.Type)
+	require.Equal(t, SpecVersion, event.SpecVersion)
+	require.Equal(t, ContentTypeProtobuf, event.DataContentType)
+	require.False(t, event.Time.IsZero())
+
+	decoded := &events.UserDeleted{}
+	require.NoError(t, proto.Unmarshal(event.Data, decoded))
+	require.True(t, proto.Equal(message, decoded))
+
+	// JSON-данные получают тип по имени топика, если он не задан явно
+	eventTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	event, err = New("test", "user-import-created", &model.UserImportCreatedEvent{ImportID: 7}, model.WithEventID("id-1"), model.WithEventTime(eventTime))
+	require.NoError(t, err)
+	require.Equal(t, "id-1", event.ID)
+	require.Equal(t, "user-import-created", event.Type)
+	require.Equal(t, eventTime, event.Time)
+	require.Equal(t, "application/json", event.DataContentType)
+	require.JSONEq(t, `{"import_id": 7}`, string(event.Data))
+
+	event, err = New("test", "user-import-created", map[string]int{}, model.WithEventType("custom"))
+	require.NoError(t, err)
+	require.Equal(t, "custom", event.Type)
+}
+
+func TestHeaders(t *testing.T) {
+	event, err := New("test", "user-deleted", &events.UserDeleted{UserId: 42})
+	require.NoError(t, err)
+
+	header := nats.Header{}
+	event.WriteHeaders(header)
+	require.Equal(t, event.ID, header.Get(HeaderID))
+	require.Equal(t, "1.0", header.Get(HeaderSpecVersion))
+	require.Equal(t, ContentTypeProtobuf, header.Get(HeaderContentType))
+
+	restored := FromHeaders(header, event.Data)
+	require.Equal(t, event.ID, restored.ID)
+	require.Equal(t, event.Source, restored.Source)
+	require.Equal(t, event.Type, restored.Type)
+	require.Equal(t, event.SpecVersion, restored.SpecVersion)
+	require.Equal(t, event.DataContentType, restored.DataContentType)
+	require.True(t, event.Time.Equal(restored.Time))
+
+	// Сообщения без заголовков CloudEvents считаются JSON
+	restored = FromHeaders(nats.Header{}, []byte(`{}`))
+	require.Equal(t, "application/json", restored.DataContentType)
+	require.True(t, restored.Time.IsZero())
+}
+
+func TestDecode(t *testing.T) {
+	message := &events.UserCreated{UserId: 42, Name: "name"}
+
+	event, err := New("test", "user-created", message)
+	require.NoError(t, err)
+	ctx := WithEvent(context.Background(), event)
+
+	decoded, err := Decode[events.UserCreated](ctx, event.Data)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(message, decoded))
+
+	// Тип события должен совпадать с типом proto-сообщения
+	_, err = Decode[events.UserDeleted](ctx, event.Data)
+	require.ErrorContains(t, err, "unexpected event type")
+
+	// Protobuf нельзя декодировать в обычную структуру
+	_, err = Decode[model.UserImportCreatedEvent](ctx, event.Data)
+	require.ErrorContains(t, err, "unsupported content type")
+
+	// Proto-сообщение в JSON и JSON без контекста события
+	decoded, err = Decode[events.UserCreated](context.Background(), []byte(`{"user_id": "42", "name": "name"}`))
+	require.NoError(t, err)
+	require.True(t, proto.Equal(message, decoded))
+
+	importEvent, err := Decode[model.UserImportCreatedEvent](context.Background(), []byte(`{"import_id": 7}`))
+	require.NoError(t, err)
+	require.Equal(t, 7, importEvent.ImportID)
+}
+
+func TestHandler(t *testing.T) {
+	event, err := New("test", "user-created", &events.UserCreated{UserId: 42})
+	require.NoError(t, err)
+
+	var received *events.UserCreated
+	handler := Handler(func(_ context.Context, _ string, data *events.UserCreated) error {
+		received = data
+		return nil
+	})
+
+	err = handler(WithEvent(context.Background(), event), "user-created", event.Data)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), received.GetUserId())
+
+	err = handler(WithEvent(context.Background(), event), "user-created", []byte("invalid"))
+	require.Error(t, err)
+	require.True(t, model.IsErrPermanent(err))
+}
@@ -0,0 +1,105 @@
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"boilerplate/internal/model"
+	errors_pkg "boilerplate/internal/pkg/errors"
+)
+
+// TypedHandler обработчик сообщений с данными, декодированными в T
+type TypedHandler[T any] func(ctx context.Context, subject string, data *T) error
+
+// TypedRequestHandler обработчик запросов с данными, декодированными в T
+type TypedRequestHandler[T any] func(ctx context.Context, subject string, req *T) (any, error)
+
+// Decode декодирует данные сообщения в T по datacontenttype события из
+// контекста. Для proto-сообщений тип события должен совпадать с именем T
+func Decode[T any](ctx context.Context, data []byte) (*T, error) {
+	result := new(T)
+	if err := DecodeInto(ctx, data, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DecodeInto декодирует данные сообщения в значение по указателю v так же,
+// как Decode
+func DecodeInto(ctx context.Context, data []byte, v any) error {
+	contentType := ContentTypeJSON
+	eventType := ""
+	if event, ok := FromContext(ctx); ok {
+		contentType = event.DataContentType
+		eventType = event.Type
+	}
+
+	message, isProto := v.(proto.Message)
+	if !isProto {
+		if contentType != ContentTypeJSON {
+			return fmt.Errorf("unsupported content type %s for %T", contentType, v)
+		}
+		if err := json.Unmarshal(data, v); err != nil {
+			return fmt.Errorf("unmarshal json %T: %w", v, err)
+		}
+		return nil
+	}
+
+	if name := string(proto.MessageName(message)); eventType != "" && eventType != name {
+		return fmt.Errorf("unexpected event type %s, want %s", eventType, name)
+	}
+
+	switch contentType {
+	case ContentTypeProtobuf:
+		if err := proto.Unmarshal(data, message); err != nil {
+			return fmt.Errorf("unmarshal protobuf %T: %w", v, err)
+		}
+	case ContentTypeJSON:
+		if err := protojson.Unmarshal(data, message); err != nil {
+			return fmt.Errorf("unmarshal protojson %T: %w", v, err)
+		}
+	default:
+		return fmt.Errorf("unsupported content type %s for %T", contentType, v)
+	}
+
+	return nil
+}
+
+// Handler возвращает обработчик брокера, который декодирует данные сообщения
+// в T и передает их handler. Сообщение, которое не удалось декодировать,
+// сразу перемещается в DLQ
+func Handler[T any](handler TypedHandler[T]) model.BrokerHandler {
+	return func(ctx context.Context, subject string, data []byte) error {
+		decoded, err := Decode[T](ctx, data)
+		if err != nil {
+			return model.ErrPermanent(fmt.Errorf("decode message: %w", err))
+		}
+		return handler(ctx, subject, decoded)
+	}
+}
+
+// Subscribe подписывает на топик обработчик с данными, декодированными в T
+func Subscribe[T any](ctx context.Context, client model.BrokerClient, consumerName, description string, topic model.BrokerTopic, handler TypedHandler[T], opts ...model.SubscribeOption) error {
+	return client.Subscribe(ctx, consumerName, description, topic, Handler(handler), opts...)
+}
+
+// RequestHandler возвращает обработчик запросов брокера, который декодирует
+// данные запроса в T и передает их handler
+func RequestHandler[T any](handler TypedRequestHandler[T]) model.BrokerRequestHandler {
+	return func(ctx context.Context, subject string, data []byte) (any, error) {
+		decoded, err := Decode[T](ctx, data)
+		if err != nil {
+			return nil, errors_pkg.NewBadRequestError("decode request: " + err.Error())
+		}
+		return handler(ctx, subject, decoded)
+	}
+}
+
+// Respond регистрирует обработчик запросов с данными, декодированными в T
+func Respond[T any](client model.BrokerClient, subject string, handler TypedRequestHandler[T]) error {
+	return client.Respond(subject, RequestHandler(handler))
+}
@@ -1,5 +1,7 @@
 package convert
 
+import "google.golang.org/protobuf/types/known/structpb"
+
 func ToInt(v int64) int {
 	return int(v)
 }
@@ -7,3 +9,64 @@ func ToInt(v int64) int {
 func ToInt64(v int) int64 {
 	return int64(v)
 }
+
+func ToIntPtr(v *int64) *int {
+	if v == nil {
+		return nil
+	}
+	res := ToInt(*v)
+	return &res
+}
+
+func ToInt64Ptr(v *int) *int64 {
+	if v == nil {
+		return nil
+	}
+	res := ToInt64(*v)
+	return &res
+}
+
+func ToInts(v []int64) []int {
+	res := make([]int, 0, len(v))
+	for _, item := range v {
+		res = append(res, ToInt(item))
+	}
+	return res
+}
+
+func ToInt64s(v []int) []int64 {
+	res := make([]int64, 0, len(v))
+	for _, item := range v {
+		res = append(res, ToInt64(item))
+	}
+	return res
+}
+
+// ToStruct конвертирует JSON-объект в google.protobuf.Struct. Если объект
+// содержит значения, непредставимые в Struct, возвращает nil
+func ToStruct(v map[string]any) *structpb.Struct {
+	s, err := structpb.NewStruct(v)
+	if err != nil {
+		return nil
+	}
+	return s
+}
+
+// ToValue конвертирует JSON-значение в google.protobuf.Value. Если значение
+// непредставимо в Value, возвращает nil
+func ToValue(v any) *structpb.Value {
+	value, err := structpb.NewValue(v)
+	if err != nil {
+		return nil
+	}
+	return value
+}
+
+// FromStruct конвертирует google.protobuf.Struct в JSON-объект. Отсутствующий
+// Struct конвертируется в nil
+func FromStruct(s *structpb.Struct) map[string]any {
+	if s == nil {
+		return nil
+	}
+	return s.AsMap()
+}
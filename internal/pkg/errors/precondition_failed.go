@@ -0,0 +1,22 @@
+package errors
+
+import "errors"
+
+type ErrPreconditionFailed struct {
+	Msg string `json:"error"`
+}
+
+func NewPreconditionFailedError(msg string) *ErrPreconditionFailed {
+	return &ErrPreconditionFailed{
+		Msg: msg,
+	}
+}
+
+func (e *ErrPreconditionFailed) Error() string {
+	return e.Msg
+}
+
+func IsErrPreconditionFailed(err error) bool {
+	var errPreconditionFailed *ErrPreconditionFailed
+	return errors.As(err, &errPreconditionFailed)
+}
@@ -0,0 +1,17 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreconditionFailedError(t *testing.T) {
+	err := errors.New("тест1")
+	require.False(t, IsErrPreconditionFailed(err))
+
+	err = NewPreconditionFailedError("тест2")
+	require.Equal(t, "тест2", err.Error())
+	require.True(t, IsErrPreconditionFailed(err))
+}
@@ -0,0 +1,53 @@
+package etag
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	anyTag     = "*"
+	weakPrefix = "W/"
+)
+
+// FromVersion формирует строгий ETag из версии сущности
+func FromVersion(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// Match проверяет условие If-Match: строгое сравнение с любым из
+// перечисленных через запятую тегов. Значение "*" совпадает с любым тегом
+func Match(header, etag string) bool {
+	return match(header, etag, false)
+}
+
+// NoneMatch проверяет условие If-None-Match: слабое сравнение с каждым из
+// перечисленных через запятую тегов. Возвращает true, если ни один не совпал
+func NoneMatch(header, etag string) bool {
+	return !match(header, etag, true)
+}
+
+func match(header, etag string, weak bool) bool {
+	header = strings.TrimSpace(header)
+	if header == anyTag {
+		return true
+	}
+
+	if weak {
+		etag = strings.TrimPrefix(etag, weakPrefix)
+	} else if strings.HasPrefix(etag, weakPrefix) {
+		return false
+	}
+
+	for tag := range strings.SplitSeq(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if weak {
+			tag = strings.TrimPrefix(tag, weakPrefix)
+		}
+		if tag == etag {
+			return true
+		}
+	}
+
+	return false
+}
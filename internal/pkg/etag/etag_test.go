@@ -0,0 +1,57 @@
+package etag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromVersion(t *testing.T) {
+	require.Equal(t, `"1"`, FromVersion(1))
+	require.Equal(t, `"42"`, FromVersion(42))
+}
+
+func TestMatch(t *testing.T) {
+	type testCase struct {
+		Name     string
+		Header   string
+		Expected bool
+	}
+
+	testCases := []testCase{
+		{Name: "same", Header: `"2"`, Expected: true},
+		{Name: "other", Header: `"1"`, Expected: false},
+		{Name: "any", Header: "*", Expected: true},
+		{Name: "list", Header: `"1", "2"`, Expected: true},
+		{Name: "weak", Header: `W/"2"`, Expected: false},
+		{Name: "unquoted", Header: "2", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			require.Equal(t, testCase.Expected, Match(testCase.Header, FromVersion(2)))
+		})
+	}
+}
+
+func TestNoneMatch(t *testing.T) {
+	type testCase struct {
+		Name     string
+		Header   string
+		Expected bool
+	}
+
+	testCases := []testCase{
+		{Name: "same", Header: `"2"`, Expected: false},
+		{Name: "other", Header: `"1"`, Expected: true},
+		{Name: "any", Header: "*", Expected: false},
+		{Name: "list", Header: `"1", "3"`, Expected: true},
+		{Name: "weak", Header: `W/"2"`, Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			require.Equal(t, testCase.Expected, NoneMatch(testCase.Header, FromVersion(2)))
+		})
+	}
+}
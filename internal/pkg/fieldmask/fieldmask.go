@@ -0,0 +1,47 @@
+package fieldmask
+
+import (
+	"fmt"
+	"strings"
+
+	"boilerplate/internal/pkg/errors"
+)
+
+// Mapping сопоставляет пути FieldMask, разрешенные для обновления, колонкам БД
+type Mapping map[string]string
+
+// Columns проверяет пути маски и возвращает соответствующие им колонки без
+// повторов в порядке следования путей. Неизвестный путь - ошибка клиента
+func (m Mapping) Columns(paths []string) ([]string, error) {
+	columns := make([]string, 0, len(paths))
+	seen := make(map[string]struct{}, len(paths))
+
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+
+		column, exists := m[path]
+		if !exists {
+			return nil, errors.NewBadRequestError(fmt.Sprintf("Поле %q нельзя обновить", path))
+		}
+
+		if _, exists := seen[column]; exists {
+			continue
+		}
+		seen[column] = struct{}{}
+
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+// Has проверяет, входит ли путь в маску
+func Has(paths []string, path string) bool {
+	for _, p := range paths {
+		if strings.TrimSpace(p) == path {
+			return true
+		}
+	}
+
+	return false
+}
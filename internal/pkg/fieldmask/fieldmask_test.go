@@ -0,0 +1,36 @@
+package fieldmask
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/pkg/errors"
+)
+
+func TestColumns(t *testing.T) {
+	mapping := Mapping{
+		"name":      "name",
+		"full_name": "name",
+		"password":  "password",
+	}
+
+	columns, err := mapping.Columns([]string{"password", " name ", "full_name"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"password", "name"}, columns)
+
+	columns, err = mapping.Columns(nil)
+	require.NoError(t, err)
+	require.Empty(t, columns)
+
+	_, err = mapping.Columns([]string{"name", "is_admin"})
+	require.Error(t, err)
+	require.True(t, errors.IsErrBadRequest(err))
+}
+
+func TestHas(t *testing.T) {
+	require.True(t, Has([]string{"name", "password"}, "password"))
+	require.True(t, Has([]string{" name"}, "name"))
+	require.False(t, Has([]string{"name"}, "password"))
+	require.False(t, Has(nil, "name"))
+}
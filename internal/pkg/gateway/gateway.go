@@ -36,6 +36,10 @@ func Setup(ctx context.Context, configAPI model.ConfigAPI, grpcHandlers []model.
 		runtime.WithMetadata(WithMetadata),
 		// Добавляем forwarder для прокидывания cookies из gRPC в HTTP
 		runtime.WithForwardResponseOption(WithForwardResponseOption),
+		// Добавляем обработчик ошибок для ответа 412 при несовпадении ETag
+		runtime.WithErrorHandler(WithErrorHandler),
+		// Файлы из потоковых ответов передаются без разделителей между частями
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, NewMarshaler()),
 	)
 	// Register gRPC handlers
 	for _, handler := range grpcHandlers {
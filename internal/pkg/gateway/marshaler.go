@@ -0,0 +1,35 @@
+package gateway
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Marshaler маршалер ответов по умолчанию. В отличие от маршалера
+// grpc-gateway, он не добавляет перевод строки после каждой части потокового
+// ответа, поэтому файл, переданный частями google.api.HttpBody, доходит без
+// изменений
+type Marshaler struct {
+	*runtime.HTTPBodyMarshaler
+}
+
+// NewMarshaler создает маршалер с настройками JSON маршалера grpc-gateway по
+// умолчанию
+func NewMarshaler() *Marshaler {
+	return &Marshaler{
+		HTTPBodyMarshaler: &runtime.HTTPBodyMarshaler{
+			Marshaler: &runtime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{
+					EmitUnpopulated: true,
+				},
+				UnmarshalOptions: protojson.UnmarshalOptions{
+					DiscardUnknown: true,
+				},
+			},
+		},
+	}
+}
+
+func (m *Marshaler) Delimiter() []byte {
+	return nil
+}
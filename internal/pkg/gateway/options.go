@@ -3,15 +3,24 @@ package gateway
 import (
 	"context"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
 const (
-	cookieKey = "cookie"
+	cookieKey         = "cookie"
+	ifMatchKey        = "if-match"
+	ifNoneMatchKey    = "if-none-match"
+	acceptLanguageKey = "accept-language"
+	etagKey           = "etag"
+	httpCodeKey       = "x-http-code"
 )
 
 // WithMetadata прокидывает данных из HTTP запроса в gRPC metadata
@@ -23,6 +32,19 @@ func WithMetadata(_ context.Context, req *http.Request) metadata.MD {
 		md.Set(cookieKey+"-"+strings.ToLower(cookie.Name), cookie.Value)
 	}
 
+	// Условные заголовки для оптимистичных блокировок
+	if value := req.Header.Get("If-Match"); value != "" {
+		md.Set(ifMatchKey, value)
+	}
+	if value := req.Header.Get("If-None-Match"); value != "" {
+		md.Set(ifNoneMatchKey, value)
+	}
+
+	// Язык ответа для пользователей без сохраненных настроек
+	if value := req.Header.Get("Accept-Language"); value != "" {
+		md.Set(acceptLanguageKey, value)
+	}
+
 	return md
 }
 
@@ -33,10 +55,50 @@ func WithForwardResponseOption(ctx context.Context, w http.ResponseWriter, _ pro
 		return nil
 	}
 
+	// Для потокового ответа функция вызывается перед каждой частью
 	cookies := md.HeaderMD.Get(cookieKey)
 	for _, cookie := range cookies {
-		w.Header().Add("Set-Cookie", cookie)
+		if !slices.Contains(w.Header().Values("Set-Cookie"), cookie) {
+			w.Header().Add("Set-Cookie", cookie)
+		}
+	}
+
+	if values := md.HeaderMD.Get(etagKey); len(values) > 0 {
+		w.Header().Del(runtime.MetadataHeaderPrefix + etagKey)
+		w.Header().Set("ETag", values[0])
+	}
+
+	if values := md.HeaderMD.Get(httpCodeKey); len(values) > 0 {
+		w.Header().Del(runtime.MetadataHeaderPrefix + httpCodeKey)
+		code, err := strconv.Atoi(values[0])
+		if err != nil {
+			return err
+		}
+		w.WriteHeader(code)
 	}
 
 	return nil
 }
+
+// WithErrorHandler отдает 412 Precondition Failed вместо 400 для
+// codes.FailedPrecondition, которым отвечают при несовпадении ETag
+func WithErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, req *http.Request, err error) {
+	if status.Code(err) == codes.FailedPrecondition {
+		w = &statusWriter{
+			ResponseWriter: w,
+			status:         http.StatusPreconditionFailed,
+		}
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, req, err)
+}
+
+// statusWriter подменяет код HTTP ответа
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(_ int) {
+	w.ResponseWriter.WriteHeader(w.status)
+}
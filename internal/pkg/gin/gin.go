@@ -28,6 +28,10 @@ func RenderErrorResponse(ctx *gin.Context, err error) {
 		RenderResponse(ctx, http.StatusForbidden, err)
 		return
 	}
+	if errors.IsErrPreconditionFailed(err) {
+		RenderResponse(ctx, http.StatusPreconditionFailed, err)
+		return
+	}
 	if errors.IsErrUnauthorized(err) {
 		RenderResponse(ctx, http.StatusUnauthorized, err)
 		return
@@ -17,6 +17,9 @@ func Error(err error) error {
 	if errors.IsErrForbidden(err) {
 		return status.Error(codes.PermissionDenied, err.Error())
 	}
+	if errors.IsErrPreconditionFailed(err) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if errors.IsErrUnauthorized(err) {
 		return status.Error(codes.Unauthenticated, err.Error())
 	}
@@ -0,0 +1,60 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	ifMatchKey     = "if-match"
+	ifNoneMatchKey = "if-none-match"
+	etagKey        = "etag"
+	httpCodeKey    = "x-http-code"
+)
+
+// GetIfMatch извлекает заголовок If-Match, прокинутый gateway в gRPC metadata
+func GetIfMatch(ctx context.Context) (string, bool) {
+	return getMetadata(ctx, ifMatchKey)
+}
+
+// GetIfNoneMatch извлекает заголовок If-None-Match, прокинутый gateway в gRPC metadata
+func GetIfNoneMatch(ctx context.Context) (string, bool) {
+	return getMetadata(ctx, ifNoneMatchKey)
+}
+
+// SetETag устанавливает ETag для отправки в HTTP ответ
+func SetETag(ctx context.Context, etag string) error {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(etagKey, etag)); err != nil {
+		return fmt.Errorf("set etag header: %w", err)
+	}
+
+	return nil
+}
+
+// SetNotModified заменяет код HTTP ответа на 304 Not Modified
+func SetNotModified(ctx context.Context) error {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(httpCodeKey, strconv.Itoa(http.StatusNotModified))); err != nil {
+		return fmt.Errorf("set http code header: %w", err)
+	}
+
+	return nil
+}
+
+func getMetadata(ctx context.Context, key string) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get(key)
+	if len(values) == 0 || values[0] == "" {
+		return "", false
+	}
+
+	return values[0], true
+}
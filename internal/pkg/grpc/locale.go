@@ -0,0 +1,10 @@
+package grpc
+
+import "context"
+
+const acceptLanguageKey = "accept-language"
+
+// GetAcceptLanguage извлекает заголовок Accept-Language, прокинутый gateway в gRPC metadata
+func GetAcceptLanguage(ctx context.Context) (string, bool) {
+	return getMetadata(ctx, acceptLanguageKey)
+}
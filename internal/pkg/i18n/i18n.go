@@ -0,0 +1,74 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"boilerplate/internal/pkg/metadata"
+)
+
+const (
+	LocaleRU = "ru"
+	LocaleEN = "en"
+
+	// DefaultLocale язык, на котором отвечает API, если язык вызывающего неизвестен
+	DefaultLocale = LocaleRU
+)
+
+// Locales поддерживаемые языки
+var Locales = []string{LocaleRU, LocaleEN}
+
+// IsSupported проверяет, что язык поддерживается
+func IsSupported(locale string) bool {
+	return slices.Contains(Locales, locale)
+}
+
+// T возвращает сообщение на языке вызывающего из контекста. Аргументы
+// подставляются в сообщение как в fmt.Sprintf
+func T(ctx context.Context, key Key, args ...any) string {
+	locale, _ := metadata.GetLocale(ctx)
+	return Translate(locale, key, args...)
+}
+
+// Translate возвращает сообщение на указанном языке. Если перевода нет,
+// используется язык по умолчанию
+func Translate(locale string, key Key, args ...any) string {
+	msg, ok := messages[locale][key]
+	if !ok {
+		msg, ok = messages[DefaultLocale][key]
+	}
+	if !ok {
+		msg = string(key)
+	}
+
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// MatchAcceptLanguage выбирает поддерживаемый язык из заголовка Accept-Language
+// с учетом весов q. Если подходящего языка нет, возвращает false
+func MatchAcceptLanguage(header string) (string, bool) {
+	best, bestWeight := "", 0.0
+	for part := range strings.SplitSeq(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+
+		weight := 1.0
+		if q, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			if _, err := fmt.Sscanf(q, "%g", &weight); err != nil {
+				continue
+			}
+		}
+
+		// en-US -> en
+		language, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		if IsSupported(language) && weight > bestWeight {
+			best, bestWeight = language, weight
+		}
+	}
+
+	return best, best != ""
+}
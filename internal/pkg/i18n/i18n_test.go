@@ -0,0 +1,52 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/pkg/metadata"
+)
+
+func TestMessagesTranslated(t *testing.T) {
+	for key := range messages[DefaultLocale] {
+		for _, locale := range Locales {
+			require.Contains(t, messages[locale], key, "locale %s", locale)
+		}
+	}
+}
+
+func TestT(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, "Не авторизованы", T(ctx, KeyUnauthorized))
+
+	ctx = metadata.WithLocale(ctx, LocaleEN)
+	require.Equal(t, "Not authorized", T(ctx, KeyUnauthorized))
+	require.Equal(t, "Locale de is not supported", T(ctx, KeyLocaleUnsupported, "de"))
+
+	// Неизвестный язык и неизвестное сообщение
+	require.Equal(t, "Не авторизованы", Translate("de", KeyUnauthorized))
+	require.Equal(t, "unknown", Translate(LocaleEN, "unknown"))
+}
+
+func TestMatchAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		locale string
+		ok     bool
+	}{
+		{header: "en", locale: LocaleEN, ok: true},
+		{header: "en-US,en;q=0.9", locale: LocaleEN, ok: true},
+		{header: "de-DE, ru;q=0.5, en;q=0.8", locale: LocaleEN, ok: true},
+		{header: "RU-ru", locale: LocaleRU, ok: true},
+		{header: "de, fr;q=0.9", ok: false},
+		{header: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		locale, ok := MatchAcceptLanguage(tt.header)
+		require.Equal(t, tt.ok, ok, tt.header)
+		require.Equal(t, tt.locale, locale, tt.header)
+	}
+}
@@ -0,0 +1,134 @@
+package i18n
+
+// Key идентификатор сообщения
+type Key string
+
+const (
+	KeyUnauthorized             Key = "unauthorized"
+	KeyUserNameRequired         Key = "users.name_required"
+	KeyUserEmailRequired        Key = "users.email_required"
+	KeyUserPasswordRequired     Key = "users.password_required"
+	KeyUserEmailExists          Key = "users.email_exists"
+	KeyUserNotFound             Key = "users.not_found"
+	KeyUserNotFoundAsOf         Key = "users.not_found_as_of"
+	KeyUserModified             Key = "users.modified"
+	KeyUserNewPasswordRequired  Key = "users.new_password_required" // nolint: gosec
+	KeyUserAttributesInvalid    Key = "users.attributes_invalid"
+	KeyUserEmailInvalid         Key = "users.email_invalid"
+	KeyUserEmailUnchanged       Key = "users.email_unchanged"
+	KeyEmailChangeNotFound      Key = "users.email_change_not_found"
+	KeyEmailChangeCompleted     Key = "users.email_change_completed"
+	KeyEmailChangeExpired       Key = "users.email_change_expired"
+	KeyEmailChangeUndoExpired   Key = "users.email_change_undo_expired"
+	KeyEmailChangeUndoConflict  Key = "users.email_change_undo_conflict"
+	KeyEmailChangeUndone        Key = "users.email_change_undone"
+	KeyUserImportNotFound       Key = "user_imports.not_found"
+	KeyUserImportFileRequired   Key = "user_imports.file_required"
+	KeyUserImportFileEmpty      Key = "user_imports.file_empty"
+	KeyUserImportColumnMissing  Key = "user_imports.column_missing"
+	KeyUserImportReportNotReady Key = "user_imports.report_not_ready"
+	KeyUserExportNotFound       Key = "user_exports.not_found"
+	KeyUserExportFormatUnknown  Key = "user_exports.format_unknown"
+	KeyUserExportPDFLimit       Key = "user_exports.pdf_limit"
+	KeyUserExportFileNotReady   Key = "user_exports.file_not_ready"
+	KeyLocaleUnsupported        Key = "preferences.locale_unsupported"
+	KeyTimezoneUnknown          Key = "preferences.timezone_unknown"
+	KeyGroupNameRequired        Key = "groups.name_required"
+	KeyGroupNameExists          Key = "groups.name_exists"
+	KeyGroupNotFound            Key = "groups.not_found"
+	KeyGroupUsersRequired       Key = "groups.users_required"
+	KeyGroupUsersNotFound       Key = "groups.users_not_found"
+	KeyWelcomeSubject           Key = "notifications.welcome_subject"
+	KeyDLQTopicUnknown          Key = "broker.dlq_topic_unknown"
+	KeyDLQMessageNotFound       Key = "broker.dlq_message_not_found"
+	KeyDLQReplayTarget          Key = "broker.dlq_replay_target"
+	KeyScheduledJobNotFound     Key = "scheduler.job_not_found"
+	KeySchemaNotFound           Key = "broker.schema_not_found"
+)
+
+var messages = map[string]map[Key]string{
+	LocaleRU: {
+		KeyUnauthorized:             "Не авторизованы",
+		KeyUserNameRequired:         "Не указано имя пользователя",
+		KeyUserEmailRequired:        "Не указан email пользователя",
+		KeyUserPasswordRequired:     "Не указан пароль пользователя",
+		KeyUserEmailExists:          "Пользователь с таким email уже существует",
+		KeyUserNotFound:             "Пользователь %d не найден",
+		KeyUserNotFoundAsOf:         "Пользователь %d на %s не найден",
+		KeyUserModified:             "Пользователь %d был изменен, получите актуальную версию и повторите запрос",
+		KeyUserNewPasswordRequired:  "Не указан новый пароль",
+		KeyUserAttributesInvalid:    "Атрибуты пользователя не соответствуют схеме: %s",
+		KeyUserEmailInvalid:         "Некорректный email",
+		KeyUserEmailUnchanged:       "Новый email совпадает с текущим",
+		KeyEmailChangeNotFound:      "Запрос на смену email не найден",
+		KeyEmailChangeCompleted:     "Запрос на смену email уже подтвержден или отменен",
+		KeyEmailChangeExpired:       "Срок действия ссылки истек, запросите смену email повторно",
+		KeyEmailChangeUndoExpired:   "Срок отмены смены email истек",
+		KeyEmailChangeUndoConflict:  "Email пользователя уже изменен повторно, отмена невозможна",
+		KeyEmailChangeUndone:        "Смена email уже отменена",
+		KeyUserImportNotFound:       "Импорт %d не найден",
+		KeyUserImportFileRequired:   "Не указан путь к файлу импорта",
+		KeyUserImportFileEmpty:      "Файл импорта пуст",
+		KeyUserImportColumnMissing:  "В файле импорта отсутствует колонка %s",
+		KeyUserImportReportNotReady: "Отчет по импорту %d еще не сформирован",
+		KeyUserExportNotFound:       "Выгрузка %d не найдена",
+		KeyUserExportFormatUnknown:  "Неизвестный формат выгрузки %q",
+		KeyUserExportPDFLimit:       "В PDF можно выгрузить не более %d пользователей",
+		KeyUserExportFileNotReady:   "Файл выгрузки %d еще не сформирован",
+		KeyLocaleUnsupported:        "Язык %s не поддерживается",
+		KeyTimezoneUnknown:          "Неизвестный часовой пояс %s",
+		KeyGroupNameRequired:        "Не указано название группы",
+		KeyGroupNameExists:          "Группа с таким названием уже существует",
+		KeyGroupNotFound:            "Группа %d не найдена",
+		KeyGroupUsersRequired:       "Не указаны пользователи",
+		KeyGroupUsersNotFound:       "Пользователи не найдены: %s",
+		KeyWelcomeSubject:           "Добро пожаловать!",
+		KeyDLQTopicUnknown:          "Топик %s не является DLQ",
+		KeyDLQMessageNotFound:       "Сообщение %d не найдено в %s",
+		KeyDLQReplayTarget:          "Укажите ровно одно из ids, filter или all",
+		KeyScheduledJobNotFound:     "Задача %s не найдена",
+		KeySchemaNotFound:           "Версия %d схемы топика %s не найдена",
+	},
+	LocaleEN: {
+		KeyUnauthorized:             "Not authorized",
+		KeyUserNameRequired:         "User name is required",
+		KeyUserEmailRequired:        "User email is required",
+		KeyUserPasswordRequired:     "User password is required",
+		KeyUserEmailExists:          "User with this email already exists",
+		KeyUserNotFound:             "User %d not found",
+		KeyUserNotFoundAsOf:         "User %d not found as of %s",
+		KeyUserModified:             "User %d has been modified, get the current version and retry",
+		KeyUserNewPasswordRequired:  "New password is required",
+		KeyUserAttributesInvalid:    "User attributes do not match the schema: %s",
+		KeyUserEmailInvalid:         "Invalid email",
+		KeyUserEmailUnchanged:       "New email is the same as the current one",
+		KeyEmailChangeNotFound:      "Email change request not found",
+		KeyEmailChangeCompleted:     "Email change request is already confirmed or undone",
+		KeyEmailChangeExpired:       "The link has expired, request the email change again",
+		KeyEmailChangeUndoExpired:   "The email change can no longer be undone",
+		KeyEmailChangeUndoConflict:  "The user email has been changed again, the change cannot be undone",
+		KeyEmailChangeUndone:        "The email change is already undone",
+		KeyUserImportNotFound:       "Import %d not found",
+		KeyUserImportFileRequired:   "Import file path is required",
+		KeyUserImportFileEmpty:      "Import file is empty",
+		KeyUserImportColumnMissing:  "Import file has no %s column",
+		KeyUserImportReportNotReady: "Report of import %d is not ready yet",
+		KeyUserExportNotFound:       "Export %d not found",
+		KeyUserExportFormatUnknown:  "Unknown export format %q",
+		KeyUserExportPDFLimit:       "At most %d users can be exported to PDF",
+		KeyUserExportFileNotReady:   "File of export %d is not ready yet",
+		KeyLocaleUnsupported:        "Locale %s is not supported",
+		KeyTimezoneUnknown:          "Unknown time zone %s",
+		KeyGroupNameRequired:        "Group name is required",
+		KeyGroupNameExists:          "Group with this name already exists",
+		KeyGroupNotFound:            "Group %d not found",
+		KeyGroupUsersRequired:       "Users are not specified",
+		KeyGroupUsersNotFound:       "Users not found: %s",
+		KeyWelcomeSubject:           "Welcome!",
+		KeyDLQTopicUnknown:          "Topic %s is not a DLQ",
+		KeyDLQMessageNotFound:       "Message %d not found in %s",
+		KeyDLQReplayTarget:          "Specify exactly one of ids, filter or all",
+		KeyScheduledJobNotFound:     "Job %s not found",
+		KeySchemaNotFound:           "Version %d of topic %s schema not found",
+	},
+}
@@ -0,0 +1,107 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	logger_pkg "boilerplate/internal/pkg/logger"
+)
+
+const (
+	// retryDelay пауза перед повторной попыткой стать лидером после ошибки
+	retryDelay = time.Second
+	// unlockTimeout время на освобождение блокировки лидером
+	unlockTimeout = 5 * time.Second
+)
+
+// LeaderElector выбирает среди экземпляров приложения одного лидера, который
+// выполняет задачу
+type LeaderElector interface {
+	// Run выполняет fn, пока экземпляр приложения удерживает блокировку key.
+	// При потере блокировки контекст fn отменяется, а после завершения fn
+	// экземпляр снова ждет блокировку. Блокируется до отмены ctx
+	Run(ctx context.Context, key string, fn func(ctx context.Context))
+}
+
+type leaderElector struct {
+	logger logger_pkg.Logger
+	locker Locker
+}
+
+func NewLeaderElector(logger logger_pkg.Logger, locker Locker) LeaderElector {
+	return &leaderElector{
+		logger: logger,
+		locker: locker,
+	}
+}
+
+func (e *leaderElector) Run(ctx context.Context, key string, fn func(ctx context.Context)) {
+	for ctx.Err() == nil {
+		lock, err := e.locker.Lock(ctx, key)
+		if err != nil {
+			if errors.Is(err, context.Canceled) && ctx.Err() != nil {
+				return
+			}
+
+			e.logger.ErrorKV(ctx, "acquire leadership error", "key", key, "error", err.Error())
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryDelay):
+			}
+			continue
+		}
+
+		e.lead(ctx, lock, fn)
+	}
+}
+
+// lead выполняет fn до ее завершения или потери блокировки
+func (e *leaderElector) lead(ctx context.Context, lock Lock, fn func(ctx context.Context)) {
+	e.logger.InfoKV(ctx, "leadership acquired", "key", lock.Key(), "token", lock.Token())
+
+	leaderCtx, cancel := context.WithCancel(WithLock(ctx, lock))
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(leaderCtx)
+	}()
+
+	select {
+	case <-done:
+	case <-lock.Lost():
+		e.logger.WarnKV(ctx, "leadership lost", "key", lock.Key(), "token", lock.Token())
+		cancel()
+		<-done
+	}
+
+	// Блокировка освобождается и при остановке приложения, чтобы другой
+	// экземпляр не ждал истечения аренды
+	unlockCtx, unlockCancel := context.WithTimeout(context.WithoutCancel(ctx), unlockTimeout)
+	defer unlockCancel()
+
+	if err := lock.Unlock(unlockCtx); err != nil && !errors.Is(err, ErrLockLost) {
+		e.logger.ErrorKV(ctx, "release leadership error", "key", lock.Key(), "error", err.Error())
+		return
+	}
+
+	e.logger.InfoKV(ctx, "leadership released", "key", lock.Key(), "token", lock.Token())
+}
+
+type lockKey struct{}
+
+// WithLock сохраняет в контексте блокировку, которую удерживает лидер
+func WithLock(ctx context.Context, lock Lock) context.Context {
+	return context.WithValue(ctx, lockKey{}, lock)
+}
+
+// FromContext возвращает блокировку лидера, например чтобы передать ее
+// fencing token в хранилище
+func FromContext(ctx context.Context) (Lock, bool) {
+	lock, ok := ctx.Value(lockKey{}).(Lock)
+	return lock, ok
+}
@@ -0,0 +1,83 @@
+package lock_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/pkg/lock"
+)
+
+func TestLeaderElector(t *testing.T) {
+	t.Parallel()
+
+	sp, lockers := newLockers(t, 3)
+
+	var (
+		leaders atomic.Int32
+		overlap atomic.Bool
+		elected = make(chan int, 10)
+		tokens  = make(chan uint64, 10)
+	)
+
+	// Задача лидера выполняется, пока экземпляр не остановлен
+	job := func(i int) func(ctx context.Context) {
+		return func(ctx context.Context) {
+			if leaders.Add(1) > 1 {
+				overlap.Store(true)
+			}
+			defer leaders.Add(-1)
+
+			var token uint64
+			if held, ok := lock.FromContext(ctx); ok {
+				token = held.Token()
+			}
+			tokens <- token
+			elected <- i
+
+			<-ctx.Done()
+		}
+	}
+
+	cancels := make([]context.CancelFunc, len(lockers))
+	var wg sync.WaitGroup
+	for i, locker := range lockers {
+		var ctx context.Context
+		ctx, cancels[i] = context.WithCancel(sp.Context())
+
+		elector := lock.NewLeaderElector(sp.GetLogger(), locker)
+		wg.Go(func() {
+			elector.Run(ctx, "job", job(i))
+		})
+	}
+
+	var last uint64
+	for range lockers {
+		var leader int
+		select {
+		case leader = <-elected:
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "leader not elected")
+		}
+
+		token := <-tokens
+		require.Greater(t, token, last)
+		last = token
+
+		// Пока лидер работает, остальные экземпляры ждут
+		time.Sleep(2 * ttl)
+		require.Equal(t, int32(1), leaders.Load())
+
+		// Остановленный лидер освобождает блокировку, и ее захватывает
+		// другой экземпляр
+		cancels[leader]()
+	}
+
+	wg.Wait()
+	require.False(t, overlap.Load())
+	require.Zero(t, leaders.Load())
+}
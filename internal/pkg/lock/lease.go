@@ -0,0 +1,149 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// lease захваченная блокировка, аренда которой продлевается в фоне
+type lease struct {
+	locker *locker
+	key    string
+	token  uint64
+
+	// revision ревизия последнего продления, с которой сравнивается запись
+	// при следующем продлении и освобождении. Изменяется только при
+	// продлении, Unlock читает ее после остановки продления
+	revision uint64
+	lost     chan struct{}
+	lostOnce sync.Once
+
+	// stop останавливает продление аренды. Запрос продления, отправленный до
+	// остановки, не прерывается: иначе сервер может применить его без
+	// обновления ревизии в клиенте
+	stop     chan struct{}
+	stopOnce sync.Once
+	stopped  chan struct{}
+}
+
+func newLease(ctx context.Context, l *locker, key string, revision uint64) *lease {
+	ls := &lease{
+		locker:   l,
+		key:      key,
+		token:    revision,
+		revision: revision,
+		lost:     make(chan struct{}),
+		stop:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+
+	// Аренда продлевается до Unlock, даже если контекст захвата отменен
+	go ls.renew(context.WithoutCancel(ctx))
+
+	return ls
+}
+
+func (ls *lease) Key() string {
+	return ls.key
+}
+
+func (ls *lease) Token() uint64 {
+	return ls.token
+}
+
+func (ls *lease) Lost() <-chan struct{} {
+	return ls.lost
+}
+
+func (ls *lease) Unlock(ctx context.Context) error {
+	ls.stopOnce.Do(func() {
+		close(ls.stop)
+	})
+	<-ls.stopped
+
+	select {
+	case <-ls.lost:
+		return fmt.Errorf("unlock %s: %w", ls.key, ErrLockLost)
+	default:
+	}
+
+	// Удаляется только своя запись: если ее уже перезаписал другой владелец,
+	// ревизия не совпадет
+	err := ls.locker.kv.Delete(ctx, ls.key, jetstream.LastRevision(ls.revision))
+	if err != nil {
+		if isWrongRevision(err) {
+			ls.markLost()
+			return fmt.Errorf("unlock %s: %w", ls.key, ErrLockLost)
+		}
+		return fmt.Errorf("delete lock %s: %w", ls.key, err)
+	}
+
+	ls.locker.logger.DebugKV(ctx, "lock released", "key", ls.key, "token", ls.token)
+
+	return nil
+}
+
+// renew продлевает аренду каждую треть TTL. Аренда считается потерянной, если
+// запись перезаписана или удалена, а также если ее не удалось продлить в
+// течение TTL: к этому моменту сервер мог удалить запись
+func (ls *lease) renew(ctx context.Context) {
+	defer close(ls.stopped)
+
+	ttl := ls.locker.ttl
+	ticker := time.NewTicker(ttl / 3)
+	defer ticker.Stop()
+
+	expiresAt := time.Now().Add(ttl)
+
+	for {
+		select {
+		case <-ls.stop:
+			return
+		case <-ticker.C:
+		}
+
+		// Срок аренды отсчитывается от отправки запроса, а не от ответа
+		sentAt := time.Now()
+
+		renewCtx, cancel := context.WithTimeout(ctx, ttl/3)
+		revision, err := ls.locker.kv.Update(renewCtx, ls.key, []byte(ls.locker.owner), ls.revision)
+		cancel()
+		if err == nil {
+			ls.revision = revision
+
+			expiresAt = sentAt.Add(ttl)
+			continue
+		}
+
+		if isWrongRevision(err) {
+			ls.locker.logger.WarnKV(ctx, "lock taken over", "key", ls.key, "token", ls.token)
+			ls.markLost()
+			return
+		}
+
+		ls.locker.logger.ErrorKV(ctx, "renew lock error", "key", ls.key, "token", ls.token, "error", err.Error())
+
+		if time.Now().After(expiresAt) {
+			ls.locker.logger.WarnKV(ctx, "lock lease expired", "key", ls.key, "token", ls.token)
+			ls.markLost()
+			return
+		}
+	}
+}
+
+func (ls *lease) markLost() {
+	ls.lostOnce.Do(func() {
+		close(ls.lost)
+	})
+}
+
+// isWrongRevision проверяет, что запись изменилась после последнего продления
+func isWrongRevision(err error) bool {
+	var apiErr *jetstream.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode == jetstream.JSErrCodeStreamWrongLastSequence
+}
@@ -0,0 +1,145 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	logger_pkg "boilerplate/internal/pkg/logger"
+)
+
+const (
+	defaultBucket = "locks"
+	defaultTTL    = 15 * time.Second
+)
+
+var (
+	// ErrLocked блокировка удерживается другим владельцем
+	ErrLocked = errors.New("lock is held by another owner")
+	// ErrLockLost аренда блокировки истекла или блокировку захватил другой
+	// владелец
+	ErrLockLost = errors.New("lock lost")
+)
+
+// Locker распределенные блокировки с арендой на время TTL. Пока блокировка
+// удерживается, аренда автоматически продлевается. Если экземпляр приложения
+// перестает продлевать аренду, блокировка освобождается по истечении TTL
+type Locker interface {
+	// TryLock захватывает блокировку key без ожидания. Возвращает ErrLocked,
+	// если блокировка удерживается другим владельцем
+	TryLock(ctx context.Context, key string) (Lock, error)
+	// Lock ждет освобождения блокировки key и захватывает ее. Ожидание
+	// прерывается отменой ctx
+	Lock(ctx context.Context, key string) (Lock, error)
+}
+
+// Lock захваченная блокировка
+type Lock interface {
+	Key() string
+	// Token fencing token блокировки: у каждого следующего захвата ключа он
+	// больше, чем у предыдущего. Хранилище, которое изменяет владелец
+	// блокировки, может отклонять запись с токеном меньше уже записанного,
+	// чтобы владелец с истекшей арендой не перезаписал данные нового
+	Token() uint64
+	// Lost закрывается, когда аренда потеряна и блокировка может
+	// удерживаться другим владельцем
+	Lost() <-chan struct{}
+	// Unlock освобождает блокировку. Возвращает ErrLockLost, если аренда уже
+	// потеряна
+	Unlock(ctx context.Context) error
+}
+
+type locker struct {
+	logger logger_pkg.Logger
+	kv     jetstream.KeyValue
+	bucket string
+	owner  string
+	ttl    time.Duration
+}
+
+// NewLocker создает хранилище блокировок в JetStream KV. Запись ключа
+// удаляется сервером, если ее не обновляли дольше TTL
+func NewLocker(ctx context.Context, logger logger_pkg.Logger, js jetstream.JetStream, opts ...Option) (Locker, error) {
+	l := &locker{
+		logger: logger,
+		bucket: defaultBucket,
+		ttl:    defaultTTL,
+	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	if l.owner == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("get hostname: %w", err)
+		}
+		l.owner = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
+
+	var err error
+	l.kv, err = js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:      l.bucket,
+		Description: "distributed locks",
+		History:     1,
+		TTL:         l.ttl,
+		Storage:     jetstream.FileStorage,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create or update bucket %s: %w", l.bucket, err)
+	}
+
+	return l, nil
+}
+
+func (l *locker) TryLock(ctx context.Context, key string) (Lock, error) {
+	revision, err := l.kv.Create(ctx, key, []byte(l.owner))
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return nil, ErrLocked
+		}
+		return nil, fmt.Errorf("create lock %s: %w", key, err)
+	}
+
+	l.logger.DebugKV(ctx, "lock acquired", "key", key, "token", revision)
+
+	return newLease(ctx, l, key, revision), nil
+}
+
+func (l *locker) Lock(ctx context.Context, key string) (Lock, error) {
+	// Наблюдение начинается до попытки захвата, чтобы не пропустить
+	// освобождение между ними
+	watcher, err := l.kv.Watch(ctx, key, jetstream.UpdatesOnly())
+	if err != nil {
+		return nil, fmt.Errorf("watch lock %s: %w", key, err)
+	}
+	defer func() {
+		if err := watcher.Stop(); err != nil {
+			l.logger.ErrorKV(ctx, "stop lock watcher error", "key", key, "error", err.Error())
+		}
+	}()
+
+	// Об удалении записи по истечении TTL сервер не уведомляет, поэтому
+	// попытки повторяются и без событий
+	ticker := time.NewTicker(l.ttl / 4)
+	defer ticker.Stop()
+
+	for {
+		lock, err := l.TryLock(ctx, key)
+		if !errors.Is(err, ErrLocked) {
+			return lock, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		case <-watcher.Updates():
+		}
+	}
+}
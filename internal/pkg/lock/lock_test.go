@@ -0,0 +1,181 @@
+package lock_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/pkg/lock"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+)
+
+const ttl = 500 * time.Millisecond
+
+// newLockers создает count хранилищ блокировок в одном бакете, как у
+// нескольких экземпляров приложения
+func newLockers(t *testing.T, count int) (*suite_provider.Provider, []lock.Locker) {
+	t.Helper()
+
+	sp, cleanup := suite_provider.NewProvider()
+	t.Cleanup(cleanup)
+
+	lockers := make([]lock.Locker, count)
+	for i := range lockers {
+		var err error
+		lockers[i], err = lock.NewLocker(sp.Context(), sp.GetLogger(), sp.GetNatsClient().JetStream(), lock.WithTTL(ttl))
+		require.NoError(t, err)
+	}
+
+	return sp, lockers
+}
+
+func TestTryLock(t *testing.T) {
+	t.Parallel()
+
+	sp, lockers := newLockers(t, 2)
+	ctx := sp.Context()
+
+	first, err := lockers[0].TryLock(ctx, "job")
+	require.NoError(t, err)
+	require.Equal(t, "job", first.Key())
+
+	_, err = lockers[1].TryLock(ctx, "job")
+	require.ErrorIs(t, err, lock.ErrLocked)
+
+	// Другие ключи не блокируются
+	other, err := lockers[1].TryLock(ctx, "other-job")
+	require.NoError(t, err)
+	require.NoError(t, other.Unlock(ctx))
+
+	require.NoError(t, first.Unlock(ctx))
+
+	second, err := lockers[1].TryLock(ctx, "job")
+	require.NoError(t, err)
+	require.Greater(t, second.Token(), first.Token())
+	require.NoError(t, second.Unlock(ctx))
+}
+
+func TestLockRenewal(t *testing.T) {
+	t.Parallel()
+
+	sp, lockers := newLockers(t, 2)
+	ctx := sp.Context()
+
+	held, err := lockers[0].TryLock(ctx, "job")
+	require.NoError(t, err)
+
+	// Блокировка удерживается дольше TTL, пока аренда продлевается
+	time.Sleep(3 * ttl)
+
+	_, err = lockers[1].TryLock(ctx, "job")
+	require.ErrorIs(t, err, lock.ErrLocked)
+
+	select {
+	case <-held.Lost():
+		require.FailNow(t, "lock lost while renewed")
+	default:
+	}
+
+	require.NoError(t, held.Unlock(ctx))
+}
+
+func TestLockLost(t *testing.T) {
+	t.Parallel()
+
+	sp, lockers := newLockers(t, 2)
+	ctx := sp.Context()
+
+	held, err := lockers[0].TryLock(ctx, "job")
+	require.NoError(t, err)
+
+	// Запись удалена в обход владельца, например вручную
+	kv, err := sp.GetNatsClient().JetStream().KeyValue(ctx, "locks")
+	require.NoError(t, err)
+	require.NoError(t, kv.Purge(ctx, "job"))
+
+	select {
+	case <-held.Lost():
+	case <-time.After(2 * ttl):
+		require.FailNow(t, "lock not lost")
+	}
+
+	taken, err := lockers[1].TryLock(ctx, "job")
+	require.NoError(t, err)
+	require.Greater(t, taken.Token(), held.Token())
+
+	// Потерянная блокировка не освобождает захваченную другим владельцем
+	require.ErrorIs(t, held.Unlock(ctx), lock.ErrLockLost)
+
+	_, err = lockers[0].TryLock(ctx, "job")
+	require.ErrorIs(t, err, lock.ErrLocked)
+
+	require.NoError(t, taken.Unlock(ctx))
+}
+
+func TestLockExpires(t *testing.T) {
+	t.Parallel()
+
+	sp, lockers := newLockers(t, 1)
+	ctx := sp.Context()
+
+	// Владелец остановился аварийно и не продлевает аренду
+	kv, err := sp.GetNatsClient().JetStream().KeyValue(ctx, "locks")
+	require.NoError(t, err)
+	_, err = kv.Create(ctx, "job", []byte("crashed"))
+	require.NoError(t, err)
+
+	waitCtx, cancel := context.WithTimeout(ctx, 10*ttl)
+	defer cancel()
+
+	held, err := lockers[0].Lock(waitCtx, "job")
+	require.NoError(t, err)
+	require.NoError(t, held.Unlock(ctx))
+}
+
+func TestLockWaits(t *testing.T) {
+	t.Parallel()
+
+	sp, lockers := newLockers(t, 2)
+	ctx := sp.Context()
+
+	held, err := lockers[0].TryLock(ctx, "job")
+	require.NoError(t, err)
+
+	acquired := make(chan lock.Lock, 1)
+	go func() {
+		waited, err := lockers[1].Lock(ctx, "job")
+		if err == nil {
+			acquired <- waited
+		}
+	}()
+
+	select {
+	case <-acquired:
+		require.FailNow(t, "lock acquired while held")
+	case <-time.After(2 * ttl):
+	}
+
+	require.NoError(t, held.Unlock(ctx))
+
+	select {
+	case waited := <-acquired:
+		require.Greater(t, waited.Token(), held.Token())
+		require.NoError(t, waited.Unlock(ctx))
+	case <-time.After(ttl):
+		require.FailNow(t, "lock not acquired after unlock")
+	}
+
+	// Ожидание прерывается отменой контекста
+	held, err = lockers[0].TryLock(ctx, "job")
+	require.NoError(t, err)
+
+	waitCtx, cancel := context.WithTimeout(ctx, ttl)
+	defer cancel()
+
+	_, err = lockers[1].Lock(waitCtx, "job")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, held.Unlock(ctx))
+}
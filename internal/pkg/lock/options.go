@@ -0,0 +1,30 @@
+package lock
+
+import (
+	"time"
+)
+
+type Option func(*locker)
+
+// WithBucket задает имя бакета JetStream KV с блокировками
+func WithBucket(bucket string) Option {
+	return func(l *locker) {
+		l.bucket = bucket
+	}
+}
+
+// WithTTL задает время аренды блокировки. Аренда продлевается каждую треть
+// TTL
+func WithTTL(ttl time.Duration) Option {
+	return func(l *locker) {
+		l.ttl = ttl
+	}
+}
+
+// WithOwner задает владельца блокировок, который записывается в значение
+// ключа. По умолчанию имя хоста и идентификатор процесса
+func WithOwner(owner string) Option {
+	return func(l *locker) {
+		l.owner = owner
+	}
+}
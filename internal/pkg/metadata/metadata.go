@@ -6,6 +6,7 @@ const (
 	KeyRequestID = "request_id"
 	KeyUserID    = "user_id"
 	KeyIP        = "ip"
+	KeyLocale    = "locale"
 )
 
 func WithRequestID(ctx context.Context, requestID string) context.Context {
@@ -44,3 +45,14 @@ func GetIP(ctx context.Context) (string, bool) {
 	}
 	return "", false
 }
+
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, KeyLocale, locale) //nolint:revive,staticcheck
+}
+
+func GetLocale(ctx context.Context) (string, bool) {
+	if res, ok := ctx.Value(KeyLocale).(string); ok {
+		return res, true
+	}
+	return "", false
+}
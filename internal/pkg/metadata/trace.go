@@ -0,0 +1,31 @@
+package metadata
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Propagator переносит контекст трассировки W3C (traceparent, tracestate) и
+// baggage между процессами
+var Propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// GetTraceContext возвращает контекст трассировки и baggage контекста в виде
+// заголовков W3C или nil, если их нет
+func GetTraceContext(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	Propagator.Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// WithTraceContext восстанавливает в контексте контекст трассировки и baggage,
+// сохраненные GetTraceContext
+func WithTraceContext(ctx context.Context, traceContext map[string]string) context.Context {
+	if len(traceContext) == 0 {
+		return ctx
+	}
+	return Propagator.Extract(ctx, propagation.MapCarrier(traceContext))
+}
@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"boilerplate/internal/model"
+	logger_pkg "boilerplate/internal/pkg/logger"
+)
+
+// collectTimeout ограничивает время запроса состояния брокера при сборе метрик
+const collectTimeout = 5 * time.Second
+
+var (
+	streamMessages = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "broker", "stream_messages"),
+		"Number of messages stored in the topic.",
+		[]string{"topic"}, nil,
+	)
+	streamBytes = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "broker", "stream_bytes"),
+		"Size of messages stored in the topic.",
+		[]string{"topic"}, nil,
+	)
+	consumerPending = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "broker", "consumer_pending_messages"),
+		"Number of messages not yet delivered to the consumer.",
+		[]string{"topic", "consumer", "subject"}, nil,
+	)
+	consumerAckPending = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "broker", "consumer_ack_pending_messages"),
+		"Number of delivered messages awaiting acknowledgement.",
+		[]string{"topic", "consumer", "subject"}, nil,
+	)
+	consumerRedelivered = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "broker", "consumer_redelivered_messages"),
+		"Number of redelivered messages awaiting acknowledgement.",
+		[]string{"topic", "consumer", "subject"}, nil,
+	)
+	consumerLastActive = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "broker", "consumer_last_active_timestamp_seconds"),
+		"Time of the last delivery to the consumer.",
+		[]string{"topic", "consumer", "subject"}, nil,
+	)
+)
+
+// brokerCollector запрашивает состояние топиков и консьюмеров при каждом
+// сборе метрик
+type brokerCollector struct {
+	logger       logger_pkg.Logger
+	brokerClient model.BrokerClient
+}
+
+// NewBrokerCollector создает сборщик метрик топиков и консьюмеров клиента
+// брокера. Отставание консьюмера - метрика consumer_pending_messages
+func NewBrokerCollector(logger logger_pkg.Logger, brokerClient model.BrokerClient) prometheus.Collector {
+	return &brokerCollector{
+		logger:       logger,
+		brokerClient: brokerClient,
+	}
+}
+
+func (c *brokerCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- streamMessages
+	ch <- streamBytes
+	ch <- consumerPending
+	ch <- consumerAckPending
+	ch <- consumerRedelivered
+	ch <- consumerLastActive
+}
+
+func (c *brokerCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	streams, err := c.brokerClient.GetStreamStats(ctx)
+	if err != nil {
+		c.logger.ErrorKV(ctx, "collect stream stats error", "error", err.Error())
+	}
+	for _, stream := range streams {
+		ch <- prometheus.MustNewConstMetric(streamMessages, prometheus.GaugeValue, float64(stream.Messages), stream.Topic)
+		ch <- prometheus.MustNewConstMetric(streamBytes, prometheus.GaugeValue, float64(stream.Bytes), stream.Topic)
+	}
+
+	consumers, err := c.brokerClient.GetConsumerStats(ctx)
+	if err != nil {
+		c.logger.ErrorKV(ctx, "collect consumer stats error", "error", err.Error())
+	}
+	for _, consumer := range consumers {
+		labels := []string{consumer.Topic, consumer.Consumer, consumer.Subject}
+		ch <- prometheus.MustNewConstMetric(consumerPending, prometheus.GaugeValue, float64(consumer.Pending), labels...)
+		ch <- prometheus.MustNewConstMetric(consumerAckPending, prometheus.GaugeValue, float64(consumer.AckPending), labels...)
+		ch <- prometheus.MustNewConstMetric(consumerRedelivered, prometheus.GaugeValue, float64(consumer.Redelivered), labels...)
+		if consumer.LastActive != nil {
+			ch <- prometheus.MustNewConstMetric(consumerLastActive, prometheus.GaugeValue, float64(consumer.LastActive.Unix()), labels...)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package metrics_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/model/mocks"
+	logger_pkg "boilerplate/internal/pkg/logger"
+	"boilerplate/internal/pkg/metrics"
+)
+
+func TestBrokerCollector(t *testing.T) {
+	t.Parallel()
+
+	logger, err := logger_pkg.New()
+	require.NoError(t, err)
+
+	lastActive := time.Unix(1700000000, 0)
+	client := mocks.NewBrokerClient(t)
+	client.EXPECT().GetStreamStats(mock.Anything).Return([]*model.BrokerStreamStats{
+		{Topic: "user-created", Messages: 10, Bytes: 2048},
+	}, nil)
+	client.EXPECT().GetConsumerStats(mock.Anything).Return([]*model.BrokerConsumerStats{
+		{Topic: "user-created", Consumer: "user-created-consumer", Subject: "user-created.p0", Pending: 7, AckPending: 1, Redelivered: 1, LastActive: &lastActive},
+		{Topic: "user-created", Consumer: "user-created-consumer", Subject: "user-created.p1"},
+	}, nil)
+
+	expected := `
+# HELP boilerplate_broker_consumer_ack_pending_messages Number of delivered messages awaiting acknowledgement.
+# TYPE boilerplate_broker_consumer_ack_pending_messages gauge
+boilerplate_broker_consumer_ack_pending_messages{consumer="user-created-consumer",subject="user-created.p0",topic="user-created"} 1
+boilerplate_broker_consumer_ack_pending_messages{consumer="user-created-consumer",subject="user-created.p1",topic="user-created"} 0
+# HELP boilerplate_broker_consumer_last_active_timestamp_seconds Time of the last delivery to the consumer.
+# TYPE boilerplate_broker_consumer_last_active_timestamp_seconds gauge
+boilerplate_broker_consumer_last_active_timestamp_seconds{consumer="user-created-consumer",subject="user-created.p0",topic="user-created"} 1.7e+09
+# HELP boilerplate_broker_consumer_pending_messages Number of messages not yet delivered to the consumer.
+# TYPE boilerplate_broker_consumer_pending_messages gauge
+boilerplate_broker_consumer_pending_messages{consumer="user-created-consumer",subject="user-created.p0",topic="user-created"} 7
+boilerplate_broker_consumer_pending_messages{consumer="user-created-consumer",subject="user-created.p1",topic="user-created"} 0
+# HELP boilerplate_broker_consumer_redelivered_messages Number of redelivered messages awaiting acknowledgement.
+# TYPE boilerplate_broker_consumer_redelivered_messages gauge
+boilerplate_broker_consumer_redelivered_messages{consumer="user-created-consumer",subject="user-created.p0",topic="user-created"} 1
+boilerplate_broker_consumer_redelivered_messages{consumer="user-created-consumer",subject="user-created.p1",topic="user-created"} 0
+# HELP boilerplate_broker_stream_bytes Size of messages stored in the topic.
+# TYPE boilerplate_broker_stream_bytes gauge
+boilerplate_broker_stream_bytes{topic="user-created"} 2048
+# HELP boilerplate_broker_stream_messages Number of messages stored in the topic.
+# TYPE boilerplate_broker_stream_messages gauge
+boilerplate_broker_stream_messages{topic="user-created"} 10
+`
+	err = testutil.CollectAndCompare(metrics.NewBrokerCollector(logger, client), strings.NewReader(expected))
+	require.NoError(t, err)
+}
+
+func TestBrokerCollectorError(t *testing.T) {
+	t.Parallel()
+
+	logger, err := logger_pkg.New()
+	require.NoError(t, err)
+
+	// Метрики консьюмеров собираются, даже если состояние топиков недоступно
+	client := mocks.NewBrokerClient(t)
+	client.EXPECT().GetStreamStats(mock.Anything).Return(nil, errors.New("unavailable"))
+	client.EXPECT().GetConsumerStats(mock.Anything).Return([]*model.BrokerConsumerStats{
+		{Topic: "user-created", Consumer: "user-created-consumer", Subject: "user-created", Pending: 3},
+	}, nil)
+
+	require.Equal(t, 3, testutil.CollectAndCount(metrics.NewBrokerCollector(logger, client)))
+}
@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace префикс метрик приложения
+const namespace = "boilerplate"
+
+// NewRegistry создает реестр метрик со стандартными метриками Go и процесса
+func NewRegistry() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return registry
+}
+
+// NewHandler создает роутер внутреннего сервера метрик с обработчиком /metrics
+// в формате Prometheus. Метрики раскрывают топики и консьюмеров брокера,
+// поэтому сервер запускается на отдельном порту, а не на порту API
+func NewHandler(gatherer prometheus.Gatherer) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+
+	return mux
+}
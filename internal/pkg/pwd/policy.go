@@ -0,0 +1,50 @@
+package pwd
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"boilerplate/internal/pkg/errors"
+)
+
+const MinLength = 8
+
+var (
+	ErrTooShort    = errors.NewBadRequestError("Пароль должен содержать не менее 8 символов")
+	ErrNoLetter    = errors.NewBadRequestError("Пароль должен содержать хотя бы одну букву")
+	ErrNoDigit     = errors.NewBadRequestError("Пароль должен содержать хотя бы одну цифру")
+	ErrHasSpace    = errors.NewBadRequestError("Пароль не должен содержать пробелов")
+	ErrInvalidUTF8 = errors.NewBadRequestError("Пароль содержит недопустимые символы")
+)
+
+// CheckPolicy проверяет пароль на соответствие политике паролей
+func CheckPolicy(password string) error {
+	if !utf8.ValidString(password) {
+		return ErrInvalidUTF8
+	}
+	if utf8.RuneCountInString(password) < MinLength {
+		return ErrTooShort
+	}
+
+	var hasLetter, hasDigit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsSpace(r):
+			return ErrHasSpace
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		default:
+		}
+	}
+
+	if !hasLetter {
+		return ErrNoLetter
+	}
+	if !hasDigit {
+		return ErrNoDigit
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package pwd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPolicy(t *testing.T) {
+	type testCase struct {
+		Name     string
+		Password string
+		Expected error
+	}
+
+	testCases := []testCase{
+		{
+			Name:     "valid",
+			Password: "secret123",
+			Expected: nil,
+		},
+		{
+			Name:     "valid cyrillic",
+			Password: "пароль123",
+			Expected: nil,
+		},
+		{
+			Name:     "too short",
+			Password: "abc12",
+			Expected: ErrTooShort,
+		},
+		{
+			Name:     "no letter",
+			Password: "12345678",
+			Expected: ErrNoLetter,
+		},
+		{
+			Name:     "no digit",
+			Password: "abcdefgh",
+			Expected: ErrNoDigit,
+		},
+		{
+			Name:     "with space",
+			Password: "secret 123",
+			Expected: ErrHasSpace,
+		},
+		{
+			Name:     "invalid utf8",
+			Password: "secret123\xff",
+			Expected: ErrInvalidUTF8,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := CheckPolicy(testCase.Password)
+			require.ErrorIs(t, err, testCase.Expected)
+		})
+	}
+}
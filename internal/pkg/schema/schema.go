@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// DefaultSchema допускает любой JSON-объект
+const DefaultSchema = `{"type": "object"}`
+
+const resourceURL = "schema.json"
+
+// ValidationError перечисляет нарушения схемы вида "/path: причина"
+type ValidationError struct {
+	Details []string
+}
+
+func (e *ValidationError) Error() string {
+	return strings.Join(e.Details, "; ")
+}
+
+// Validator проверяет значения по JSON Schema
+type Validator interface {
+	Validate(value any) error
+}
+
+type validator struct {
+	schema *jsonschema.Schema
+}
+
+// NewValidator компилирует JSON Schema
+func NewValidator(schema []byte) (Validator, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(schema))
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal schema: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(resourceURL, doc); err != nil {
+		return nil, fmt.Errorf("add schema resource: %w", err)
+	}
+
+	compiled, err := compiler.Compile(resourceURL)
+	if err != nil {
+		return nil, fmt.Errorf("compile schema: %w", err)
+	}
+
+	return &validator{
+		schema: compiled,
+	}, nil
+}
+
+// LoadValidator читает JSON Schema из файла. Если путь не указан,
+// используется DefaultSchema
+func LoadValidator(path string) (Validator, error) {
+	if path == "" {
+		return NewValidator([]byte(DefaultSchema))
+	}
+
+	schema, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("read schema %s: %w", path, err)
+	}
+
+	return NewValidator(schema)
+}
+
+// Validate проверяет значение по схеме. Несоответствие схеме возвращается
+// как *ValidationError
+func (v *validator) Validate(value any) error {
+	err := v.schema.Validate(value)
+	if err == nil {
+		return nil
+	}
+
+	validationErr, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return fmt.Errorf("validate: %w", err)
+	}
+
+	return newValidationError(validationErr)
+}
+
+func newValidationError(err *jsonschema.ValidationError) *ValidationError {
+	details := []string{}
+
+	for _, unit := range err.BasicOutput().Errors {
+		if unit.Error == nil {
+			continue
+		}
+
+		location := unit.InstanceLocation
+		if location == "" {
+			location = "/"
+		}
+
+		details = append(details, location+": "+unit.Error.String())
+	}
+
+	return &ValidationError{
+		Details: details,
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `{
+	"type": "object",
+	"properties": {
+		"department": {"type": "string"},
+		"level": {"type": "integer", "minimum": 1}
+	},
+	"required": ["department"],
+	"additionalProperties": false
+}`
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	v, err := NewValidator([]byte(testSchema))
+	require.NoError(t, err)
+
+	require.NoError(t, v.Validate(map[string]any{"department": "sales", "level": float64(2)}))
+
+	err = v.Validate(map[string]any{"level": float64(0)})
+	validationErr := &ValidationError{}
+	require.ErrorAs(t, err, &validationErr)
+	require.Len(t, validationErr.Details, 2)
+	require.Contains(t, err.Error(), "/level")
+
+	err = v.Validate(map[string]any{"department": "sales", "unknown": true})
+	require.ErrorAs(t, err, &validationErr)
+}
+
+func TestNewValidatorInvalidSchema(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewValidator([]byte(`{"type": 1}`))
+	require.Error(t, err)
+
+	_, err = NewValidator([]byte(`not json`))
+	require.Error(t, err)
+}
+
+func TestLoadValidator(t *testing.T) {
+	t.Parallel()
+
+	validationErr := &ValidationError{}
+
+	v, err := LoadValidator("")
+	require.NoError(t, err)
+	require.NoError(t, v.Validate(map[string]any{"anything": "goes"}))
+	require.ErrorAs(t, v.Validate("not an object"), &validationErr)
+
+	path := filepath.Join(t.TempDir(), "schema.json")
+	require.NoError(t, os.WriteFile(path, []byte(testSchema), 0o600))
+
+	v, err = LoadValidator(path)
+	require.NoError(t, err)
+	require.ErrorAs(t, v.Validate(map[string]any{}), &validationErr)
+
+	_, err = LoadValidator(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
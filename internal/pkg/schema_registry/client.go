@@ -0,0 +1,30 @@
+package schema_registry
+
+import (
+	"context"
+
+	"boilerplate/internal/model"
+)
+
+type validatingClient struct {
+	model.BrokerClient
+	registry Registry
+}
+
+// NewValidatingClient оборачивает клиент брокера: Publish проверяет событие
+// по схеме топика из реестра и не отправляет событие, которое ей не
+// соответствует. Остальные методы вызываются без изменений
+func NewValidatingClient(client model.BrokerClient, registry Registry) model.BrokerClient {
+	return &validatingClient{
+		BrokerClient: client,
+		registry:     registry,
+	}
+}
+
+func (c *validatingClient) Publish(ctx context.Context, topic string, partition *int, key, data any, opts ...model.PublishOption) error {
+	if err := c.registry.Validate(ctx, topic, data, opts...); err != nil {
+		return err
+	}
+
+	return c.BrokerClient.Publish(ctx, topic, partition, key, data, opts...)
+}
@@ -0,0 +1,293 @@
+package schema_registry
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Ограничения JSON Schema, которые не должны становиться строже: нижние
+// границы не растут, верхние не уменьшаются
+var (
+	lowerBounds = []string{"minimum", "exclusiveMinimum", "minLength", "minItems", "minProperties"}
+	upperBounds = []string{"maximum", "exclusiveMaximum", "maxLength", "maxItems", "maxProperties"}
+)
+
+// checkCompatibility проверяет обратную совместимость: события, которые
+// соответствуют previous, должны соответствовать и next. Возвращает нарушения
+// вида "путь: причина"
+func checkCompatibility(previous, next *compiledSchema) []string {
+	if previous.Format != next.Format {
+		return []string{fmt.Sprintf("/: format changed from %s to %s", previous.Format, next.Format)}
+	}
+
+	if previous.Format == FormatProtobuf {
+		if previous.Message != next.Message {
+			return []string{fmt.Sprintf("/: message changed from %s to %s", previous.Message, next.Message)}
+		}
+		return compareMessages("", previous.message, next.message, map[protoreflect.FullName]bool{})
+	}
+
+	return compareJSON("", previous.document, next.document)
+}
+
+// compareMessages сравнивает поля с одинаковыми номерами. Удаление поля
+// совместимо: его значение в старых событиях читается как неизвестное
+func compareMessages(path string, previous, next protoreflect.MessageDescriptor, compared map[protoreflect.FullName]bool) []string {
+	if compared[previous.FullName()] {
+		return nil
+	}
+	compared[previous.FullName()] = true
+
+	violations := []string{}
+
+	fields := previous.Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		nextField := next.Fields().ByNumber(field.Number())
+		if nextField == nil {
+			continue
+		}
+
+		fieldPath := path + "/" + string(field.Name())
+
+		switch {
+		case field.Name() != nextField.Name():
+			violations = append(violations, fmt.Sprintf("%s: field %d renamed to %s", fieldPath, field.Number(), nextField.Name()))
+		case field.Kind() != nextField.Kind():
+			violations = append(violations, fmt.Sprintf("%s: type changed from %s to %s", fieldPath, field.Kind(), nextField.Kind()))
+		case field.Cardinality() != nextField.Cardinality() || field.IsMap() != nextField.IsMap():
+			violations = append(violations, fmt.Sprintf("%s: cardinality changed", fieldPath))
+		case field.Message() != nil:
+			if field.Message().FullName() != nextField.Message().FullName() {
+				violations = append(violations, fmt.Sprintf("%s: message changed from %s to %s",
+					fieldPath, field.Message().FullName(), nextField.Message().FullName()))
+				continue
+			}
+			violations = append(violations, compareMessages(fieldPath, field.Message(), nextField.Message(), compared)...)
+		case field.Enum() != nil:
+			violations = append(violations, compareEnums(fieldPath, field.Enum(), nextField.Enum())...)
+		default:
+			// Скалярное поле того же типа совместимо
+		}
+	}
+
+	return violations
+}
+
+func compareEnums(path string, previous, next protoreflect.EnumDescriptor) []string {
+	if previous.FullName() != next.FullName() {
+		return []string{fmt.Sprintf("%s: enum changed from %s to %s", path, previous.FullName(), next.FullName())}
+	}
+
+	violations := []string{}
+
+	values := previous.Values()
+	for i := range values.Len() {
+		if next.Values().ByNumber(values.Get(i).Number()) == nil {
+			violations = append(violations, fmt.Sprintf("%s: enum value %s removed", path, values.Get(i).Name()))
+		}
+	}
+
+	return violations
+}
+
+// compareJSON сравнивает ключевые слова JSON Schema, которые ограничивают
+// значения: type, enum, const, pattern, format, $ref, границы, required,
+// properties, additionalProperties и items. Остальные ключевые слова не
+// проверяются
+func compareJSON(path string, previous, next any) []string {
+	location := path
+	if location == "" {
+		location = "/"
+	}
+
+	// Булевы схемы: true допускает любое значение, false - никакое
+	nextDoc, ok := next.(map[string]any)
+	if !ok {
+		if isFalse(next) && !isFalse(previous) {
+			return []string{location + ": schema rejects all values"}
+		}
+		return nil
+	}
+	prevDoc, ok := previous.(map[string]any)
+	if !ok {
+		if isFalse(previous) {
+			return nil
+		}
+		prevDoc = map[string]any{}
+	}
+
+	violations := compareValues(location, prevDoc, nextDoc)
+	violations = append(violations, compareObjects(path, prevDoc, nextDoc)...)
+
+	if items, ok := nextDoc["items"]; ok {
+		prevItems, ok := prevDoc["items"]
+		if !ok {
+			prevItems = true
+		}
+		violations = append(violations, compareJSON(path+"/items", prevItems, items)...)
+	}
+
+	return violations
+}
+
+// compareValues сравнивает ограничения самого значения
+func compareValues(location string, prevDoc, nextDoc map[string]any) []string {
+	violations := []string{}
+
+	if removed := removedTypes(prevDoc["type"], nextDoc["type"]); len(removed) > 0 {
+		violations = append(violations, fmt.Sprintf("%s: types %v no longer allowed", location, removed))
+	}
+
+	if nextEnum, ok := nextDoc["enum"].([]any); ok {
+		prevEnum, ok := prevDoc["enum"].([]any)
+		if !ok {
+			violations = append(violations, location+": enum added")
+		}
+		for _, value := range prevEnum {
+			if !slices.ContainsFunc(nextEnum, func(v any) bool { return reflect.DeepEqual(v, value) }) {
+				violations = append(violations, fmt.Sprintf("%s: enum value %v removed", location, value))
+			}
+		}
+	}
+
+	for _, keyword := range []string{"const", "pattern", "format", "$ref"} {
+		value, ok := nextDoc[keyword]
+		if ok && !reflect.DeepEqual(prevDoc[keyword], value) {
+			violations = append(violations, fmt.Sprintf("%s: %s changed", location, keyword))
+		}
+	}
+
+	for _, keyword := range lowerBounds {
+		if stricter(prevDoc[keyword], nextDoc[keyword], func(prev, next float64) bool { return next > prev }) {
+			violations = append(violations, fmt.Sprintf("%s: %s increased", location, keyword))
+		}
+	}
+	for _, keyword := range upperBounds {
+		if stricter(prevDoc[keyword], nextDoc[keyword], func(prev, next float64) bool { return next < prev }) {
+			violations = append(violations, fmt.Sprintf("%s: %s decreased", location, keyword))
+		}
+	}
+
+	return violations
+}
+
+// compareObjects сравнивает свойства объекта. Удаление свойства совместимо,
+// пока объект допускает дополнительные свойства
+func compareObjects(path string, prevDoc, nextDoc map[string]any) []string {
+	location := path
+	if location == "" {
+		location = "/"
+	}
+
+	violations := []string{}
+
+	prevRequired := keywordList(prevDoc, "required")
+	for _, name := range keywordList(nextDoc, "required") {
+		if !slices.Contains(prevRequired, name) {
+			violations = append(violations, fmt.Sprintf("%s: property %v became required", location, name))
+		}
+	}
+
+	closed := isFalse(nextDoc["additionalProperties"])
+	if closed && !isFalse(prevDoc["additionalProperties"]) {
+		violations = append(violations, location+": additional properties forbidden")
+	}
+
+	prevProperties := keywordObject(prevDoc, "properties")
+	nextProperties := keywordObject(nextDoc, "properties")
+	for _, name := range slices.Sorted(maps.Keys(prevProperties)) {
+		nextProperty, ok := nextProperties[name]
+		if !ok {
+			if closed {
+				violations = append(violations, fmt.Sprintf("%s/%s: property removed", path, name))
+			}
+			continue
+		}
+		violations = append(violations, compareJSON(path+"/"+name, prevProperties[name], nextProperty)...)
+	}
+
+	return violations
+}
+
+// removedTypes возвращает типы previous, которые не допускает next. Тип
+// integer допускается типом number. Отсутствие type допускает любые типы
+func removedTypes(previous, next any) []string {
+	nextTypes := jsonTypes(next)
+	if nextTypes == nil {
+		return nil
+	}
+
+	prevTypes := jsonTypes(previous)
+	if prevTypes == nil {
+		prevTypes = []string{"array", "boolean", "null", "number", "object", "string"}
+	}
+
+	removed := []string{}
+	for _, t := range prevTypes {
+		if slices.Contains(nextTypes, t) || t == "integer" && slices.Contains(nextTypes, "number") {
+			continue
+		}
+		removed = append(removed, t)
+	}
+
+	return removed
+}
+
+func jsonTypes(value any) []string {
+	switch value := value.(type) {
+	case string:
+		return []string{value}
+	case []any:
+		types := make([]string, 0, len(value))
+		for _, t := range value {
+			if s, ok := t.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	default:
+		return nil
+	}
+}
+
+// stricter проверяет, что граница next строже previous. Появление новой
+// границы тоже строже
+func stricter(previous, next any, isStricter func(prev, next float64) bool) bool {
+	nextValue, ok := next.(float64)
+	if !ok {
+		return false
+	}
+
+	prevValue, ok := previous.(float64)
+	if !ok {
+		return true
+	}
+
+	return isStricter(prevValue, nextValue)
+}
+
+func isFalse(value any) bool {
+	b, ok := value.(bool)
+	return ok && !b
+}
+
+func keywordList(doc map[string]any, keyword string) []any {
+	list, ok := doc[keyword].([]any)
+	if !ok {
+		return nil
+	}
+	return list
+}
+
+func keywordObject(doc map[string]any, keyword string) map[string]any {
+	object, ok := doc[keyword].(map[string]any)
+	if !ok {
+		return nil
+	}
+	return object
+}
@@ -0,0 +1,10 @@
+package schema_registry
+
+type Option func(*registry)
+
+// WithBucket задает имя бакета JetStream KV со схемами
+func WithBucket(bucket string) Option {
+	return func(r *registry) {
+		r.bucket = bucket
+	}
+}
@@ -0,0 +1,402 @@
+package schema_registry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/cloudevents"
+	logger_pkg "boilerplate/internal/pkg/logger"
+)
+
+const defaultBucket = "event-schemas"
+
+var (
+	// ErrSchemaNotFound у топика нет схемы указанной версии
+	ErrSchemaNotFound = errors.New("schema not found")
+	// ErrEventInvalid событие не соответствует схеме топика
+	ErrEventInvalid = errors.New("event does not match schema")
+)
+
+// IncompatibleError новая версия схемы не может читать события, которые
+// соответствуют последней версии
+type IncompatibleError struct {
+	Topic   string
+	Details []string
+}
+
+func (e *IncompatibleError) Error() string {
+	return fmt.Sprintf("schema of topic %s is incompatible with latest version: %s", e.Topic, strings.Join(e.Details, "; "))
+}
+
+// Registry реестр схем событий по топикам и версиям. Схемы хранятся в
+// JetStream KV, каждый экземпляр приложения держит их копию в памяти и
+// получает новые версии через наблюдение за бакетом
+type Registry interface {
+	// Register сохраняет схему следующей версией схемы топика. Если схема
+	// совпадает с последней версией, новая версия не создается и
+	// возвращается последняя. Схема, несовместимая с последней версией,
+	// отклоняется с *IncompatibleError
+	Register(ctx context.Context, schema *Schema) (*Schema, error)
+	// Get возвращает версию схемы топика или ErrSchemaNotFound
+	Get(ctx context.Context, topic string, version int) (*Schema, error)
+	// Latest возвращает последнюю версию схемы топика или ErrSchemaNotFound
+	Latest(ctx context.Context, topic string) (*Schema, error)
+	// List возвращает все версии схем топика, а если topic не задан - схемы
+	// всех топиков, по топику и версии
+	List(ctx context.Context, topic string) ([]*Schema, error)
+	// Validate проверяет событие по последней версии схемы топика. data
+	// кодируется так же, как в model.BrokerClient.Publish. Событие топика без
+	// схемы считается корректным. Несоответствие схеме возвращается с
+	// ErrEventInvalid
+	Validate(ctx context.Context, topic string, data any, opts ...model.PublishOption) error
+	// Close останавливает наблюдение за бакетом
+	Close() error
+}
+
+type registry struct {
+	logger logger_pkg.Logger
+	kv     jetstream.KeyValue
+	bucket string
+
+	mu sync.RWMutex
+	// schemas версии схем по топикам в порядке возрастания
+	schemas map[string][]*compiledSchema
+
+	watcher jetstream.KeyWatcher
+	done    chan struct{}
+}
+
+// NewRegistry создает бакет схем в JetStream KV и загружает сохраненные
+// схемы
+func NewRegistry(ctx context.Context, logger logger_pkg.Logger, js jetstream.JetStream, opts ...Option) (Registry, error) {
+	r := &registry{
+		logger:  logger,
+		bucket:  defaultBucket,
+		schemas: map[string][]*compiledSchema{},
+		done:    make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	var err error
+	r.kv, err = js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:      r.bucket,
+		Description: "event schemas",
+		History:     1,
+		Storage:     jetstream.FileStorage,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create or update bucket %s: %w", r.bucket, err)
+	}
+
+	// Наблюдение продолжается до Close и не зависит от ctx создания
+	r.watcher, err = r.kv.WatchAll(context.WithoutCancel(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("watch bucket %s: %w", r.bucket, err)
+	}
+
+	// Сначала приходят сохраненные схемы, затем nil
+	for entry := range r.watcher.Updates() {
+		if entry == nil {
+			break
+		}
+		r.applyEntry(ctx, entry)
+	}
+
+	go r.watch(ctx)
+
+	return r, nil
+}
+
+func (r *registry) watch(ctx context.Context) {
+	defer close(r.done)
+
+	for entry := range r.watcher.Updates() {
+		if entry != nil {
+			r.applyEntry(ctx, entry)
+		}
+	}
+}
+
+// applyEntry применяет изменение из наблюдения за бакетом. Схема, которую
+// не удалось разобрать, пропускается
+func (r *registry) applyEntry(ctx context.Context, entry jetstream.KeyValueEntry) {
+	if err := r.apply(entry); err != nil {
+		r.logger.ErrorKV(ctx, "apply event schema error", "key", entry.Key(), "error", err.Error())
+	}
+}
+
+func (r *registry) Close() error {
+	select {
+	case <-r.done:
+		// Подписка закрыта вместе с соединением
+		return nil
+	default:
+	}
+
+	if err := r.watcher.Stop(); err != nil {
+		return fmt.Errorf("stop watcher: %w", err)
+	}
+	<-r.done
+
+	return nil
+}
+
+func (r *registry) Register(ctx context.Context, schema *Schema) (*Schema, error) {
+	if schema.Topic == "" {
+		return nil, errors.New("schema topic is empty")
+	}
+
+	next, err := compile(schema)
+	if err != nil {
+		return nil, fmt.Errorf("compile schema: %w", err)
+	}
+
+	for {
+		latest := r.latest(schema.Topic)
+
+		version := 1
+		if latest != nil {
+			if latest.equal(schema) {
+				return cloneSchema(latest.Schema), nil
+			}
+
+			if details := checkCompatibility(latest, next); len(details) > 0 {
+				return nil, &IncompatibleError{
+					Topic:   schema.Topic,
+					Details: details,
+				}
+			}
+
+			version = latest.Version + 1
+		}
+
+		stored := cloneSchema(schema)
+		stored.Version = version
+		stored.CreatedAt = time.Now().UTC()
+
+		value, err := json.Marshal(stored)
+		if err != nil {
+			return nil, fmt.Errorf("marshal schema: %w", err)
+		}
+
+		key := schemaKey(stored.Topic, version)
+		_, err = r.kv.Create(ctx, key, value)
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			// Версию создал другой экземпляр приложения, а наблюдение за
+			// бакетом еще не получило ее. Проверка повторяется с ней
+			entry, err := r.kv.Get(ctx, key)
+			if err != nil {
+				return nil, fmt.Errorf("get schema %s: %w", key, err)
+			}
+			if err = r.apply(entry); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("create schema %s: %w", key, err)
+		}
+
+		next.Schema = stored
+		r.add(next)
+
+		r.logger.InfoKV(ctx, "event schema registered", "topic", stored.Topic, "version", version)
+
+		return cloneSchema(stored), nil
+	}
+}
+
+func (r *registry) Get(_ context.Context, topic string, version int) (*Schema, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, schema := range r.schemas[topic] {
+		if schema.Version == version {
+			return cloneSchema(schema.Schema), nil
+		}
+	}
+
+	return nil, ErrSchemaNotFound
+}
+
+func (r *registry) Latest(_ context.Context, topic string) (*Schema, error) {
+	latest := r.latest(topic)
+	if latest == nil {
+		return nil, ErrSchemaNotFound
+	}
+
+	return cloneSchema(latest.Schema), nil
+}
+
+func (r *registry) List(_ context.Context, topic string) ([]*Schema, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	topics := []string{topic}
+	if topic == "" {
+		topics = slices.Sorted(maps.Keys(r.schemas))
+	}
+
+	schemas := []*Schema{}
+	for _, name := range topics {
+		for _, schema := range r.schemas[name] {
+			schemas = append(schemas, cloneSchema(schema.Schema))
+		}
+	}
+
+	return schemas, nil
+}
+
+func (r *registry) Validate(_ context.Context, topic string, data any, opts ...model.PublishOption) error {
+	latest := r.latest(topic)
+	if latest == nil {
+		return nil
+	}
+
+	event, err := cloudevents.New("", topic, data, opts...)
+	if err != nil {
+		return err
+	}
+
+	if err = latest.validate(event); err != nil {
+		return fmt.Errorf("%w %s version %d: %w", ErrEventInvalid, topic, latest.Version, err)
+	}
+
+	return nil
+}
+
+// validate проверяет данные и тип события
+func (s *compiledSchema) validate(event *cloudevents.Event) error {
+	if s.Format == FormatProtobuf {
+		if event.DataContentType != cloudevents.ContentTypeProtobuf {
+			return fmt.Errorf("content type %s, expected %s", event.DataContentType, cloudevents.ContentTypeProtobuf)
+		}
+		if event.Type != s.Message {
+			return fmt.Errorf("type %s, expected %s", event.Type, s.Message)
+		}
+		if err := proto.Unmarshal(event.Data, dynamicpb.NewMessage(s.message)); err != nil {
+			return fmt.Errorf("unmarshal protobuf data: %w", err)
+		}
+		return nil
+	}
+
+	if event.DataContentType != cloudevents.ContentTypeJSON {
+		return fmt.Errorf("content type %s, expected %s", event.DataContentType, cloudevents.ContentTypeJSON)
+	}
+
+	value, err := jsonschema.UnmarshalJSON(bytes.NewReader(event.Data))
+	if err != nil {
+		return fmt.Errorf("unmarshal json data: %w", err)
+	}
+
+	return s.validator.Validate(value)
+}
+
+func (r *registry) latest(topic string) *compiledSchema {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	versions := r.schemas[topic]
+	if len(versions) == 0 {
+		return nil
+	}
+
+	return versions[len(versions)-1]
+}
+
+// add сохраняет версию схемы в памяти, если ее там еще нет
+func (r *registry) add(schema *compiledSchema) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	versions := r.schemas[schema.Topic]
+	index, found := slices.BinarySearchFunc(versions, schema.Version, func(s *compiledSchema, version int) int {
+		return s.Version - version
+	})
+	if !found {
+		r.schemas[schema.Topic] = slices.Insert(versions, index, schema)
+	}
+}
+
+func (r *registry) remove(topic string, version int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.schemas[topic] = slices.DeleteFunc(r.schemas[topic], func(s *compiledSchema) bool {
+		return s.Version == version
+	})
+	if len(r.schemas[topic]) == 0 {
+		delete(r.schemas, topic)
+	}
+}
+
+// apply применяет изменение записи бакета к схемам в памяти
+func (r *registry) apply(entry jetstream.KeyValueEntry) error {
+	topic, version, err := parseKey(entry.Key())
+	if err != nil {
+		return err
+	}
+
+	if entry.Operation() != jetstream.KeyValuePut {
+		r.remove(topic, version)
+		return nil
+	}
+
+	schema := &Schema{}
+	if err = json.Unmarshal(entry.Value(), schema); err != nil {
+		return fmt.Errorf("unmarshal schema %s: %w", entry.Key(), err)
+	}
+
+	compiled, err := compile(schema)
+	if err != nil {
+		return fmt.Errorf("compile schema %s: %w", entry.Key(), err)
+	}
+
+	r.add(compiled)
+
+	return nil
+}
+
+// schemaKey ключ версии схемы в бакете: имя топика и номер версии через точку
+func schemaKey(topic string, version int) string {
+	return topic + "." + strconv.Itoa(version)
+}
+
+func parseKey(key string) (string, int, error) {
+	index := strings.LastIndex(key, ".")
+	if index < 0 {
+		return "", 0, fmt.Errorf("invalid schema key %s", key)
+	}
+
+	version, err := strconv.Atoi(key[index+1:])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid schema key %s: %w", key, err)
+	}
+
+	return key[:index], version, nil
+}
+
+func cloneSchema(schema *Schema) *Schema {
+	clone := *schema
+	clone.Definition = slices.Clone(schema.Definition)
+
+	return &clone
+}
@@ -0,0 +1,382 @@
+package schema_registry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/model/mocks"
+	"boilerplate/internal/pkg/schema_registry"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/topics"
+	"boilerplate/pkg/pb/events"
+)
+
+const orderSchema = `{
+	"type": "object",
+	"properties": {
+		"order_id": {"type": "integer"},
+		"status": {"type": "string", "enum": ["created", "paid"]}
+	},
+	"required": ["order_id"]
+}`
+
+func newRegistry(t *testing.T) (*suite_provider.Provider, schema_registry.Registry) {
+	t.Helper()
+
+	sp, cleanup := suite_provider.NewProvider()
+	t.Cleanup(cleanup)
+
+	return sp, sp.GetSchemaRegistry()
+}
+
+func jsonSchema(t *testing.T, topic, definition string) *schema_registry.Schema {
+	t.Helper()
+
+	schema, err := schema_registry.NewJSONSchema(topic, []byte(definition))
+	require.NoError(t, err)
+
+	return schema
+}
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	sp, registry := newRegistry(t)
+	ctx := sp.Context()
+
+	first, err := registry.Register(ctx, jsonSchema(t, "orders", orderSchema))
+	require.NoError(t, err)
+	require.Equal(t, 1, first.Version)
+	require.Equal(t, schema_registry.FormatJSON, first.Format)
+
+	// Та же схема в другом форматировании не создает новую версию
+	same, err := registry.Register(ctx, jsonSchema(t, "orders", `{"required": ["order_id"], "properties": {"status": {"enum": ["created", "paid"], "type": "string"}, "order_id": {"type": "integer"}}, "type": "object"}`))
+	require.NoError(t, err)
+	require.Equal(t, 1, same.Version)
+
+	second, err := registry.Register(ctx, jsonSchema(t, "orders", `{
+		"type": "object",
+		"properties": {
+			"order_id": {"type": "integer"},
+			"status": {"type": "string", "enum": ["created", "paid", "cancelled"]},
+			"comment": {"type": "string"}
+		},
+		"required": ["order_id"]
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, 2, second.Version)
+
+	_, err = registry.Register(ctx, jsonSchema(t, "orders", `{
+		"type": "object",
+		"properties": {
+			"order_id": {"type": "string"},
+			"status": {"type": "string", "enum": ["created"]}
+		},
+		"required": ["order_id", "status"]
+	}`))
+	var incompatibleErr *schema_registry.IncompatibleError
+	require.ErrorAs(t, err, &incompatibleErr)
+	require.ElementsMatch(t, []string{
+		"/: property status became required",
+		"/order_id: types [integer] no longer allowed",
+		"/status: enum value paid removed",
+		"/status: enum value cancelled removed",
+	}, incompatibleErr.Details)
+
+	latest, err := registry.Latest(ctx, "orders")
+	require.NoError(t, err)
+	require.Equal(t, second, latest)
+
+	schema, err := registry.Get(ctx, "orders", 1)
+	require.NoError(t, err)
+	require.Equal(t, first, schema)
+
+	_, err = registry.Get(ctx, "orders", 3)
+	require.ErrorIs(t, err, schema_registry.ErrSchemaNotFound)
+	_, err = registry.Latest(ctx, "payments")
+	require.ErrorIs(t, err, schema_registry.ErrSchemaNotFound)
+
+	_, err = registry.Register(ctx, jsonSchema(t, "payments", `{"type": "object"}`))
+	require.NoError(t, err)
+
+	schemas, err := registry.List(ctx, "orders")
+	require.NoError(t, err)
+	require.Equal(t, []*schema_registry.Schema{first, second}, schemas)
+
+	schemas, err = registry.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, schemas, 3)
+
+	// Другой экземпляр приложения загружает сохраненные схемы и получает новые
+	other, err := schema_registry.NewRegistry(ctx, sp.GetLogger(), sp.GetNatsClient().JetStream())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, other.Close())
+	}()
+
+	schemas, err = other.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, schemas, 3)
+
+	third, err := registry.Register(ctx, jsonSchema(t, "orders", `{"type": "object"}`))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		latest, err := other.Latest(ctx, "orders")
+		return err == nil && latest.Version == third.Version
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Версию, которую уже создал другой экземпляр, регистрация не
+	// перезаписывает
+	schema, err = other.Register(ctx, jsonSchema(t, "orders", `{"type": "object"}`))
+	require.NoError(t, err)
+	require.Equal(t, third, schema)
+
+	_, err = registry.Register(ctx, jsonSchema(t, "orders", `{"type": "object", "additionalProperties": false}`))
+	require.ErrorAs(t, err, &incompatibleErr)
+}
+
+func TestJSONCompatibility(t *testing.T) {
+	t.Parallel()
+
+	sp, registry := newRegistry(t)
+	ctx := sp.Context()
+
+	tests := []struct {
+		name       string
+		previous   string
+		next       string
+		compatible bool
+	}{
+		{"optional property added", `{"properties": {"a": {"type": "string"}}}`, `{"properties": {"a": {"type": "string"}, "b": {"type": "integer"}}}`, true},
+		{"required property removed", `{"required": ["a"]}`, `{}`, true},
+		{"integer widened to number", `{"type": "integer"}`, `{"type": "number"}`, true},
+		{"type added", `{"type": "string"}`, `{"type": ["string", "null"]}`, true},
+		{"enum extended", `{"enum": [1, 2]}`, `{"enum": [1, 2, 3]}`, true},
+		{"bound relaxed", `{"maxLength": 10, "minimum": 1}`, `{"maxLength": 20, "minimum": 0}`, true},
+		{"open object property removed", `{"properties": {"a": {}}}`, `{"properties": {}}`, true},
+		{"number narrowed to integer", `{"type": "number"}`, `{"type": "integer"}`, false},
+		{"type restricted", `{}`, `{"type": "object"}`, false},
+		{"property required", `{"properties": {"a": {}}}`, `{"properties": {"a": {}}, "required": ["a"]}`, false},
+		{"enum added", `{"type": "string"}`, `{"type": "string", "enum": ["a"]}`, false},
+		{"bound tightened", `{"maxItems": 10}`, `{"maxItems": 5}`, false},
+		{"bound added", `{}`, `{"minLength": 1}`, false},
+		{"pattern changed", `{"pattern": "^a"}`, `{"pattern": "^b"}`, false},
+		{"object closed", `{"properties": {"a": {}}}`, `{"properties": {"a": {}}, "additionalProperties": false}`, false},
+		{"closed object property removed", `{"properties": {"a": {}, "b": {}}, "additionalProperties": false}`, `{"properties": {"a": {}}, "additionalProperties": false}`, false},
+		{"nested property changed", `{"properties": {"a": {"properties": {"b": {"type": "string"}}}}}`, `{"properties": {"a": {"properties": {"b": {"type": "integer"}}}}}`, false},
+		{"items changed", `{"items": {"type": "string"}}`, `{"items": {"type": "integer"}}`, false},
+		{"schema rejects all", `true`, `false`, false},
+	}
+
+	for _, tt := range tests {
+		topic := utils.UUID().String()
+
+		_, err := registry.Register(ctx, jsonSchema(t, topic, tt.previous))
+		require.NoError(t, err, tt.name)
+
+		_, err = registry.Register(ctx, jsonSchema(t, topic, tt.next))
+		if tt.compatible {
+			require.NoError(t, err, tt.name)
+		} else {
+			var incompatibleErr *schema_registry.IncompatibleError
+			require.ErrorAs(t, err, &incompatibleErr, tt.name)
+		}
+	}
+}
+
+// protoSchema схема сообщения test.Event с полями fields
+func protoSchema(t *testing.T, topic string, fields ...*descriptorpb.FieldDescriptorProto) *schema_registry.Schema {
+	t.Helper()
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    utils.Ptr("test.proto"),
+		Package: utils.Ptr("test"),
+		Syntax:  utils.Ptr("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: utils.Ptr("Event"), Field: fields},
+			{Name: utils.Ptr("Other")},
+		},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: utils.Ptr("Status"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: utils.Ptr("STATUS_UNKNOWN"), Number: utils.Ptr(int32(0))},
+				{Name: utils.Ptr("STATUS_ACTIVE"), Number: utils.Ptr(int32(1))},
+			},
+		}},
+	}
+
+	definition, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{file}})
+	require.NoError(t, err)
+
+	return &schema_registry.Schema{
+		Topic:      topic,
+		Format:     schema_registry.FormatProtobuf,
+		Definition: definition,
+		Message:    "test.Event",
+	}
+}
+
+func field(name string, number int32, fieldType descriptorpb.FieldDescriptorProto_Type, typeName ...string) *descriptorpb.FieldDescriptorProto {
+	f := &descriptorpb.FieldDescriptorProto{
+		Name:     utils.Ptr(name),
+		JsonName: utils.Ptr(name),
+		Number:   utils.Ptr(number),
+		Type:     fieldType.Enum(),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+	}
+	if len(typeName) > 0 {
+		f.TypeName = utils.Ptr(typeName[0])
+	}
+
+	return f
+}
+
+func TestProtoCompatibility(t *testing.T) {
+	t.Parallel()
+
+	sp, registry := newRegistry(t)
+	ctx := sp.Context()
+
+	id := field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64)
+	name := field("name", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING)
+	tags := field("tags", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING)
+	tags.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+
+	tests := []struct {
+		name       string
+		previous   []*descriptorpb.FieldDescriptorProto
+		next       []*descriptorpb.FieldDescriptorProto
+		compatible bool
+	}{
+		{"field added", []*descriptorpb.FieldDescriptorProto{id}, []*descriptorpb.FieldDescriptorProto{id, name}, true},
+		{"field removed", []*descriptorpb.FieldDescriptorProto{id, name}, []*descriptorpb.FieldDescriptorProto{id}, true},
+		{"field renamed", []*descriptorpb.FieldDescriptorProto{id}, []*descriptorpb.FieldDescriptorProto{field("user_id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64)}, false},
+		{"type changed", []*descriptorpb.FieldDescriptorProto{id}, []*descriptorpb.FieldDescriptorProto{field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING)}, false},
+		{"field repeated", []*descriptorpb.FieldDescriptorProto{id, field("tags", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING)}, []*descriptorpb.FieldDescriptorProto{id, tags}, false},
+		{
+			"message changed",
+			[]*descriptorpb.FieldDescriptorProto{field("payload", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".test.Event")},
+			[]*descriptorpb.FieldDescriptorProto{field("payload", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".test.Other")},
+			false,
+		},
+		{
+			"enum kept",
+			[]*descriptorpb.FieldDescriptorProto{field("status", 1, descriptorpb.FieldDescriptorProto_TYPE_ENUM, ".test.Status")},
+			[]*descriptorpb.FieldDescriptorProto{field("status", 1, descriptorpb.FieldDescriptorProto_TYPE_ENUM, ".test.Status"), name},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		topic := utils.UUID().String()
+
+		_, err := registry.Register(ctx, protoSchema(t, topic, tt.previous...))
+		require.NoError(t, err, tt.name)
+
+		_, err = registry.Register(ctx, protoSchema(t, topic, tt.next...))
+		if tt.compatible {
+			require.NoError(t, err, tt.name)
+		} else {
+			var incompatibleErr *schema_registry.IncompatibleError
+			require.ErrorAs(t, err, &incompatibleErr, tt.name)
+		}
+	}
+
+	// Схема другого сообщения и другого формата несовместимы
+	topic := utils.UUID().String()
+	created, err := schema_registry.NewProtoSchema(topic, &events.UserCreated{})
+	require.NoError(t, err)
+	_, err = registry.Register(ctx, created)
+	require.NoError(t, err)
+
+	deleted, err := schema_registry.NewProtoSchema(topic, &events.UserDeleted{})
+	require.NoError(t, err)
+	var incompatibleErr *schema_registry.IncompatibleError
+	_, err = registry.Register(ctx, deleted)
+	require.ErrorAs(t, err, &incompatibleErr)
+
+	_, err = registry.Register(ctx, jsonSchema(t, topic, `{}`))
+	require.ErrorAs(t, err, &incompatibleErr)
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	sp, registry := newRegistry(t)
+	ctx := sp.Context()
+
+	require.NoError(t, topics.RegisterSchemas(ctx, registry))
+
+	// Повторная регистрация при запуске приложения не создает новых версий
+	require.NoError(t, topics.RegisterSchemas(ctx, registry))
+	schemas, err := registry.List(ctx, "")
+	require.NoError(t, err)
+	for _, schema := range schemas {
+		require.Equal(t, 1, schema.Version, schema.Topic)
+	}
+
+	valid := map[string]any{
+		topics.TopicUserCreated:       &events.UserCreated{UserId: 1, Name: "user"},
+		topics.TopicUserDeleted:       &events.UserDeleted{UserId: 1},
+		topics.TopicUserExportCreated: &model.UserExportCreatedEvent{ExportID: 1},
+		topics.TopicGroupMembersChanged: &model.GroupMembersChangedEvent{
+			GroupID: 1,
+			Action:  model.GroupMembersActionAdded,
+			UserIDs: []int{1, 2},
+		},
+		// Топики без схемы не проверяются
+		"unknown": map[string]any{"any": true},
+	}
+	for topic, data := range valid {
+		require.NoError(t, registry.Validate(ctx, topic, data), topic)
+	}
+
+	invalid := map[string]any{
+		topics.TopicUserCreated:       &events.UserDeleted{UserId: 1},
+		topics.TopicUserDeleted:       map[string]any{"user_id": 1},
+		topics.TopicUserExportCreated: &model.UserExportCreatedEvent{},
+		topics.TopicGroupMembersChanged: &model.GroupMembersChangedEvent{
+			GroupID: 1,
+			Action:  "moved",
+			UserIDs: []int{},
+		},
+	}
+	for topic, data := range invalid {
+		err := registry.Validate(ctx, topic, data)
+		require.ErrorIs(t, err, schema_registry.ErrEventInvalid, topic)
+	}
+}
+
+func TestValidatingClient(t *testing.T) {
+	t.Parallel()
+
+	sp, registry := newRegistry(t)
+	ctx := sp.Context()
+
+	_, err := registry.Register(ctx, jsonSchema(t, "orders", orderSchema))
+	require.NoError(t, err)
+
+	brokerClient := mocks.NewBrokerClient(t)
+	client := schema_registry.NewValidatingClient(brokerClient, registry)
+
+	valid := map[string]any{"order_id": 1, "status": "paid"}
+	brokerClient.EXPECT().Publish(mock.Anything, "orders", (*int)(nil), 1, valid).Return(nil).Once()
+	require.NoError(t, client.Publish(ctx, "orders", nil, 1, valid))
+
+	err = client.Publish(ctx, "orders", nil, 1, map[string]any{"status": "lost"})
+	require.ErrorIs(t, err, schema_registry.ErrEventInvalid)
+
+	// Остальные методы передаются клиенту без изменений
+	publishErr := errors.New("publish failed")
+	brokerClient.EXPECT().PublishMessage(mock.Anything, mock.Anything).Return(publishErr).Once()
+	require.ErrorIs(t, client.PublishMessage(context.Background(), &model.BrokerMessage{}), publishErr)
+}
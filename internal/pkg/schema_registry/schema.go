@@ -0,0 +1,153 @@
+package schema_registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	schema_pkg "boilerplate/internal/pkg/schema"
+)
+
+type Format string
+
+const (
+	FormatJSON     Format = "json"
+	FormatProtobuf Format = "protobuf"
+)
+
+// Schema версия схемы событий топика
+type Schema struct {
+	Topic   string `json:"topic"`
+	Version int    `json:"version"`
+	Format  Format `json:"format"`
+	// Definition JSON Schema или FileDescriptorSet в protobuf с файлом
+	// сообщения и его зависимостями
+	Definition []byte `json:"definition"`
+	// Message полное имя proto-сообщения события
+	Message   string    `json:"message,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewJSONSchema создает схему JSON-событий топика. Definition сохраняется в
+// каноническом виде, чтобы одинаковые схемы не создавали новых версий
+func NewJSONSchema(topic string, definition []byte) (*Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(definition))
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal json schema: %w", err)
+	}
+
+	canonical, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("marshal json schema: %w", err)
+	}
+
+	return &Schema{
+		Topic:      topic,
+		Format:     FormatJSON,
+		Definition: canonical,
+	}, nil
+}
+
+// NewProtoSchema создает схему protobuf-событий топика с типом message
+func NewProtoSchema(topic string, message proto.Message) (*Schema, error) {
+	descriptor := message.ProtoReflect().Descriptor()
+
+	set := &descriptorpb.FileDescriptorSet{}
+	addFile(set, descriptor.ParentFile(), map[string]bool{})
+
+	definition, err := proto.MarshalOptions{Deterministic: true}.Marshal(set)
+	if err != nil {
+		return nil, fmt.Errorf("marshal descriptor set: %w", err)
+	}
+
+	return &Schema{
+		Topic:      topic,
+		Format:     FormatProtobuf,
+		Definition: definition,
+		Message:    string(descriptor.FullName()),
+	}, nil
+}
+
+// addFile добавляет файл в набор после его зависимостей
+func addFile(set *descriptorpb.FileDescriptorSet, file protoreflect.FileDescriptor, added map[string]bool) {
+	if added[file.Path()] {
+		return
+	}
+	added[file.Path()] = true
+
+	imports := file.Imports()
+	for i := range imports.Len() {
+		addFile(set, imports.Get(i).FileDescriptor, added)
+	}
+
+	set.File = append(set.File, protodesc.ToFileDescriptorProto(file))
+}
+
+// compiledSchema схема с разобранным определением для проверки событий и
+// совместимости версий
+type compiledSchema struct {
+	*Schema
+
+	// JSON Schema
+	document  any
+	validator schema_pkg.Validator
+
+	// protobuf
+	message protoreflect.MessageDescriptor
+}
+
+func compile(schema *Schema) (*compiledSchema, error) {
+	compiled := &compiledSchema{
+		Schema: schema,
+	}
+
+	switch schema.Format {
+	case FormatJSON:
+		if err := json.Unmarshal(schema.Definition, &compiled.document); err != nil {
+			return nil, fmt.Errorf("unmarshal json schema: %w", err)
+		}
+
+		validator, err := schema_pkg.NewValidator(schema.Definition)
+		if err != nil {
+			return nil, err
+		}
+		compiled.validator = validator
+	case FormatProtobuf:
+		set := &descriptorpb.FileDescriptorSet{}
+		if err := proto.Unmarshal(schema.Definition, set); err != nil {
+			return nil, fmt.Errorf("unmarshal descriptor set: %w", err)
+		}
+
+		files, err := protodesc.NewFiles(set)
+		if err != nil {
+			return nil, fmt.Errorf("build descriptors: %w", err)
+		}
+
+		descriptor, err := files.FindDescriptorByName(protoreflect.FullName(schema.Message))
+		if err != nil {
+			return nil, fmt.Errorf("find message %s: %w", schema.Message, err)
+		}
+
+		message, ok := descriptor.(protoreflect.MessageDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a message", schema.Message)
+		}
+		compiled.message = message
+	default:
+		return nil, fmt.Errorf("unknown schema format %q", schema.Format)
+	}
+
+	return compiled, nil
+}
+
+// equal проверяет, что схема определяет события так же, как s
+func (s *compiledSchema) equal(schema *Schema) bool {
+	return s.Format == schema.Format && s.Message == schema.Message && bytes.Equal(s.Definition, schema.Definition)
+}
@@ -28,6 +28,7 @@ func NewServer(
 ) Server {
 	s := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(middleware...),
+		grpc.StreamInterceptor(streamInterceptor(middleware)),
 	)
 
 	reflection.Register(s)
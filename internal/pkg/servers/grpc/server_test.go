@@ -0,0 +1,136 @@
+package grpc_server_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"boilerplate/internal/model"
+	grpc_server "boilerplate/internal/pkg/servers/grpc"
+)
+
+type prefixKey struct{}
+
+// streamHandler регистрирует метод /test.Test/Repeat, который отвечает
+// значением запроса с префиксом из контекста указанное количество раз
+type streamHandler struct{}
+
+func (h *streamHandler) RegisterGRPCServer(server *grpc.Server) {
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "test.Test",
+		HandlerType: (*any)(nil),
+		Streams: []grpc.StreamDesc{{
+			StreamName:    "Repeat",
+			ServerStreams: true,
+			Handler: func(_ any, stream grpc.ServerStream) error {
+				req := &wrapperspb.StringValue{}
+				if err := stream.RecvMsg(req); err != nil {
+					return err
+				}
+
+				if req.GetValue() == "panic" {
+					panic("repeat")
+				}
+
+				prefix, ok := stream.Context().Value(prefixKey{}).(string)
+				if !ok {
+					return status.Error(codes.Internal, "prefix not set")
+				}
+				for i := range 3 {
+					if err := stream.SendMsg(wrapperspb.String(prefix + req.GetValue() + strconv.Itoa(i))); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		}},
+	}, struct{}{})
+}
+
+func (h *streamHandler) RegisterHTTPHandler(context.Context, *runtime.ServeMux, *grpc.ClientConn) error {
+	return nil
+}
+
+func TestStreamMiddleware(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+
+	// Перехватчики отклоняют запрос со значением denied и добавляют префикс
+	// в контекст метода
+	server := grpc_server.NewServer(host, port, []grpc.UnaryServerInterceptor{
+		func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if value, ok := req.(*wrapperspb.StringValue); ok && value.GetValue() == "denied" {
+				return nil, status.Error(codes.PermissionDenied, "denied")
+			}
+			return handler(ctx, req)
+		},
+		func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			return handler(context.WithValue(ctx, prefixKey{}, "prefix-"), req)
+		},
+	}, []model.GRPCHandler{&streamHandler{}})
+	go func() {
+		_ = server.Start()
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	repeat := func(value string) ([]string, error) {
+		stream, err := conn.NewStream(context.Background(), &grpc.StreamDesc{ServerStreams: true}, "/test.Test/Repeat", grpc.WaitForReady(true))
+		if err != nil {
+			return nil, err
+		}
+		if err := stream.SendMsg(wrapperspb.String(value)); err != nil {
+			return nil, err
+		}
+		if err := stream.CloseSend(); err != nil {
+			return nil, err
+		}
+
+		values := []string{}
+		for {
+			resp := &wrapperspb.StringValue{}
+			err := stream.RecvMsg(resp)
+			if errors.Is(err, io.EOF) {
+				return values, nil
+			}
+			if err != nil {
+				return values, err
+			}
+			values = append(values, resp.GetValue())
+		}
+	}
+
+	values, err := repeat("value")
+	require.NoError(t, err)
+	require.Equal(t, []string{"prefix-value0", "prefix-value1", "prefix-value2"}, values)
+
+	values, err = repeat("denied")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Empty(t, values)
+
+	_, err = repeat("panic")
+	require.Equal(t, codes.Internal, status.Code(err))
+}
@@ -0,0 +1,123 @@
+package grpc_server
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// streamInterceptor выполняет цепочку unary-перехватчиков для методов с одним
+// запросом и потоком ответов, чтобы аутентификация, проверка запроса и
+// журналирование работали для них так же, как для обычных методов. Цепочка
+// получает запрос, когда метод его читает, а ее обработчик ждет окончания
+// метода
+func streamInterceptor(middleware []grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.IsClientStream {
+			return status.Error(codes.Unimplemented, "client streaming is not supported")
+		}
+
+		stream := &serverStream{
+			ServerStream: ss,
+			ctx:          ss.Context(),
+			middleware:   middleware,
+			info:         &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod},
+			started:      make(chan struct{}),
+			done:         make(chan error),
+			result:       make(chan error, 1),
+		}
+
+		return stream.finish(stream.handle(srv, handler))
+	}
+}
+
+// serverStream передает методу контекст, подготовленный цепочкой перехватчиков
+type serverStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	middleware []grpc.UnaryServerInterceptor
+	info       *grpc.UnaryServerInfo
+
+	// received запрос прочитан и передан цепочке
+	received bool
+	// started закрывается, когда цепочка вызвала обработчик
+	started chan struct{}
+	// done передает обработчику цепочки результат метода
+	done chan error
+	// result результат цепочки
+	result chan error
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if s.received {
+		return nil
+	}
+	s.received = true
+
+	go func() {
+		_, err := chain(s.middleware)(s.ServerStream.Context(), m, s.info, func(ctx context.Context, _ any) (any, error) {
+			s.ctx = ctx
+			close(s.started)
+			return &emptypb.Empty{}, <-s.done
+		})
+		s.result <- err
+	}()
+
+	select {
+	case <-s.started:
+		return nil
+	case err := <-s.result:
+		// Цепочка отклонила запрос, метод не выполняется
+		return err
+	}
+}
+
+// handle выполняет метод. Паника метода возвращается как ошибка, так как
+// перехватчик паники цепочки работает в другой горутине
+func (s *serverStream) handle(srv any, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = status.Errorf(codes.Internal, "panic: %v", e)
+		}
+	}()
+
+	return handler(srv, s)
+}
+
+// finish передает цепочке результат метода и возвращает результат цепочки
+func (s *serverStream) finish(err error) error {
+	select {
+	case <-s.started:
+	default:
+		// Метод завершился до вызова обработчика цепочки
+		return err
+	}
+
+	s.done <- err
+	return <-s.result
+}
+
+// chain объединяет перехватчики в один, первый перехватчик вызывается первым
+func chain(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, h := interceptors[i], next
+			next = func(ctx context.Context, req any) (any, error) {
+				return interceptor(ctx, req, info, h)
+			}
+		}
+		return next(ctx, req)
+	}
+}
@@ -21,6 +21,13 @@ func (f *UserFactory) WithID(id int) *UserFactory {
 	return f
 }
 
+func (f *UserFactory) WithVersion(version int) *UserFactory {
+	f.setters = append(f.setters, func(user *repository.User) {
+		user.Version = version
+	})
+	return f
+}
+
 func (f *UserFactory) WithAdmin() *UserFactory {
 	f.setters = append(f.setters, func(user *repository.User) {
 		user.IsAdmin = true
@@ -35,6 +42,13 @@ func (f *UserFactory) WithPassword(password string) *UserFactory {
 	return f
 }
 
+func (f *UserFactory) WithAttributes(attributes map[string]any) *UserFactory {
+	f.setters = append(f.setters, func(user *repository.User) {
+		user.Attributes = attributes
+	})
+	return f
+}
+
 func (f *UserFactory) Build() *repository.User {
 	return f.generate()
 }
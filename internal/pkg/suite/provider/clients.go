@@ -1,27 +1,42 @@
 package suite_provider
 
 import (
+	"os"
+	"time"
+
 	"boilerplate/internal/model"
-	model_mocks "boilerplate/internal/model/mocks"
 	"boilerplate/internal/pkg/clients/chrome"
 	"boilerplate/internal/pkg/clients/mail"
 	"boilerplate/internal/pkg/clients/mail/mocks"
+	"boilerplate/internal/pkg/clients/memory_broker"
+	nats_client "boilerplate/internal/pkg/clients/nats"
 	"boilerplate/internal/pkg/clients/s3"
+	"boilerplate/internal/pkg/lock"
+	"boilerplate/internal/pkg/schema_registry"
+	nats_server "boilerplate/internal/pkg/servers/nats"
+	"boilerplate/internal/topics"
 )
 
 type clients struct {
-	s3Client     s3.Client
-	chromeClient chrome.Client
-	brokerClient model.BrokerClient
-	mailClient   mail.Client
+	s3Client       s3.Client
+	chromeClient   chrome.Client
+	brokerClient   model.BrokerClient
+	mailClient     mail.Client
+	natsClient     nats_client.Client
+	locker         lock.Locker
+	schemaRegistry schema_registry.Registry
 }
 
 func (p *Provider) GetS3Client() s3.Client {
 	if p.clients.s3Client == nil {
 		var err error
-		p.clients.s3Client, err = s3.NewClient(p.Context(), p.config.S3.Host, p.config.S3.Port, p.config.S3.AccessKey, p.config.S3.SecretKey, p.config.S3.Bucket, s3.WithLogger(p.GetLogger()))
-		if err != nil {
-			panic(err)
+		if p.config.S3.Backend == model.S3BackendNats {
+			p.clients.s3Client = s3.NewObjectStoreClient(p.GetNatsClient().JetStream(), p.config.S3.Bucket, s3.WithLogger(p.GetLogger()))
+		} else {
+			p.clients.s3Client, err = s3.NewClient(p.Context(), p.config.S3.Host, p.config.S3.Port, p.config.S3.AccessKey, p.config.S3.SecretKey, p.config.S3.Bucket, s3.WithLogger(p.GetLogger()))
+			if err != nil {
+				panic(err)
+			}
 		}
 
 		_, err = p.clients.s3Client.CreateBucket(p.Context(), p.config.S3.Bucket)
@@ -39,9 +54,18 @@ func (p *Provider) GetChromeClient() chrome.Client {
 	return p.clients.chromeClient
 }
 
+// GetBrokerClient возвращает брокер в памяти процесса с топиками приложения.
+// Сообщения доставляются подписчикам синхронно, а опубликованные сообщения
+// проверяются через memory_broker.Client
 func (p *Provider) GetBrokerClient() model.BrokerClient {
 	if p.clients.brokerClient == nil {
-		p.clients.brokerClient = &model_mocks.BrokerClient{}
+		brokerClient := memory_broker.NewClient(p.GetLogger())
+		if err := topics.CreateOrUpdateTopics(p.Context(), brokerClient); err != nil {
+			panic(err)
+		}
+
+		p.clients.brokerClient = brokerClient
+		p.cleanups = append(p.cleanups, brokerClient.Close)
 	}
 	return p.clients.brokerClient
 }
@@ -52,3 +76,77 @@ func (p *Provider) GetMailClient() mail.Client {
 	}
 	return p.clients.mailClient
 }
+
+// GetNatsClient запускает встроенный сервер NATS с JetStream и возвращает
+// подключенного к нему клиента
+func (p *Provider) GetNatsClient() nats_client.Client {
+	if p.clients.natsClient == nil {
+		dataDir, err := os.MkdirTemp("", "nats")
+		if err != nil {
+			panic(err)
+		}
+
+		server, err := nats_server.NewServer(&model.ConfigNats{
+			Host:     "127.0.0.1",
+			Port:     "-1",
+			HTTPPort: "0",
+			DataDir:  dataDir,
+		}, nats_server.WithJetStream("TEST"))
+		if err != nil {
+			panic(err)
+		}
+
+		err = server.Start()
+		if err != nil {
+			panic(err)
+		}
+
+		conn, err := server.GetConn()
+		if err != nil {
+			panic(err)
+		}
+
+		p.clients.natsClient, err = nats_client.NewClient(p.GetLogger(), nats_client.WithConn(conn))
+		if err != nil {
+			panic(err)
+		}
+
+		p.cleanups = append(p.cleanups,
+			p.clients.natsClient.Close,
+			server.Stop,
+			func() error {
+				return os.RemoveAll(dataDir)
+			},
+		)
+	}
+	return p.clients.natsClient
+}
+
+// GetLocker возвращает блокировки в JetStream KV встроенного сервера NATS
+func (p *Provider) GetLocker() lock.Locker {
+	if p.clients.locker == nil {
+		var err error
+		p.clients.locker, err = lock.NewLocker(p.Context(), p.GetLogger(), p.GetNatsClient().JetStream(),
+			lock.WithTTL(time.Duration(p.config.Lock.TTL)*time.Second))
+		if err != nil {
+			panic(err)
+		}
+	}
+	return p.clients.locker
+}
+
+// GetSchemaRegistry возвращает реестр схем событий в JetStream KV встроенного
+// сервера NATS
+func (p *Provider) GetSchemaRegistry() schema_registry.Registry {
+	if p.clients.schemaRegistry == nil {
+		var err error
+		p.clients.schemaRegistry, err = schema_registry.NewRegistry(p.Context(), p.GetLogger(), p.GetNatsClient().JetStream())
+		if err != nil {
+			panic(err)
+		}
+
+		// Наблюдение останавливается до закрытия клиента NATS
+		p.cleanups = append([]func() error{p.clients.schemaRegistry.Close}, p.cleanups...)
+	}
+	return p.clients.schemaRegistry
+}
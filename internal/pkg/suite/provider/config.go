@@ -1,6 +1,9 @@
 package suite_provider
 
-import "boilerplate/internal/model"
+import (
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/schema"
+)
 
 func (sp *Provider) GetConfig() *model.Config {
 	if sp.config == nil {
@@ -9,6 +12,17 @@ func (sp *Provider) GetConfig() *model.Config {
 	return sp.config
 }
 
+func (sp *Provider) GetAttributesValidator() schema.Validator {
+	if sp.attributesValidator == nil {
+		var err error
+		sp.attributesValidator, err = schema.LoadValidator(sp.GetConfig().Users.AttributesSchema)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return sp.attributesValidator
+}
+
 func InitConfig() *model.Config {
 	return &model.Config{
 		DB: model.ConfigDB{
@@ -22,11 +36,13 @@ func InitConfig() *model.Config {
 		API: model.ConfigAPI{
 			HTTPPort:         "8080",
 			GRPCPort:         "8082",
+			MetricsPort:      "9090",
 			AccessPrivateKey: "dd4dcf2eae3c3a6f097d69f49ce584852d66ac85505f5d264e1b6fb8f90d9019",
 			AccessTokenTTL:   10,
 			RefreshTokenTTL:  60,
 		},
 		S3: model.ConfigS3{
+			Backend:   model.S3BackendS3,
 			Host:      "localhost",
 			Port:      "9000",
 			AccessKey: "admin",
@@ -38,5 +54,25 @@ func InitConfig() *model.Config {
 			Port:    "3000",
 			Timeout: 30,
 		},
+		Users: model.ConfigUsers{
+			EmailConfirmURL: "http://localhost:8080/api/users/email/confirm",
+			EmailUndoURL:    "http://localhost:8080/api/users/email/undo",
+			EmailChangeTTL:  86400,
+			EmailUndoTTL:    604800,
+		},
+		Outbox: model.ConfigOutbox{
+			PollInterval:  100,
+			BatchSize:     100,
+			MaxAttempts:   3,
+			RetryDelay:    1,
+			MaxRetryDelay: 10,
+			Retention:     3600,
+		},
+		Inbox: model.ConfigInbox{
+			Retention: 3600,
+		},
+		Lock: model.ConfigLock{
+			TTL: 15,
+		},
 	}
 }
@@ -5,6 +5,7 @@ import (
 
 	"boilerplate/internal/model"
 	logger_pkg "boilerplate/internal/pkg/logger"
+	"boilerplate/internal/pkg/schema"
 	"boilerplate/internal/repository"
 )
 
@@ -17,6 +18,8 @@ type Provider struct {
 	services services
 	handlers handlers // nolint: unused
 	cleanups []func() error
+
+	attributesValidator schema.Validator
 }
 
 func NewProvider() (*Provider, func()) {
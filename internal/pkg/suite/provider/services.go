@@ -2,12 +2,30 @@ package suite_provider
 
 import (
 	"boilerplate/internal/services/auth"
+	"boilerplate/internal/services/broker"
+	"boilerplate/internal/services/groups"
+	"boilerplate/internal/services/inbox"
+	"boilerplate/internal/services/notifications"
+	"boilerplate/internal/services/outbox"
+	"boilerplate/internal/services/preferences"
+	"boilerplate/internal/services/scheduler"
+	"boilerplate/internal/services/user_exports"
+	"boilerplate/internal/services/user_imports"
 	"boilerplate/internal/services/users"
 )
 
 type services struct {
-	auth  auth.Service
-	users users.Service
+	auth          auth.Service
+	users         users.Service
+	userImports   user_imports.Service
+	userExports   user_exports.Service
+	preferences   preferences.Service
+	groups        groups.Service
+	outbox        outbox.Service
+	notifications notifications.Service
+	broker        broker.Service
+	inbox         inbox.Service
+	scheduler     scheduler.Service
 }
 
 func (sp *Provider) GetAuthService() auth.Service {
@@ -15,6 +33,7 @@ func (sp *Provider) GetAuthService() auth.Service {
 		sp.services.auth = auth.NewService(
 			&sp.GetConfig().API,
 			sp.GetUserService(),
+			sp.GetPreferencesService(),
 		)
 	}
 	return sp.services.auth
@@ -22,7 +41,103 @@ func (sp *Provider) GetAuthService() auth.Service {
 
 func (sp *Provider) GetUserService() users.Service {
 	if sp.services.users == nil {
-		sp.services.users = users.NewService(sp.GetRepo())
+		sp.services.users = users.NewService(
+			&sp.GetConfig().Users,
+			sp.GetRepo(),
+			sp.GetAttributesValidator(),
+			sp.GetMailClient(),
+		)
 	}
 	return sp.services.users
 }
+
+func (sp *Provider) GetUserImportsService() user_imports.Service {
+	if sp.services.userImports == nil {
+		sp.services.userImports = user_imports.NewService(
+			sp.GetRepo(),
+			sp.GetS3Client(),
+		)
+	}
+	return sp.services.userImports
+}
+
+func (sp *Provider) GetUserExportsService() user_exports.Service {
+	if sp.services.userExports == nil {
+		sp.services.userExports = user_exports.NewService(
+			sp.GetRepo(),
+			sp.GetS3Client(),
+			sp.GetChromeClient(),
+		)
+	}
+	return sp.services.userExports
+}
+
+func (sp *Provider) GetPreferencesService() preferences.Service {
+	if sp.services.preferences == nil {
+		sp.services.preferences = preferences.NewService(
+			sp.GetRepo(),
+		)
+	}
+	return sp.services.preferences
+}
+
+func (sp *Provider) GetGroupsService() groups.Service {
+	if sp.services.groups == nil {
+		sp.services.groups = groups.NewService(
+			sp.GetRepo(),
+		)
+	}
+	return sp.services.groups
+}
+
+func (sp *Provider) GetOutboxService() outbox.Service {
+	if sp.services.outbox == nil {
+		sp.services.outbox = outbox.NewService(
+			&sp.GetConfig().Outbox,
+			sp.GetRepo(),
+			sp.GetBrokerClient(),
+		)
+	}
+	return sp.services.outbox
+}
+
+func (sp *Provider) GetNotificationsService() notifications.Service {
+	if sp.services.notifications == nil {
+		sp.services.notifications = notifications.NewService(
+			sp.GetRepo(),
+			sp.GetMailClient(),
+			sp.GetPreferencesService(),
+		)
+	}
+	return sp.services.notifications
+}
+
+func (sp *Provider) GetBrokerService() broker.Service {
+	if sp.services.broker == nil {
+		sp.services.broker = broker.NewService(
+			sp.GetBrokerClient(),
+			sp.GetSchemaRegistry(),
+		)
+	}
+	return sp.services.broker
+}
+
+func (sp *Provider) GetInboxService() inbox.Service {
+	if sp.services.inbox == nil {
+		sp.services.inbox = inbox.NewService(
+			&sp.GetConfig().Inbox,
+			sp.GetRepo(),
+		)
+	}
+	return sp.services.inbox
+}
+
+func (sp *Provider) GetSchedulerService() scheduler.Service {
+	if sp.services.scheduler == nil {
+		sp.services.scheduler = scheduler.NewService(
+			sp.GetLogger(),
+			sp.GetRepo(),
+		)
+	}
+	return sp.services.scheduler
+}
@@ -0,0 +1,391 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"boilerplate/internal/pkg/clients/db"
+)
+
+type Group struct {
+	ID          int       `db:"id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	CreatedBy   *int      `db:"created_by"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+// GroupMember участник группы с данными пользователя
+type GroupMember struct {
+	UserID  int       `db:"user_id"`
+	Name    string    `db:"name"`
+	Email   string    `db:"email"`
+	AddedBy *int      `db:"added_by"`
+	AddedAt time.Time `db:"added_at"`
+}
+
+// ErrGroupNameExists возвращается, если название без учета регистра уже занято
+// другой группой
+var ErrGroupNameExists = errors.New("group name exists")
+
+const indexGroupsName = "groups_name_lower_idx"
+
+type GroupFilter struct {
+	IDs  []int
+	Name *string
+	// MemberID отбирает группы, в которых состоит пользователь
+	MemberID *int
+	Limit    *int
+	Offset   *int
+}
+
+type Groups struct {
+	Result []*Group
+	Total  int
+}
+
+type GroupMembers struct {
+	Result []*GroupMember
+	Total  int
+}
+
+type GroupsRepo interface {
+	Create(ctx context.Context, group *Group) error
+	Get(ctx context.Context, id int) (*Group, error)
+	Update(ctx context.Context, group *Group) error
+	Delete(ctx context.Context, id int) error
+	Search(ctx context.Context, filter *GroupFilter) (*Groups, error)
+	// AddMembers добавляет пользователей в группу и возвращает тех, кого в
+	// группе еще не было, по возрастанию id
+	AddMembers(ctx context.Context, groupID int, userIDs []int, addedBy *int) ([]int, error)
+	// RemoveMembers исключает пользователей из группы и возвращает тех, кто в
+	// ней состоял, по возрастанию id. Если userIDs nil, исключаются все участники
+	RemoveMembers(ctx context.Context, groupID int, userIDs []int) ([]int, error)
+	// ListMembers возвращает неудаленных участников группы в порядке добавления
+	ListMembers(ctx context.Context, groupID int, limit, offset *int) (*GroupMembers, error)
+}
+
+type groupsRepo struct {
+	client db.Client
+}
+
+func NewGroupsRepo(client db.Client) GroupsRepo {
+	return &groupsRepo{
+		client: client,
+	}
+}
+
+func (r *groupsRepo) Create(ctx context.Context, group *Group) error {
+	builder := sq.Insert(TableGroups).
+		Columns(ColumnName, ColumnDescription, ColumnCreatedBy, ColumnCreatedAt, ColumnUpdatedAt).
+		Values(group.Name, group.Description, group.CreatedBy, squirrel.Expr("now()"), squirrel.Expr("now()")).
+		Suffix("RETURNING *")
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query create group: %w", err)
+	}
+	defer rows.Close()
+
+	createdGroup, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[Group])
+	if err != nil {
+		if isGroupNameExists(err) {
+			return ErrGroupNameExists
+		}
+		return fmt.Errorf("collect group: %w", err)
+	}
+
+	*group = *createdGroup
+
+	return nil
+}
+
+func (r *groupsRepo) Get(ctx context.Context, id int) (*Group, error) {
+	builder := sq.Select("*").
+		From(TableGroups).
+		Where(squirrel.Eq{
+			ColumnID: id,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query get group: %w", err)
+	}
+	defer rows.Close()
+
+	group, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[Group])
+	if err != nil {
+		return nil, fmt.Errorf("collect group: %w", err)
+	}
+
+	return group, nil
+}
+
+func (r *groupsRepo) Update(ctx context.Context, group *Group) error {
+	builder := sq.Update(TableGroups).
+		Set(ColumnName, group.Name).
+		Set(ColumnDescription, group.Description).
+		Set(ColumnUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			ColumnID: group.ID,
+		}).
+		Suffix("RETURNING *")
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query update group: %w", err)
+	}
+	defer rows.Close()
+
+	updatedGroup, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[Group])
+	if err != nil {
+		if isGroupNameExists(err) {
+			return ErrGroupNameExists
+		}
+		return fmt.Errorf("collect group: %w", err)
+	}
+
+	*group = *updatedGroup
+
+	return nil
+}
+
+// Delete удаляет группу вместе с участниками. Если группы нет, возвращает
+// pgx.ErrNoRows
+func (r *groupsRepo) Delete(ctx context.Context, id int) error {
+	builder := sq.Delete(TableGroups).
+		Where(squirrel.Eq{
+			ColumnID: id,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	tag, err := r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query delete group: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *groupsRepo) Search(ctx context.Context, filter *GroupFilter) (*Groups, error) {
+	builder := applyGroupFilter(sq.Select("*").From(TableGroups), filter).
+		OrderBy(ColumnID + " ASC")
+
+	if filter.Limit != nil {
+		builder = builder.Limit(uint64(*filter.Limit))
+	}
+
+	if filter.Offset != nil {
+		builder = builder.Offset(uint64(*filter.Offset))
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query search groups: %w", err)
+	}
+	defer rows.Close()
+
+	groups := &Groups{}
+	groups.Result, err = pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[Group])
+	if err != nil {
+		return nil, fmt.Errorf("collect group: %w", err)
+	}
+
+	groups.Total, err = r.count(ctx, applyGroupFilter(sq.Select("count(*)").From(TableGroups), filter))
+	if err != nil {
+		return nil, fmt.Errorf("count groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+func (r *groupsRepo) AddMembers(ctx context.Context, groupID int, userIDs []int, addedBy *int) ([]int, error) {
+	if len(userIDs) == 0 {
+		return []int{}, nil
+	}
+
+	builder := sq.Insert(TableGroupMembers).
+		Columns(ColumnGroupID, ColumnUserID, ColumnAddedBy, ColumnCreatedAt).
+		Suffix("ON CONFLICT DO NOTHING RETURNING " + ColumnUserID)
+	for _, userID := range userIDs {
+		builder = builder.Values(groupID, userID, addedBy, squirrel.Expr("now()"))
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	return r.collectUserIDs(ctx, "add group members", sql, args)
+}
+
+func (r *groupsRepo) RemoveMembers(ctx context.Context, groupID int, userIDs []int) ([]int, error) {
+	builder := sq.Delete(TableGroupMembers).
+		Where(squirrel.Eq{
+			ColumnGroupID: groupID,
+		}).
+		Suffix("RETURNING " + ColumnUserID)
+
+	if userIDs != nil {
+		builder = builder.Where(squirrel.Eq{
+			ColumnUserID: userIDs,
+		})
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	return r.collectUserIDs(ctx, "remove group members", sql, args)
+}
+
+func (r *groupsRepo) ListMembers(ctx context.Context, groupID int, limit, offset *int) (*GroupMembers, error) {
+	from := sq.Select().
+		From(TableGroupMembers).
+		Join(fmt.Sprintf("%[1]s ON %[1]s.%[2]s = %[3]s.%[4]s", TableUsers, ColumnID, TableGroupMembers, ColumnUserID)).
+		Where(squirrel.Eq{
+			TableGroupMembers + "." + ColumnGroupID: groupID,
+			TableUsers + "." + ColumnDeleted:        false,
+		})
+
+	builder := from.
+		Columns(
+			TableUsers+"."+ColumnID+" AS "+ColumnUserID,
+			TableUsers+"."+ColumnName,
+			TableUsers+"."+ColumnEmail,
+			TableGroupMembers+"."+ColumnAddedBy,
+			TableGroupMembers+"."+ColumnCreatedAt+" AS "+ColumnAddedAt,
+		).
+		OrderBy(TableGroupMembers+"."+ColumnCreatedAt+" ASC", TableUsers+"."+ColumnID+" ASC")
+
+	if limit != nil {
+		builder = builder.Limit(uint64(*limit))
+	}
+
+	if offset != nil {
+		builder = builder.Offset(uint64(*offset))
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query list group members: %w", err)
+	}
+	defer rows.Close()
+
+	members := &GroupMembers{}
+	members.Result, err = pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[GroupMember])
+	if err != nil {
+		return nil, fmt.Errorf("collect group member: %w", err)
+	}
+
+	members.Total, err = r.count(ctx, from.Columns("count(*)"))
+	if err != nil {
+		return nil, fmt.Errorf("count group members: %w", err)
+	}
+
+	return members, nil
+}
+
+func (r *groupsRepo) count(ctx context.Context, builder squirrel.SelectBuilder) (int, error) {
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("to sql: %w", err)
+	}
+
+	var count int
+	err = r.client.QueryRow(ctx, sql, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("execute query count: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *groupsRepo) collectUserIDs(ctx context.Context, operation, sql string, args []any) ([]int, error) {
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query %s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	userIDs, err := pgx.CollectRows(rows, pgx.RowTo[int])
+	if err != nil {
+		return nil, fmt.Errorf("collect user id: %w", err)
+	}
+
+	slices.Sort(userIDs)
+
+	return userIDs, nil
+}
+
+func applyGroupFilter(builder squirrel.SelectBuilder, filter *GroupFilter) squirrel.SelectBuilder {
+	if filter.IDs != nil {
+		builder = builder.Where(squirrel.Eq{
+			ColumnID: filter.IDs,
+		})
+	}
+
+	if filter.Name != nil {
+		builder = builder.Where(squirrel.ILike{
+			ColumnName: "%" + *filter.Name + "%",
+		})
+	}
+
+	if filter.MemberID != nil {
+		builder = builder.Where(squirrel.Expr(
+			fmt.Sprintf("%s IN (SELECT %s FROM %s WHERE %s = ?)", ColumnID, ColumnGroupID, TableGroupMembers, ColumnUserID),
+			*filter.MemberID,
+		))
+	}
+
+	return builder
+}
+
+// isGroupNameExists проверяет, что ошибка вызвана нарушением уникальности
+// названия группы
+func isGroupNameExists(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == codeUniqueViolation && pgErr.ConstraintName == indexGroupsName
+}
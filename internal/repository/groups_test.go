@@ -0,0 +1,132 @@
+package repository_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/repository"
+)
+
+func TestGroupCRUD(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	group := &repository.Group{
+		Name:        gofakeit.UUID(),
+		Description: gofakeit.Sentence(5),
+	}
+	err := sp.GetRepo().Groups().Create(sp.Context(), group)
+	require.NoError(t, err)
+	require.NotZero(t, group.ID)
+	require.NotEmpty(t, group.CreatedAt)
+
+	// Название уникально без учета регистра
+	err = sp.GetRepo().Groups().Create(sp.Context(), &repository.Group{
+		Name: strings.ToUpper(group.Name),
+	})
+	require.ErrorIs(t, err, repository.ErrGroupNameExists)
+
+	group.Description = gofakeit.Sentence(5)
+	err = sp.GetRepo().Groups().Update(sp.Context(), group)
+	require.NoError(t, err)
+
+	gotGroup, err := sp.GetRepo().Groups().Get(sp.Context(), group.ID)
+	require.NoError(t, err)
+	require.Equal(t, group, gotGroup)
+
+	err = sp.GetRepo().Groups().Delete(sp.Context(), group.ID)
+	require.NoError(t, err)
+
+	_, err = sp.GetRepo().Groups().Get(sp.Context(), group.ID)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	err = sp.GetRepo().Groups().Delete(sp.Context(), group.ID)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestGroupMembers(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	users := suite_factory.NewUserFactory().Builds(3)
+	for _, user := range users {
+		err := sp.GetRepo().Users().Create(sp.Context(), user)
+		require.NoError(t, err)
+	}
+
+	group := &repository.Group{Name: gofakeit.UUID()}
+	err := sp.GetRepo().Groups().Create(sp.Context(), group)
+	require.NoError(t, err)
+
+	otherGroup := &repository.Group{Name: gofakeit.UUID()}
+	err = sp.GetRepo().Groups().Create(sp.Context(), otherGroup)
+	require.NoError(t, err)
+
+	added, err := sp.GetRepo().Groups().AddMembers(sp.Context(), group.ID, []int{users[0].ID, users[1].ID}, &users[0].ID)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int{users[0].ID, users[1].ID}, added)
+
+	// Повторное добавление возвращает только новых участников
+	added, err = sp.GetRepo().Groups().AddMembers(sp.Context(), group.ID, []int{users[1].ID, users[2].ID}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int{users[2].ID}, added)
+
+	_, err = sp.GetRepo().Groups().AddMembers(sp.Context(), otherGroup.ID, []int{users[0].ID}, nil)
+	require.NoError(t, err)
+
+	members, err := sp.GetRepo().Groups().ListMembers(sp.Context(), group.ID, utils.Ptr(2), nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, members.Total)
+	require.Len(t, members.Result, 2)
+	require.Equal(t, users[0].Name, members.Result[0].Name)
+	require.Equal(t, users[0].Email, members.Result[0].Email)
+	require.Equal(t, &users[0].ID, members.Result[0].AddedBy)
+
+	members, err = sp.GetRepo().Groups().ListMembers(sp.Context(), group.ID, utils.Ptr(2), utils.Ptr(2))
+	require.NoError(t, err)
+	require.Len(t, members.Result, 1)
+	require.Equal(t, users[2].ID, members.Result[0].UserID)
+
+	// Удаленные пользователи не показываются среди участников
+	err = sp.GetRepo().Users().Delete(sp.Context(), users[2].ID, users[2].Version)
+	require.NoError(t, err)
+	members, err = sp.GetRepo().Groups().ListMembers(sp.Context(), group.ID, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, members.Total)
+
+	groups, err := sp.GetRepo().Groups().Search(sp.Context(), &repository.GroupFilter{
+		MemberID: &users[0].ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, groups.Total)
+	require.Equal(t, []*repository.Group{group, otherGroup}, groups.Result)
+
+	groups, err = sp.GetRepo().Groups().Search(sp.Context(), &repository.GroupFilter{
+		MemberID: &users[1].ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*repository.Group{group}, groups.Result)
+
+	removed, err := sp.GetRepo().Groups().RemoveMembers(sp.Context(), group.ID, []int{users[1].ID, users[1].ID + 1000})
+	require.NoError(t, err)
+	require.Equal(t, []int{users[1].ID}, removed)
+
+	removed, err = sp.GetRepo().Groups().RemoveMembers(sp.Context(), group.ID, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int{users[0].ID, users[2].ID}, removed)
+
+	members, err = sp.GetRepo().Groups().ListMembers(sp.Context(), otherGroup.ID, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, members.Total)
+}
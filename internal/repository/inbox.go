@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"boilerplate/internal/pkg/clients/db"
+)
+
+type InboxRepo interface {
+	// Claim отмечает сообщение обработанным консьюмером и возвращает false,
+	// если оно уже было отмечено. Вызывается в транзакции вместе с обработкой:
+	// конкурентный вызов с тем же сообщением ждет ее завершения
+	Claim(ctx context.Context, consumer, messageID string) (bool, error)
+	// DeleteProcessed удаляет отметки, созданные раньше, чем olderThan назад,
+	// и возвращает их количество
+	DeleteProcessed(ctx context.Context, olderThan time.Duration) (int, error)
+}
+
+type inboxRepo struct {
+	client db.Client
+}
+
+func NewInboxRepo(client db.Client) InboxRepo {
+	return &inboxRepo{
+		client: client,
+	}
+}
+
+func (r *inboxRepo) Claim(ctx context.Context, consumer, messageID string) (bool, error) {
+	builder := sq.Insert(TableInbox).
+		Columns(ColumnConsumer, ColumnMessageID).
+		Values(consumer, messageID).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("to sql: %w", err)
+	}
+
+	tag, err := r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return false, fmt.Errorf("execute query claim inbox message: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *inboxRepo) DeleteProcessed(ctx context.Context, olderThan time.Duration) (int, error) {
+	builder := sq.Delete(TableInbox).
+		Where(squirrel.Expr(ColumnProcessedAt+" < now() - make_interval(secs => ?)", olderThan.Seconds()))
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("to sql: %w", err)
+	}
+
+	tag, err := r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("execute query delete processed inbox messages: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
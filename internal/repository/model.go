@@ -1,17 +1,88 @@
 package repository
 
 const (
-	TableUsers = "users"
+	TableUsers             = "users"
+	TableUserImports       = "user_imports"
+	TableUserExports       = "user_exports"
+	TableUserEmailChanges  = "user_email_changes"
+	TableUsersHistory      = "users_history"
+	TableUserPreferences   = "user_preferences"
+	TableGroups            = "groups"
+	TableGroupMembers      = "group_members"
+	TableOutbox            = "outbox"
+	TableSentNotifications = "sent_notifications"
+	TableInbox             = "inbox"
+	TableScheduledJobs     = "scheduled_jobs"
 )
 
 const (
-	ColumnID        = "id"
-	ColumnName      = "name"
-	ColumnEmail     = "email"
-	ColumnPassword  = "password"
-	ColumnIsAdmin   = "is_admin"
-	ColumnDeleted   = "deleted"
-	ColumnCreatedAt = "created_at"
-	ColumnUpdatedAt = "updated_at"
-	ColumnDeletedAt = "deleted_at"
+	ColumnID               = "id"
+	ColumnName             = "name"
+	ColumnEmail            = "email"
+	ColumnPassword         = "password"
+	ColumnIsAdmin          = "is_admin"
+	ColumnDeleted          = "deleted"
+	ColumnVersion          = "version"
+	ColumnAttributes       = "attributes"
+	ColumnStatus           = "status"
+	ColumnFilePath         = "file_path"
+	ColumnDryRun           = "dry_run"
+	ColumnFormat           = "format"
+	ColumnFilter           = "filter"
+	ColumnTotal            = "total"
+	ColumnProcessed        = "processed"
+	ColumnCreated          = "created"
+	ColumnFailed           = "failed"
+	ColumnReportPath       = "report_path"
+	ColumnError            = "error"
+	ColumnCreatedBy        = "created_by"
+	ColumnCreatedAt        = "created_at"
+	ColumnUpdatedAt        = "updated_at"
+	ColumnDeletedAt        = "deleted_at"
+	ColumnFinishedAt       = "finished_at"
+	ColumnUserID           = "user_id"
+	ColumnOldEmail         = "old_email"
+	ColumnNewEmail         = "new_email"
+	ColumnConfirmTokenHash = "confirm_token_hash"
+	ColumnUndoTokenHash    = "undo_token_hash" // nolint: gosec
+	ColumnExpiresAt        = "expires_at"
+	ColumnUndoExpiresAt    = "undo_expires_at"
+	ColumnConfirmedAt      = "confirmed_at"
+	ColumnOperation        = "operation"
+	ColumnData             = "data"
+	ColumnChangedBy        = "changed_by"
+	ColumnChangedAt        = "changed_at"
+	ColumnKey              = "key"
+	ColumnValue            = "value"
+	ColumnDescription      = "description"
+	ColumnGroupID          = "group_id"
+	ColumnAddedBy          = "added_by"
+	ColumnAddedAt          = "added_at"
+	ColumnTopic            = "topic"
+	ColumnAggregate        = "aggregate"
+	ColumnPayload          = "payload"
+	ColumnRequestID        = "request_id"
+	ColumnActorID          = "actor_id"
+	ColumnIP               = "ip"
+	ColumnTraceContext     = "trace_context"
+	ColumnAttempts         = "attempts"
+	ColumnNextAttemptAt    = "next_attempt_at"
+	ColumnLastError        = "last_error"
+	ColumnPublishedAt      = "published_at"
+	ColumnFailedAt         = "failed_at"
+	ColumnType             = "type"
+	ColumnKind             = "kind"
+	ColumnSentAt           = "sent_at"
+	ColumnConsumer         = "consumer"
+	ColumnMessageID        = "message_id"
+	ColumnProcessedAt      = "processed_at"
+	ColumnSchedule         = "schedule"
+	ColumnTimezone         = "timezone"
+	ColumnCatchUp          = "catch_up"
+	ColumnPaused           = "paused"
+	ColumnNextRunAt        = "next_run_at"
+	ColumnTriggeredAt      = "triggered_at"
+	ColumnLastRunAt        = "last_run_at"
+	ColumnLastStatus       = "last_status"
+	ColumnLastDurationMs   = "last_duration_ms"
 )
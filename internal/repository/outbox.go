@@ -0,0 +1,278 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"boilerplate/internal/pkg/clients/db"
+	"boilerplate/internal/pkg/metadata"
+)
+
+// OutboxMessage событие, сохраненное в одной транзакции с изменением агрегата
+// и ожидающее публикации в брокер
+type OutboxMessage struct {
+	ID        int             `db:"id"`
+	Topic     string          `db:"topic"`
+	Aggregate string          `db:"aggregate"`
+	Key       string          `db:"key"`
+	Type      string          `db:"type"`
+	Payload   json.RawMessage `db:"payload"`
+	RequestID *string         `db:"request_id"`
+	ActorID   *int            `db:"actor_id"`
+	IP        *string         `db:"ip"`
+	// TraceContext заголовки W3C traceparent, tracestate и baggage запроса
+	TraceContext  map[string]string `db:"trace_context"`
+	Attempts      int               `db:"attempts"`
+	NextAttemptAt time.Time         `db:"next_attempt_at"`
+	LastError     *string           `db:"last_error"`
+	CreatedAt     time.Time         `db:"created_at"`
+	PublishedAt   *time.Time        `db:"published_at"`
+	FailedAt      *time.Time        `db:"failed_at"`
+}
+
+// NewOutboxMessage сериализует событие агрегата с указанным ключом.
+// proto.Message сохраняется в формате protojson вместе с именем типа
+func NewOutboxMessage(topic, aggregate, key string, payload any) (*OutboxMessage, error) {
+	message := &OutboxMessage{
+		Topic:     topic,
+		Aggregate: aggregate,
+		Key:       key,
+	}
+
+	var err error
+	if protoPayload, ok := payload.(proto.Message); ok {
+		message.Type = string(proto.MessageName(protoPayload))
+		message.Payload, err = protojson.Marshal(protoPayload)
+	} else {
+		message.Payload, err = json.Marshal(payload)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	return message, nil
+}
+
+type OutboxRepo interface {
+	// Add сохраняет сообщение вместе с метаданными и контекстом трассировки
+	// запроса из контекста. Вызывается в транзакции, изменяющей агрегат
+	Add(ctx context.Context, message *OutboxMessage) error
+	// FetchPending блокирует и возвращает готовые к публикации сообщения по
+	// возрастанию id. Из каждого агрегата возвращается только самое раннее
+	// неопубликованное сообщение, поэтому события агрегата публикуются по порядку
+	FetchPending(ctx context.Context, limit int) ([]*OutboxMessage, error)
+	MarkPublished(ctx context.Context, ids []int) error
+	// MarkRetry откладывает следующую попытку публикации сообщения на delay
+	MarkRetry(ctx context.Context, id int, lastError string, delay time.Duration) error
+	// MarkFailed прекращает попытки публикации сообщения
+	MarkFailed(ctx context.Context, id int, lastError string) error
+	// List возвращает сообщения агрегата в порядке добавления
+	List(ctx context.Context, aggregate, key string) ([]*OutboxMessage, error)
+	// DeletePublished удаляет сообщения, опубликованные раньше, чем olderThan
+	// назад, и возвращает их количество
+	DeletePublished(ctx context.Context, olderThan time.Duration) (int, error)
+}
+
+type outboxRepo struct {
+	client db.Client
+}
+
+func NewOutboxRepo(client db.Client) OutboxRepo {
+	return &outboxRepo{
+		client: client,
+	}
+}
+
+func (r *outboxRepo) Add(ctx context.Context, message *OutboxMessage) error {
+	if requestID, ok := metadata.GetRequestID(ctx); ok {
+		message.RequestID = &requestID
+	}
+	if actorID, ok := metadata.GetUserID(ctx); ok {
+		message.ActorID = &actorID
+	}
+	if ip, ok := metadata.GetIP(ctx); ok {
+		message.IP = &ip
+	}
+	message.TraceContext = metadata.GetTraceContext(ctx)
+
+	builder := sq.Insert(TableOutbox).
+		Columns(ColumnTopic, ColumnAggregate, ColumnKey, ColumnType, ColumnPayload, ColumnRequestID, ColumnActorID, ColumnIP, ColumnTraceContext).
+		Values(message.Topic, message.Aggregate, message.Key, message.Type, message.Payload, message.RequestID, message.ActorID, message.IP, message.TraceContext).
+		Suffix("RETURNING *")
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query add outbox message: %w", err)
+	}
+	defer rows.Close()
+
+	addedMessage, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[OutboxMessage])
+	if err != nil {
+		return fmt.Errorf("collect outbox message: %w", err)
+	}
+
+	*message = *addedMessage
+
+	return nil
+}
+
+func (r *outboxRepo) FetchPending(ctx context.Context, limit int) ([]*OutboxMessage, error) {
+	builder := sq.Select("*").
+		From(TableOutbox).
+		Where(squirrel.Eq{
+			ColumnPublishedAt: nil,
+			ColumnFailedAt:    nil,
+		}).
+		Where(squirrel.Expr(ColumnNextAttemptAt + " <= now()")).
+		Where(squirrel.Expr(fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM %[1]s p WHERE p.%[2]s = %[1]s.%[2]s AND p.%[3]s = %[1]s.%[3]s AND p.%[4]s < %[1]s.%[4]s AND p.%[5]s IS NULL AND p.%[6]s IS NULL)",
+			TableOutbox, ColumnAggregate, ColumnKey, ColumnID, ColumnPublishedAt, ColumnFailedAt,
+		))).
+		OrderBy(ColumnID + " ASC").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query fetch pending outbox messages: %w", err)
+	}
+	defer rows.Close()
+
+	messages, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[OutboxMessage])
+	if err != nil {
+		return nil, fmt.Errorf("collect outbox messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+func (r *outboxRepo) MarkPublished(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	builder := sq.Update(TableOutbox).
+		Set(ColumnAttempts, squirrel.Expr(ColumnAttempts+" + 1")).
+		Set(ColumnPublishedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			ColumnID: ids,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	_, err = r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query mark outbox messages published: %w", err)
+	}
+
+	return nil
+}
+
+func (r *outboxRepo) MarkRetry(ctx context.Context, id int, lastError string, delay time.Duration) error {
+	builder := sq.Update(TableOutbox).
+		Set(ColumnAttempts, squirrel.Expr(ColumnAttempts+" + 1")).
+		Set(ColumnLastError, lastError).
+		Set(ColumnNextAttemptAt, squirrel.Expr("now() + make_interval(secs => ?)", delay.Seconds())).
+		Where(squirrel.Eq{
+			ColumnID: id,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	_, err = r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query mark outbox message retry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *outboxRepo) MarkFailed(ctx context.Context, id int, lastError string) error {
+	builder := sq.Update(TableOutbox).
+		Set(ColumnAttempts, squirrel.Expr(ColumnAttempts+" + 1")).
+		Set(ColumnLastError, lastError).
+		Set(ColumnFailedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			ColumnID: id,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	_, err = r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query mark outbox message failed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *outboxRepo) List(ctx context.Context, aggregate, key string) ([]*OutboxMessage, error) {
+	builder := sq.Select("*").
+		From(TableOutbox).
+		Where(squirrel.Eq{
+			ColumnAggregate: aggregate,
+			ColumnKey:       key,
+		}).
+		OrderBy(ColumnID + " ASC")
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query list outbox messages: %w", err)
+	}
+	defer rows.Close()
+
+	messages, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[OutboxMessage])
+	if err != nil {
+		return nil, fmt.Errorf("collect outbox messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+func (r *outboxRepo) DeletePublished(ctx context.Context, olderThan time.Duration) (int, error) {
+	builder := sq.Delete(TableOutbox).
+		Where(squirrel.Expr(ColumnPublishedAt+" < now() - make_interval(secs => ?)", olderThan.Seconds()))
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("to sql: %w", err)
+	}
+
+	tag, err := r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("execute query delete published outbox messages: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
@@ -0,0 +1,95 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/pkg/metadata"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/repository"
+)
+
+func TestOutbox(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	aggregate := gofakeit.UUID()
+
+	// fetchPending возвращает id готовых сообщений агрегата теста
+	fetchPending := func() []int {
+		messages, err := sp.GetRepo().Outbox().FetchPending(sp.Context(), 1000)
+		require.NoError(t, err)
+
+		ids := []int{}
+		for _, message := range messages {
+			if message.Aggregate == aggregate {
+				ids = append(ids, message.ID)
+			}
+		}
+		return ids
+	}
+
+	ctx := metadata.WithRequestID(sp.Context(), gofakeit.UUID())
+	ctx = metadata.WithUserID(ctx, 42)
+
+	add := func(key string) *repository.OutboxMessage {
+		message, err := repository.NewOutboxMessage(gofakeit.Word(), aggregate, key, map[string]any{"key": key})
+		require.NoError(t, err)
+
+		err = sp.GetRepo().Outbox().Add(ctx, message)
+		require.NoError(t, err)
+		require.NotZero(t, message.ID)
+		return message
+	}
+
+	first := add("1")
+	second := add("1")
+	other := add("2")
+
+	require.NotNil(t, first.RequestID)
+	require.Equal(t, 42, *first.ActorID)
+	require.JSONEq(t, `{"key": "1"}`, string(first.Payload))
+
+	// Из агрегата выбирается только самое раннее неопубликованное сообщение
+	require.Equal(t, []int{first.ID, other.ID}, fetchPending())
+
+	err := sp.GetRepo().Outbox().MarkRetry(sp.Context(), first.ID, "unavailable", time.Hour)
+	require.NoError(t, err)
+	err = sp.GetRepo().Outbox().MarkPublished(sp.Context(), []int{other.ID})
+	require.NoError(t, err)
+
+	// Отложенное сообщение задерживает следующие сообщения агрегата
+	require.Empty(t, fetchPending())
+
+	err = sp.GetRepo().Outbox().MarkFailed(sp.Context(), first.ID, "unavailable")
+	require.NoError(t, err)
+
+	require.Equal(t, []int{second.ID}, fetchPending())
+
+	err = sp.GetRepo().Outbox().MarkPublished(sp.Context(), []int{second.ID})
+	require.NoError(t, err)
+
+	messages, err := sp.GetRepo().Outbox().List(sp.Context(), aggregate, "1")
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	require.Equal(t, 2, messages[0].Attempts)
+	require.Equal(t, "unavailable", *messages[0].LastError)
+	require.NotNil(t, messages[0].FailedAt)
+	require.Nil(t, messages[0].PublishedAt)
+	require.Equal(t, 1, messages[1].Attempts)
+	require.NotNil(t, messages[1].PublishedAt)
+
+	deleted, err := sp.GetRepo().Outbox().DeletePublished(sp.Context(), 0)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, deleted, 2)
+
+	messages, err = sp.GetRepo().Outbox().List(sp.Context(), aggregate, "1")
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.Equal(t, first.ID, messages[0].ID)
+}
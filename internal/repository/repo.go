@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/Masterminds/squirrel"
 
@@ -12,15 +15,53 @@ type Repo interface {
 	Client() db.Client
 	Transaction(ctx context.Context, fn db.TxFunc) error
 	Users() UsersRepo
+	UserImports() UserImportsRepo
+	UserExports() UserExportsRepo
+	UserEmailChanges() UserEmailChangesRepo
+	UsersHistory() UsersHistoryRepo
+	UserPreferences() UserPreferencesRepo
+	Groups() GroupsRepo
+	Outbox() OutboxRepo
+	SentNotifications() SentNotificationsRepo
+	Inbox() InboxRepo
+	ScheduledJobs() ScheduledJobsRepo
 }
 
 type repo struct {
-	dbClient  db.Client
-	usersRepo UsersRepo
+	dbClient              db.Client
+	usersRepo             UsersRepo
+	userImportsRepo       UserImportsRepo
+	userExportsRepo       UserExportsRepo
+	userEmailChangesRepo  UserEmailChangesRepo
+	usersHistoryRepo      UsersHistoryRepo
+	userPreferencesRepo   UserPreferencesRepo
+	groupsRepo            GroupsRepo
+	outboxRepo            OutboxRepo
+	sentNotificationsRepo SentNotificationsRepo
+	inboxRepo             InboxRepo
+	scheduledJobsRepo     ScheduledJobsRepo
 }
 
 var sq = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 
+// setColumns добавляет в запрос SET только для указанных колонок, значения
+// берутся из values. Пустой список колонок обновляет все колонки из values
+func setColumns(builder squirrel.UpdateBuilder, values map[string]any, columns []string) (squirrel.UpdateBuilder, error) {
+	if len(columns) == 0 {
+		columns = slices.Sorted(maps.Keys(values))
+	}
+
+	for _, column := range columns {
+		value, exists := values[column]
+		if !exists {
+			return builder, fmt.Errorf("column %s can not be updated", column)
+		}
+		builder = builder.Set(column, value)
+	}
+
+	return builder, nil
+}
+
 func NewRepo(dbClient db.Client) Repo {
 	return &repo{
 		dbClient: dbClient,
@@ -41,3 +82,73 @@ func (r *repo) Users() UsersRepo {
 	}
 	return r.usersRepo
 }
+
+func (r *repo) UserImports() UserImportsRepo {
+	if r.userImportsRepo == nil {
+		r.userImportsRepo = NewUserImportsRepo(r.dbClient)
+	}
+	return r.userImportsRepo
+}
+
+func (r *repo) UserExports() UserExportsRepo {
+	if r.userExportsRepo == nil {
+		r.userExportsRepo = NewUserExportsRepo(r.dbClient)
+	}
+	return r.userExportsRepo
+}
+
+func (r *repo) UserEmailChanges() UserEmailChangesRepo {
+	if r.userEmailChangesRepo == nil {
+		r.userEmailChangesRepo = NewUserEmailChangesRepo(r.dbClient)
+	}
+	return r.userEmailChangesRepo
+}
+
+func (r *repo) UsersHistory() UsersHistoryRepo {
+	if r.usersHistoryRepo == nil {
+		r.usersHistoryRepo = NewUsersHistoryRepo(r.dbClient)
+	}
+	return r.usersHistoryRepo
+}
+
+func (r *repo) UserPreferences() UserPreferencesRepo {
+	if r.userPreferencesRepo == nil {
+		r.userPreferencesRepo = NewUserPreferencesRepo(r.dbClient)
+	}
+	return r.userPreferencesRepo
+}
+
+func (r *repo) Groups() GroupsRepo {
+	if r.groupsRepo == nil {
+		r.groupsRepo = NewGroupsRepo(r.dbClient)
+	}
+	return r.groupsRepo
+}
+
+func (r *repo) Outbox() OutboxRepo {
+	if r.outboxRepo == nil {
+		r.outboxRepo = NewOutboxRepo(r.dbClient)
+	}
+	return r.outboxRepo
+}
+
+func (r *repo) SentNotifications() SentNotificationsRepo {
+	if r.sentNotificationsRepo == nil {
+		r.sentNotificationsRepo = NewSentNotificationsRepo(r.dbClient)
+	}
+	return r.sentNotificationsRepo
+}
+
+func (r *repo) Inbox() InboxRepo {
+	if r.inboxRepo == nil {
+		r.inboxRepo = NewInboxRepo(r.dbClient)
+	}
+	return r.inboxRepo
+}
+
+func (r *repo) ScheduledJobs() ScheduledJobsRepo {
+	if r.scheduledJobsRepo == nil {
+		r.scheduledJobsRepo = NewScheduledJobsRepo(r.dbClient)
+	}
+	return r.scheduledJobsRepo
+}
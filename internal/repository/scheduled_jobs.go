@@ -0,0 +1,292 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"boilerplate/internal/pkg/clients/db"
+)
+
+// ScheduledJob задача планировщика с результатом последнего запуска
+type ScheduledJob struct {
+	Name           string     `db:"name"`
+	Schedule       string     `db:"schedule"`
+	Timezone       string     `db:"timezone"`
+	CatchUp        string     `db:"catch_up"`
+	Paused         bool       `db:"paused"`
+	NextRunAt      time.Time  `db:"next_run_at"`
+	TriggeredAt    *time.Time `db:"triggered_at"`
+	LastRunAt      *time.Time `db:"last_run_at"`
+	LastStatus     *string    `db:"last_status"`
+	LastError      *string    `db:"last_error"`
+	LastDurationMs *int64     `db:"last_duration_ms"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
+
+type ScheduledJobsRepo interface {
+	// Sync создает задачу или обновляет ее расписание. Время следующего
+	// запуска job.NextRunAt сохраняется, только если расписание изменилось
+	Sync(ctx context.Context, job *ScheduledJob) error
+	List(ctx context.Context) ([]*ScheduledJob, error)
+	Get(ctx context.Context, name string) (*ScheduledJob, error)
+	Pause(ctx context.Context, name string) (*ScheduledJob, error)
+	// Resume возобновляет задачу со следующим запуском в nextRunAt
+	Resume(ctx context.Context, name string, nextRunAt time.Time) (*ScheduledJob, error)
+	// Reschedule переносит следующий запуск задачи на nextRunAt
+	Reschedule(ctx context.Context, name string, nextRunAt time.Time) error
+	// Trigger отмечает задачу для запуска вручную
+	Trigger(ctx context.Context, name string) (*ScheduledJob, error)
+	// StartRun отмечает начало запуска задачи со статусом status и переносит
+	// следующий запуск на nextRunAt. Запуск вручную снимает отметку Trigger
+	StartRun(ctx context.Context, name, status string, nextRunAt time.Time, manual bool) error
+	FinishRun(ctx context.Context, name, status string, lastError *string, duration time.Duration) error
+	// SetStatus заменяет статус последнего запуска from на to у всех задач и
+	// возвращает количество измененных задач
+	SetStatus(ctx context.Context, from, to string) (int, error)
+}
+
+type scheduledJobsRepo struct {
+	client db.Client
+}
+
+func NewScheduledJobsRepo(client db.Client) ScheduledJobsRepo {
+	return &scheduledJobsRepo{
+		client: client,
+	}
+}
+
+func (r *scheduledJobsRepo) Sync(ctx context.Context, job *ScheduledJob) error {
+	builder := sq.Insert(TableScheduledJobs).
+		Columns(ColumnName, ColumnSchedule, ColumnTimezone, ColumnCatchUp, ColumnNextRunAt).
+		Values(job.Name, job.Schedule, job.Timezone, job.CatchUp, job.NextRunAt).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%[1]s) DO UPDATE SET %[2]s = excluded.%[2]s, %[3]s = excluded.%[3]s, %[4]s = excluded.%[4]s, "+
+				"%[5]s = CASE WHEN %[6]s.%[2]s <> excluded.%[2]s OR %[6]s.%[3]s <> excluded.%[3]s THEN excluded.%[5]s ELSE %[6]s.%[5]s END, "+
+				"%[7]s = now() RETURNING *",
+			ColumnName, ColumnSchedule, ColumnTimezone, ColumnCatchUp, ColumnNextRunAt, TableScheduledJobs, ColumnUpdatedAt,
+		))
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query sync scheduled job: %w", err)
+	}
+	defer rows.Close()
+
+	syncedJob, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[ScheduledJob])
+	if err != nil {
+		return fmt.Errorf("collect scheduled job: %w", err)
+	}
+
+	*job = *syncedJob
+
+	return nil
+}
+
+func (r *scheduledJobsRepo) List(ctx context.Context) ([]*ScheduledJob, error) {
+	builder := sq.Select("*").
+		From(TableScheduledJobs).
+		OrderBy(ColumnName + " ASC")
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query list scheduled jobs: %w", err)
+	}
+	defer rows.Close()
+
+	jobs, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[ScheduledJob])
+	if err != nil {
+		return nil, fmt.Errorf("collect scheduled jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+func (r *scheduledJobsRepo) Get(ctx context.Context, name string) (*ScheduledJob, error) {
+	builder := sq.Select("*").
+		From(TableScheduledJobs).
+		Where(squirrel.Eq{
+			ColumnName: name,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query get scheduled job: %w", err)
+	}
+	defer rows.Close()
+
+	job, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[ScheduledJob])
+	if err != nil {
+		return nil, fmt.Errorf("collect scheduled job: %w", err)
+	}
+
+	return job, nil
+}
+
+func (r *scheduledJobsRepo) Pause(ctx context.Context, name string) (*ScheduledJob, error) {
+	builder := sq.Update(TableScheduledJobs).
+		Set(ColumnPaused, true).
+		Set(ColumnUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			ColumnName: name,
+		})
+
+	return r.update(ctx, builder, "pause")
+}
+
+func (r *scheduledJobsRepo) Resume(ctx context.Context, name string, nextRunAt time.Time) (*ScheduledJob, error) {
+	builder := sq.Update(TableScheduledJobs).
+		Set(ColumnPaused, false).
+		Set(ColumnNextRunAt, nextRunAt).
+		Set(ColumnUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			ColumnName: name,
+		})
+
+	return r.update(ctx, builder, "resume")
+}
+
+func (r *scheduledJobsRepo) Reschedule(ctx context.Context, name string, nextRunAt time.Time) error {
+	builder := sq.Update(TableScheduledJobs).
+		Set(ColumnNextRunAt, nextRunAt).
+		Set(ColumnUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			ColumnName: name,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	_, err = r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query reschedule scheduled job: %w", err)
+	}
+
+	return nil
+}
+
+func (r *scheduledJobsRepo) Trigger(ctx context.Context, name string) (*ScheduledJob, error) {
+	builder := sq.Update(TableScheduledJobs).
+		Set(ColumnTriggeredAt, squirrel.Expr("now()")).
+		Set(ColumnUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			ColumnName: name,
+		})
+
+	return r.update(ctx, builder, "trigger")
+}
+
+func (r *scheduledJobsRepo) StartRun(ctx context.Context, name, status string, nextRunAt time.Time, manual bool) error {
+	builder := sq.Update(TableScheduledJobs).
+		Set(ColumnNextRunAt, nextRunAt).
+		Set(ColumnLastRunAt, squirrel.Expr("now()")).
+		Set(ColumnLastStatus, status).
+		Set(ColumnLastError, nil).
+		Set(ColumnLastDurationMs, nil).
+		Set(ColumnUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			ColumnName: name,
+		})
+	if manual {
+		builder = builder.Set(ColumnTriggeredAt, nil)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	_, err = r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query start scheduled job run: %w", err)
+	}
+
+	return nil
+}
+
+func (r *scheduledJobsRepo) FinishRun(ctx context.Context, name, status string, lastError *string, duration time.Duration) error {
+	builder := sq.Update(TableScheduledJobs).
+		Set(ColumnLastStatus, status).
+		Set(ColumnLastError, lastError).
+		Set(ColumnLastDurationMs, duration.Milliseconds()).
+		Set(ColumnUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			ColumnName: name,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	_, err = r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query finish scheduled job run: %w", err)
+	}
+
+	return nil
+}
+
+func (r *scheduledJobsRepo) SetStatus(ctx context.Context, from, to string) (int, error) {
+	builder := sq.Update(TableScheduledJobs).
+		Set(ColumnLastStatus, to).
+		Set(ColumnUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			ColumnLastStatus: from,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("to sql: %w", err)
+	}
+
+	tag, err := r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("execute query set scheduled jobs status: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// update выполняет изменение одной задачи и возвращает ее. Если задачи нет,
+// возвращает pgx.ErrNoRows
+func (r *scheduledJobsRepo) update(ctx context.Context, builder squirrel.UpdateBuilder, operation string) (*ScheduledJob, error) {
+	sql, args, err := builder.Suffix("RETURNING *").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query %s scheduled job: %w", operation, err)
+	}
+	defer rows.Close()
+
+	job, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[ScheduledJob])
+	if err != nil {
+		return nil, fmt.Errorf("collect scheduled job: %w", err)
+	}
+
+	return job, nil
+}
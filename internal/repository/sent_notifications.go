@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"boilerplate/internal/pkg/clients/db"
+)
+
+// SentNotification отметка об отправленном уведомлении
+type SentNotification struct {
+	Kind   string    `db:"kind"`
+	Key    string    `db:"key"`
+	SentAt time.Time `db:"sent_at"`
+}
+
+type SentNotificationsRepo interface {
+	// Claim отмечает уведомление отправленным и возвращает false, если оно уже
+	// было отмечено. Вызывается в транзакции вместе с отправкой: конкурентный
+	// вызов с тем же ключом ждет ее завершения
+	Claim(ctx context.Context, kind, key string) (bool, error)
+	Get(ctx context.Context, kind, key string) (*SentNotification, error)
+}
+
+type sentNotificationsRepo struct {
+	client db.Client
+}
+
+func NewSentNotificationsRepo(client db.Client) SentNotificationsRepo {
+	return &sentNotificationsRepo{
+		client: client,
+	}
+}
+
+func (r *sentNotificationsRepo) Claim(ctx context.Context, kind, key string) (bool, error) {
+	builder := sq.Insert(TableSentNotifications).
+		Columns(ColumnKind, ColumnKey).
+		Values(kind, key).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("to sql: %w", err)
+	}
+
+	tag, err := r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return false, fmt.Errorf("execute query claim sent notification: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *sentNotificationsRepo) Get(ctx context.Context, kind, key string) (*SentNotification, error) {
+	builder := sq.Select("*").
+		From(TableSentNotifications).
+		Where(squirrel.Eq{
+			ColumnKind: kind,
+			ColumnKey:  key,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query get sent notification: %w", err)
+	}
+	defer rows.Close()
+
+	notification, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[SentNotification])
+	if err != nil {
+		return nil, fmt.Errorf("collect sent notification: %w", err)
+	}
+
+	return notification, nil
+}
@@ -0,0 +1,56 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/pkg/clients/db"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+)
+
+func TestSentNotifications(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	kind := gofakeit.Word()
+	key := gofakeit.UUID()
+
+	// Отметка откатывается вместе с транзакцией, в которой не удалась отправка
+	errSend := errors.New("send failed")
+	err := sp.GetRepo().Transaction(sp.Context(), func(ctx context.Context, _ db.Executor) error {
+		claimed, err := sp.GetRepo().SentNotifications().Claim(ctx, kind, key)
+		require.NoError(t, err)
+		require.True(t, claimed)
+		return errSend
+	})
+	require.ErrorIs(t, err, errSend)
+
+	_, err = sp.GetRepo().SentNotifications().Get(sp.Context(), kind, key)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	claimed, err := sp.GetRepo().SentNotifications().Claim(sp.Context(), kind, key)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	claimed, err = sp.GetRepo().SentNotifications().Claim(sp.Context(), kind, key)
+	require.NoError(t, err)
+	require.False(t, claimed)
+
+	notification, err := sp.GetRepo().SentNotifications().Get(sp.Context(), kind, key)
+	require.NoError(t, err)
+	require.Equal(t, kind, notification.Kind)
+	require.Equal(t, key, notification.Key)
+	require.NotZero(t, notification.SentAt)
+
+	// Ключ уникален только в пределах вида уведомления
+	claimed, err = sp.GetRepo().SentNotifications().Claim(sp.Context(), gofakeit.UUID(), key)
+	require.NoError(t, err)
+	require.True(t, claimed)
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"boilerplate/internal/pkg/clients/db"
+)
+
+type UserEmailChange struct {
+	ID               int        `db:"id"`
+	UserID           int        `db:"user_id"`
+	OldEmail         string     `db:"old_email"`
+	NewEmail         string     `db:"new_email"`
+	Status           string     `db:"status"`
+	ConfirmTokenHash string     `db:"confirm_token_hash"`
+	UndoTokenHash    string     `db:"undo_token_hash"`
+	ExpiresAt        time.Time  `db:"expires_at"`
+	UndoExpiresAt    time.Time  `db:"undo_expires_at"`
+	ConfirmedAt      *time.Time `db:"confirmed_at"`
+	CreatedAt        time.Time  `db:"created_at"`
+	UpdatedAt        time.Time  `db:"updated_at"`
+}
+
+type UserEmailChangesRepo interface {
+	Create(ctx context.Context, change *UserEmailChange) error
+	GetByConfirmTokenHash(ctx context.Context, hash string) (*UserEmailChange, error)
+	GetByUndoTokenHash(ctx context.Context, hash string) (*UserEmailChange, error)
+	Update(ctx context.Context, change *UserEmailChange) error
+	// SetStatus переводит все смены email пользователя из статуса from в to
+	SetStatus(ctx context.Context, userID int, from, to string) error
+}
+
+type userEmailChangesRepo struct {
+	client db.Client
+}
+
+func NewUserEmailChangesRepo(client db.Client) UserEmailChangesRepo {
+	return &userEmailChangesRepo{
+		client: client,
+	}
+}
+
+func (r *userEmailChangesRepo) Create(ctx context.Context, change *UserEmailChange) error {
+	builder := sq.Insert(TableUserEmailChanges).
+		Columns(ColumnUserID, ColumnOldEmail, ColumnNewEmail, ColumnStatus, ColumnConfirmTokenHash, ColumnUndoTokenHash, ColumnExpiresAt, ColumnUndoExpiresAt, ColumnCreatedAt, ColumnUpdatedAt).
+		Values(change.UserID, change.OldEmail, change.NewEmail, change.Status, change.ConfirmTokenHash, change.UndoTokenHash, change.ExpiresAt, change.UndoExpiresAt, squirrel.Expr("now()"), squirrel.Expr("now()")).
+		Suffix("RETURNING *")
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query create user email change: %w", err)
+	}
+	defer rows.Close()
+
+	createdChange, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[UserEmailChange])
+	if err != nil {
+		return fmt.Errorf("collect user email change: %w", err)
+	}
+
+	*change = *createdChange
+
+	return nil
+}
+
+func (r *userEmailChangesRepo) GetByConfirmTokenHash(ctx context.Context, hash string) (*UserEmailChange, error) {
+	return r.get(ctx, squirrel.Eq{
+		ColumnConfirmTokenHash: hash,
+	})
+}
+
+func (r *userEmailChangesRepo) GetByUndoTokenHash(ctx context.Context, hash string) (*UserEmailChange, error) {
+	return r.get(ctx, squirrel.Eq{
+		ColumnUndoTokenHash: hash,
+	})
+}
+
+func (r *userEmailChangesRepo) get(ctx context.Context, where squirrel.Eq) (*UserEmailChange, error) {
+	builder := sq.Select("*").
+		From(TableUserEmailChanges).
+		Where(where)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query get user email change: %w", err)
+	}
+	defer rows.Close()
+
+	change, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[UserEmailChange])
+	if err != nil {
+		return nil, fmt.Errorf("collect user email change: %w", err)
+	}
+
+	return change, nil
+}
+
+func (r *userEmailChangesRepo) Update(ctx context.Context, change *UserEmailChange) error {
+	builder := sq.Update(TableUserEmailChanges).
+		Set(ColumnStatus, change.Status).
+		Set(ColumnConfirmedAt, change.ConfirmedAt).
+		Set(ColumnUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			ColumnID: change.ID,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	_, err = r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query update user email change: %w", err)
+	}
+
+	return nil
+}
+
+func (r *userEmailChangesRepo) SetStatus(ctx context.Context, userID int, from, to string) error {
+	builder := sq.Update(TableUserEmailChanges).
+		Set(ColumnStatus, to).
+		Set(ColumnUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			ColumnUserID: userID,
+			ColumnStatus: from,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	_, err = r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query set user email changes status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/repository"
+)
+
+func TestUserEmailChangeCRUD(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	unknownChange, err := sp.GetRepo().UserEmailChanges().GetByConfirmTokenHash(sp.Context(), "unknown")
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+	require.Nil(t, unknownChange)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	change := &repository.UserEmailChange{
+		UserID:           user.ID,
+		OldEmail:         user.Email,
+		NewEmail:         "new-" + user.Email,
+		Status:           string(model.UserEmailChangeStatusPending),
+		ConfirmTokenHash: "confirm-" + user.Email,
+		UndoTokenHash:    "undo-" + user.Email,
+		ExpiresAt:        now.Add(time.Hour),
+		UndoExpiresAt:    now.Add(2 * time.Hour),
+	}
+	err = sp.GetRepo().UserEmailChanges().Create(sp.Context(), change)
+	require.NoError(t, err)
+	require.NotZero(t, change.ID)
+
+	createdChange, err := sp.GetRepo().UserEmailChanges().GetByConfirmTokenHash(sp.Context(), change.ConfirmTokenHash)
+	require.NoError(t, err)
+	require.Equal(t, change.ID, createdChange.ID)
+	require.Equal(t, change.NewEmail, createdChange.NewEmail)
+	require.True(t, change.ExpiresAt.Equal(createdChange.ExpiresAt))
+	require.Nil(t, createdChange.ConfirmedAt)
+
+	createdChange.Status = string(model.UserEmailChangeStatusConfirmed)
+	createdChange.ConfirmedAt = utils.Ptr(now)
+	err = sp.GetRepo().UserEmailChanges().Update(sp.Context(), createdChange)
+	require.NoError(t, err)
+
+	updatedChange, err := sp.GetRepo().UserEmailChanges().GetByUndoTokenHash(sp.Context(), change.UndoTokenHash)
+	require.NoError(t, err)
+	require.Equal(t, string(model.UserEmailChangeStatusConfirmed), updatedChange.Status)
+	require.NotNil(t, updatedChange.ConfirmedAt)
+
+	// Подтвержденная смена не затрагивается при отмене ожидающих
+	err = sp.GetRepo().UserEmailChanges().SetStatus(sp.Context(), user.ID, string(model.UserEmailChangeStatusPending), string(model.UserEmailChangeStatusCancelled))
+	require.NoError(t, err)
+
+	updatedChange, err = sp.GetRepo().UserEmailChanges().GetByUndoTokenHash(sp.Context(), change.UndoTokenHash)
+	require.NoError(t, err)
+	require.Equal(t, string(model.UserEmailChangeStatusConfirmed), updatedChange.Status)
+}
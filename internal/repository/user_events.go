@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/topics"
+	"boilerplate/pkg/pb/events"
+)
+
+// NewUserCreatedMessage собирает outbox-сообщение о создании пользователя.
+// Используется всеми сервисами, создающими пользователей
+func NewUserCreatedMessage(user *User) (*OutboxMessage, error) {
+	return NewOutboxMessage(topics.TopicUserCreated, string(model.OutboxAggregateUser), strconv.Itoa(user.ID), &events.UserCreated{
+		UserId:     convert.ToInt64(user.ID),
+		Name:       user.Name,
+		Email:      user.Email,
+		Attributes: convert.ToStruct(user.Attributes),
+		CreatedAt:  timestamppb.New(user.CreatedAt),
+	})
+}
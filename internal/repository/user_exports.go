@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"boilerplate/internal/pkg/clients/db"
+)
+
+type UserExport struct {
+	ID         int        `db:"id"`
+	Status     string     `db:"status"`
+	Format     string     `db:"format"`
+	Filter     []byte     `db:"filter"`
+	Total      int        `db:"total"`
+	FilePath   *string    `db:"file_path"`
+	Error      *string    `db:"error"`
+	CreatedBy  *int       `db:"created_by"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+	FinishedAt *time.Time `db:"finished_at"`
+}
+
+type UserExportsRepo interface {
+	Create(ctx context.Context, userExport *UserExport) error
+	Get(ctx context.Context, id int) (*UserExport, error)
+	Update(ctx context.Context, userExport *UserExport) error
+}
+
+type userExportsRepo struct {
+	client db.Client
+}
+
+func NewUserExportsRepo(client db.Client) UserExportsRepo {
+	return &userExportsRepo{
+		client: client,
+	}
+}
+
+func (r *userExportsRepo) Create(ctx context.Context, userExport *UserExport) error {
+	builder := sq.Insert(TableUserExports).
+		Columns(ColumnStatus, ColumnFormat, ColumnFilter, ColumnCreatedBy, ColumnCreatedAt, ColumnUpdatedAt).
+		Values(userExport.Status, userExport.Format, userExport.Filter, userExport.CreatedBy, squirrel.Expr("now()"), squirrel.Expr("now()")).
+		Suffix("RETURNING *")
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query create user export: %w", err)
+	}
+	defer rows.Close()
+
+	createdUserExport, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[UserExport])
+	if err != nil {
+		return fmt.Errorf("collect user export: %w", err)
+	}
+
+	*userExport = *createdUserExport
+
+	return nil
+}
+
+func (r *userExportsRepo) Get(ctx context.Context, id int) (*UserExport, error) {
+	builder := sq.Select("*").
+		From(TableUserExports).
+		Where(squirrel.Eq{
+			ColumnID: id,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query get user export: %w", err)
+	}
+	defer rows.Close()
+
+	userExport, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[UserExport])
+	if err != nil {
+		return nil, fmt.Errorf("collect user export: %w", err)
+	}
+
+	return userExport, nil
+}
+
+func (r *userExportsRepo) Update(ctx context.Context, userExport *UserExport) error {
+	builder := sq.Update(TableUserExports).
+		Set(ColumnStatus, userExport.Status).
+		Set(ColumnTotal, userExport.Total).
+		Set(ColumnFilePath, userExport.FilePath).
+		Set(ColumnError, userExport.Error).
+		Set(ColumnFinishedAt, userExport.FinishedAt).
+		Set(ColumnUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			ColumnID: userExport.ID,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	_, err = r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query update user export: %w", err)
+	}
+
+	return nil
+}
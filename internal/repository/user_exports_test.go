@@ -0,0 +1,59 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/repository"
+)
+
+func TestUserExportCRUD(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	unknownExport, err := sp.GetRepo().UserExports().Get(sp.Context(), -1)
+	require.Error(t, err)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+	require.Nil(t, unknownExport)
+
+	userExport := &repository.UserExport{
+		Status: string(model.UserExportStatusPending),
+		Format: string(model.UserExportFormatCSV),
+		Filter: []byte(`{"name":"test"}`),
+	}
+	err = sp.GetRepo().UserExports().Create(sp.Context(), userExport)
+	require.NoError(t, err)
+	require.NotZero(t, userExport.ID)
+	require.NotEmpty(t, userExport.CreatedAt)
+	require.NotEmpty(t, userExport.UpdatedAt)
+
+	createdExport, err := sp.GetRepo().UserExports().Get(sp.Context(), userExport.ID)
+	require.NoError(t, err)
+	require.Equal(t, userExport.Status, createdExport.Status)
+	require.Equal(t, userExport.Format, createdExport.Format)
+	require.JSONEq(t, `{"name":"test"}`, string(createdExport.Filter))
+	require.Zero(t, createdExport.Total)
+	require.Nil(t, createdExport.FilePath)
+	require.Nil(t, createdExport.FinishedAt)
+
+	createdExport.Status = string(model.UserExportStatusCompleted)
+	createdExport.Total = 10
+	createdExport.FilePath = utils.Ptr("exports/1/users.csv")
+	createdExport.FinishedAt = utils.Ptr(createdExport.CreatedAt)
+	err = sp.GetRepo().UserExports().Update(sp.Context(), createdExport)
+	require.NoError(t, err)
+
+	updatedExport, err := sp.GetRepo().UserExports().Get(sp.Context(), userExport.ID)
+	require.NoError(t, err)
+	require.Equal(t, string(model.UserExportStatusCompleted), updatedExport.Status)
+	require.Equal(t, 10, updatedExport.Total)
+	require.Equal(t, createdExport.FilePath, updatedExport.FilePath)
+	require.NotNil(t, updatedExport.FinishedAt)
+}
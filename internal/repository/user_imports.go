@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"boilerplate/internal/pkg/clients/db"
+)
+
+type UserImport struct {
+	ID         int        `db:"id"`
+	Status     string     `db:"status"`
+	FilePath   string     `db:"file_path"`
+	DryRun     bool       `db:"dry_run"`
+	Total      int        `db:"total"`
+	Processed  int        `db:"processed"`
+	Created    int        `db:"created"`
+	Failed     int        `db:"failed"`
+	ReportPath *string    `db:"report_path"`
+	Error      *string    `db:"error"`
+	CreatedBy  *int       `db:"created_by"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+	FinishedAt *time.Time `db:"finished_at"`
+}
+
+type UserImportsRepo interface {
+	Create(ctx context.Context, userImport *UserImport) error
+	Get(ctx context.Context, id int) (*UserImport, error)
+	Update(ctx context.Context, userImport *UserImport) error
+}
+
+type userImportsRepo struct {
+	client db.Client
+}
+
+func NewUserImportsRepo(client db.Client) UserImportsRepo {
+	return &userImportsRepo{
+		client: client,
+	}
+}
+
+func (r *userImportsRepo) Create(ctx context.Context, userImport *UserImport) error {
+	builder := sq.Insert(TableUserImports).
+		Columns(ColumnStatus, ColumnFilePath, ColumnDryRun, ColumnCreatedBy, ColumnCreatedAt, ColumnUpdatedAt).
+		Values(userImport.Status, userImport.FilePath, userImport.DryRun, userImport.CreatedBy, squirrel.Expr("now()"), squirrel.Expr("now()")).
+		Suffix("RETURNING *")
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query create user import: %w", err)
+	}
+	defer rows.Close()
+
+	createdUserImport, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[UserImport])
+	if err != nil {
+		return fmt.Errorf("collect user import: %w", err)
+	}
+
+	*userImport = *createdUserImport
+
+	return nil
+}
+
+func (r *userImportsRepo) Get(ctx context.Context, id int) (*UserImport, error) {
+	builder := sq.Select("*").
+		From(TableUserImports).
+		Where(squirrel.Eq{
+			ColumnID: id,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query get user import: %w", err)
+	}
+	defer rows.Close()
+
+	userImport, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[UserImport])
+	if err != nil {
+		return nil, fmt.Errorf("collect user import: %w", err)
+	}
+
+	return userImport, nil
+}
+
+func (r *userImportsRepo) Update(ctx context.Context, userImport *UserImport) error {
+	builder := sq.Update(TableUserImports).
+		Set(ColumnStatus, userImport.Status).
+		Set(ColumnTotal, userImport.Total).
+		Set(ColumnProcessed, userImport.Processed).
+		Set(ColumnCreated, userImport.Created).
+		Set(ColumnFailed, userImport.Failed).
+		Set(ColumnReportPath, userImport.ReportPath).
+		Set(ColumnError, userImport.Error).
+		Set(ColumnFinishedAt, userImport.FinishedAt).
+		Set(ColumnUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			ColumnID: userImport.ID,
+		})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	_, err = r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query update user import: %w", err)
+	}
+
+	return nil
+}
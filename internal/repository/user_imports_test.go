@@ -0,0 +1,66 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/repository"
+)
+
+func TestUserImportCRUD(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	unknownImport, err := sp.GetRepo().UserImports().Get(sp.Context(), -1)
+	require.Error(t, err)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+	require.Nil(t, unknownImport)
+
+	userImport := &repository.UserImport{
+		Status:   string(model.UserImportStatusPending),
+		FilePath: gofakeit.UUID() + ".csv",
+		DryRun:   true,
+	}
+	err = sp.GetRepo().UserImports().Create(sp.Context(), userImport)
+	require.NoError(t, err)
+	require.NotZero(t, userImport.ID)
+	require.NotEmpty(t, userImport.CreatedAt)
+	require.NotEmpty(t, userImport.UpdatedAt)
+
+	createdImport, err := sp.GetRepo().UserImports().Get(sp.Context(), userImport.ID)
+	require.NoError(t, err)
+	require.Equal(t, userImport.Status, createdImport.Status)
+	require.Equal(t, userImport.FilePath, createdImport.FilePath)
+	require.True(t, createdImport.DryRun)
+	require.Zero(t, createdImport.Total)
+	require.Nil(t, createdImport.ReportPath)
+	require.Nil(t, createdImport.FinishedAt)
+
+	createdImport.Status = string(model.UserImportStatusCompleted)
+	createdImport.Total = 10
+	createdImport.Processed = 10
+	createdImport.Created = 7
+	createdImport.Failed = 3
+	createdImport.ReportPath = utils.Ptr("imports/1/report.csv")
+	createdImport.FinishedAt = utils.Ptr(createdImport.CreatedAt)
+	err = sp.GetRepo().UserImports().Update(sp.Context(), createdImport)
+	require.NoError(t, err)
+
+	updatedImport, err := sp.GetRepo().UserImports().Get(sp.Context(), userImport.ID)
+	require.NoError(t, err)
+	require.Equal(t, string(model.UserImportStatusCompleted), updatedImport.Status)
+	require.Equal(t, 10, updatedImport.Total)
+	require.Equal(t, 10, updatedImport.Processed)
+	require.Equal(t, 7, updatedImport.Created)
+	require.Equal(t, 3, updatedImport.Failed)
+	require.Equal(t, createdImport.ReportPath, updatedImport.ReportPath)
+	require.NotNil(t, updatedImport.FinishedAt)
+}
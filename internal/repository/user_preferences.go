@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"boilerplate/internal/pkg/clients/db"
+)
+
+type UserPreference struct {
+	UserID    int             `db:"user_id"`
+	Key       string          `db:"key"`
+	Value     json.RawMessage `db:"value"`
+	UpdatedAt time.Time       `db:"updated_at"`
+}
+
+type UserPreferencesRepo interface {
+	List(ctx context.Context, userID int) ([]*UserPreference, error)
+	// Set сохраняет значения настроек пользователя, заменяя существующие
+	Set(ctx context.Context, userID int, values map[string]json.RawMessage) error
+}
+
+type userPreferencesRepo struct {
+	client db.Client
+}
+
+func NewUserPreferencesRepo(client db.Client) UserPreferencesRepo {
+	return &userPreferencesRepo{
+		client: client,
+	}
+}
+
+func (r *userPreferencesRepo) List(ctx context.Context, userID int) ([]*UserPreference, error) {
+	builder := sq.Select("*").
+		From(TableUserPreferences).
+		Where(squirrel.Eq{
+			ColumnUserID: userID,
+		}).
+		OrderBy(ColumnKey)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query list user preferences: %w", err)
+	}
+	defer rows.Close()
+
+	preferences, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[UserPreference])
+	if err != nil {
+		return nil, fmt.Errorf("collect user preferences: %w", err)
+	}
+
+	return preferences, nil
+}
+
+func (r *userPreferencesRepo) Set(ctx context.Context, userID int, values map[string]json.RawMessage) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	builder := sq.Insert(TableUserPreferences).
+		Columns(ColumnUserID, ColumnKey, ColumnValue, ColumnUpdatedAt).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%[1]s, %[2]s) DO UPDATE SET %[3]s = excluded.%[3]s, %[4]s = excluded.%[4]s",
+			ColumnUserID, ColumnKey, ColumnValue, ColumnUpdatedAt,
+		))
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		builder = builder.Values(userID, key, values[key], squirrel.Expr("now()"))
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	_, err = r.client.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query set user preferences: %w", err)
+	}
+
+	return nil
+}
@@ -2,32 +2,51 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 
+	"boilerplate/internal/model"
 	"boilerplate/internal/pkg/clients/db"
 )
 
 type User struct {
-	ID        int        `db:"id"`
-	Name      string     `db:"name"`
-	Email     string     `db:"email"`
-	Password  string     `db:"password"`
-	IsAdmin   bool       `db:"is_admin"`
-	Deleted   bool       `db:"deleted"`
-	CreatedAt time.Time  `db:"created_at"`
-	UpdatedAt time.Time  `db:"updated_at"`
-	DeletedAt *time.Time `db:"deleted_at"`
+	ID         int            `db:"id"`
+	Name       string         `db:"name"`
+	Email      string         `db:"email"`
+	Password   string         `db:"password"`
+	IsAdmin    bool           `db:"is_admin"`
+	Deleted    bool           `db:"deleted"`
+	CreatedAt  time.Time      `db:"created_at"`
+	UpdatedAt  time.Time      `db:"updated_at"`
+	DeletedAt  *time.Time     `db:"deleted_at"`
+	Version    int            `db:"version"`
+	Attributes map[string]any `db:"attributes"`
 }
 
+// ErrVersionConflict возвращается, если пользователь был изменен с момента чтения
+var ErrVersionConflict = errors.New("version conflict")
+
+// ErrEmailExists возвращается, если email без учета регистра уже занят другим
+// пользователем
+var ErrEmailExists = errors.New("email exists")
+
+const (
+	codeUniqueViolation = "23505"
+	indexUsersEmail     = "users_email_lower_idx"
+)
+
 type UserFilter struct {
 	IDs         []int
 	Name        *string
 	Emails      []string
 	IsAdmin     *bool
+	Attributes  map[string]any
 	WithDeleted *bool
 	Limit       *int
 	Offset      *int
@@ -39,12 +58,15 @@ type Users struct {
 	Total  int
 }
 
+// UsersRepo каждое изменение пользователя сохраняет в users_history
 type UsersRepo interface {
 	Create(ctx context.Context, user *User) error
 	Get(ctx context.Context, id int) (*User, error)
-	Update(ctx context.Context, user *User) error
-	Delete(ctx context.Context, id int) error
+	Update(ctx context.Context, user *User, columns ...string) error
+	Delete(ctx context.Context, id, version int) error
 	Search(ctx context.Context, filter *UserFilter) (*Users, error)
+	Count(ctx context.Context, filter *UserFilter) (int, error)
+	Each(ctx context.Context, filter *UserFilter, fn func(user *User) error) error
 }
 
 type usersRepo struct {
@@ -59,13 +81,13 @@ func NewUsersRepo(client db.Client) UsersRepo {
 
 func (r *usersRepo) Create(ctx context.Context, user *User) error {
 	builder := sq.Insert(TableUsers).
-		Columns(ColumnName, ColumnEmail, ColumnPassword, ColumnIsAdmin, ColumnCreatedAt, ColumnUpdatedAt).
-		Values(user.Name, user.Email, user.Password, user.IsAdmin, squirrel.Expr("now()"), squirrel.Expr("now()")).
+		Columns(ColumnName, ColumnEmail, ColumnPassword, ColumnIsAdmin, ColumnAttributes, ColumnCreatedAt, ColumnUpdatedAt).
+		Values(user.Name, user.Email, user.Password, user.IsAdmin, attributesOrEmpty(user.Attributes), squirrel.Expr("now()"), squirrel.Expr("now()")).
 		Suffix("RETURNING *")
 
-	sql, args, err := builder.ToSql()
+	sql, args, err := withHistory(ctx, builder, string(model.UserHistoryOperationCreate))
 	if err != nil {
-		return fmt.Errorf("to sql: %w", err)
+		return fmt.Errorf("with history: %w", err)
 	}
 
 	rows, err := r.client.Query(ctx, sql, args...)
@@ -77,6 +99,9 @@ func (r *usersRepo) Create(ctx context.Context, user *User) error {
 
 	createdUser, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[User])
 	if err != nil {
+		if isEmailExists(err) {
+			return ErrEmailExists
+		}
 		return fmt.Errorf("collect user: %w", err)
 	}
 
@@ -111,83 +136,93 @@ func (r *usersRepo) Get(ctx context.Context, id int) (*User, error) {
 	return user, nil
 }
 
-func (r *usersRepo) Update(ctx context.Context, user *User) error {
-	builder := sq.Update(TableUsers).
-		Set(ColumnName, user.Name).
-		Set(ColumnEmail, user.Email).
-		Set(ColumnPassword, user.Password).
+// Update сохраняет указанные колонки пользователя (все, если колонки не
+// указаны), если его версия не изменилась с момента чтения, и увеличивает
+// версию. Иначе возвращает ErrVersionConflict
+func (r *usersRepo) Update(ctx context.Context, user *User, columns ...string) error {
+	builder, err := setColumns(sq.Update(TableUsers), map[string]any{
+		ColumnName:       user.Name,
+		ColumnEmail:      user.Email,
+		ColumnPassword:   user.Password,
+		ColumnAttributes: attributesOrEmpty(user.Attributes),
+	}, columns)
+	if err != nil {
+		return fmt.Errorf("set columns: %w", err)
+	}
+
+	builder = builder.
+		Set(ColumnVersion, squirrel.Expr(ColumnVersion+" + 1")).
 		Set(ColumnUpdatedAt, squirrel.Expr("now()")).
 		Where(squirrel.Eq{
-			ColumnID: user.ID,
-		})
+			ColumnID:      user.ID,
+			ColumnVersion: user.Version,
+		}).
+		Suffix("RETURNING *")
 
-	sql, args, err := builder.ToSql()
+	sql, args, err := withHistory(ctx, builder, string(model.UserHistoryOperationUpdate))
 	if err != nil {
-		return fmt.Errorf("to sql: %w", err)
+		return fmt.Errorf("with history: %w", err)
 	}
 
-	_, err = r.client.Exec(ctx, sql, args...)
+	rows, err := r.client.Query(ctx, sql, args...)
 	if err != nil {
 		return fmt.Errorf("execute query update user: %w", err)
 	}
+	defer rows.Close()
+
+	updatedUser, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[User])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrVersionConflict
+		}
+		if isEmailExists(err) {
+			return ErrEmailExists
+		}
+		return fmt.Errorf("collect user: %w", err)
+	}
+
+	*user = *updatedUser
 
 	return nil
 }
 
-func (r *usersRepo) Delete(ctx context.Context, id int) error {
+// Delete помечает пользователя удаленным, если его версия не изменилась с
+// момента чтения. Иначе возвращает ErrVersionConflict
+func (r *usersRepo) Delete(ctx context.Context, id, version int) error {
 	builder := sq.Update(TableUsers).
 		Set(ColumnDeleted, true).
 		Set(ColumnDeletedAt, squirrel.Expr("now()")).
+		Set(ColumnVersion, squirrel.Expr(ColumnVersion+" + 1")).
 		Where(squirrel.Eq{
-			ColumnID: id,
-		})
+			ColumnID:      id,
+			ColumnVersion: version,
+		}).
+		Suffix("RETURNING *")
 
-	sql, args, err := builder.ToSql()
+	sql, args, err := withHistory(ctx, builder, string(model.UserHistoryOperationDelete))
 	if err != nil {
-		return fmt.Errorf("to sql: %w", err)
+		return fmt.Errorf("with history: %w", err)
 	}
 
-	_, err = r.client.Exec(ctx, sql, args...)
+	rows, err := r.client.Query(ctx, sql, args...)
 	if err != nil {
 		return fmt.Errorf("execute query delete user: %w", err)
 	}
+	defer rows.Close()
+
+	_, err = pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[User])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrVersionConflict
+		}
+		return fmt.Errorf("collect user: %w", err)
+	}
 
 	return nil
 }
 
 func (r *usersRepo) Search(ctx context.Context, filter *UserFilter) (*Users, error) {
-	builder := sq.Select("*").
-		From(TableUsers)
-
-	if filter.IDs != nil {
-		builder = builder.Where(squirrel.Eq{
-			ColumnID: filter.IDs,
-		})
-	}
-
-	if filter.Name != nil {
-		builder = builder.Where(squirrel.Like{
-			ColumnName: "%" + *filter.Name + "%",
-		})
-	}
-
-	if filter.Emails != nil {
-		builder = builder.Where(squirrel.Eq{
-			ColumnEmail: filter.Emails,
-		})
-	}
-
-	if filter.IsAdmin != nil {
-		builder = builder.Where(squirrel.Eq{
-			ColumnIsAdmin: *filter.IsAdmin,
-		})
-	}
-
-	if filter.WithDeleted == nil || *filter.WithDeleted == false {
-		builder = builder.Where(squirrel.Eq{
-			ColumnDeleted: false,
-		})
-	}
+	builder := applyUserFilter(sq.Select("*").From(TableUsers), filter)
 
 	if filter.Limit != nil {
 		builder = builder.Limit(uint64(*filter.Limit))
@@ -222,3 +257,115 @@ func (r *usersRepo) Search(ctx context.Context, filter *UserFilter) (*Users, err
 
 	return users, nil
 }
+
+func (r *usersRepo) Count(ctx context.Context, filter *UserFilter) (int, error) {
+	builder := applyUserFilter(sq.Select("count(*)").From(TableUsers), filter)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("to sql: %w", err)
+	}
+
+	var count int
+	err = r.client.QueryRow(ctx, sql, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("execute query count users: %w", err)
+	}
+
+	return count, nil
+}
+
+// Each читает пользователей по фильтру построчно и вызывает fn для каждого,
+// не загружая всю выборку в память. Limit и Offset фильтра игнорируются
+func (r *usersRepo) Each(ctx context.Context, filter *UserFilter, fn func(user *User) error) error {
+	builder := applyUserFilter(sq.Select("*").From(TableUsers), filter).
+		OrderBy(ColumnID + " ASC")
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("execute query each users: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		user, err := pgx.RowToAddrOfStructByName[User](rows)
+		if err != nil {
+			return fmt.Errorf("collect user: %w", err)
+		}
+
+		if err := fn(user); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("read users: %w", err)
+	}
+
+	return nil
+}
+
+func applyUserFilter(builder squirrel.SelectBuilder, filter *UserFilter) squirrel.SelectBuilder {
+	if filter.IDs != nil {
+		builder = builder.Where(squirrel.Eq{
+			ColumnID: filter.IDs,
+		})
+	}
+
+	if filter.Name != nil {
+		builder = builder.Where(squirrel.Like{
+			ColumnName: "%" + *filter.Name + "%",
+		})
+	}
+
+	// Email сравнивается без учета регистра (использует индекс по lower(email))
+	if filter.Emails != nil {
+		emails := make([]string, 0, len(filter.Emails))
+		for _, email := range filter.Emails {
+			emails = append(emails, strings.ToLower(email))
+		}
+
+		builder = builder.Where(squirrel.Eq{
+			"lower(" + ColumnEmail + ")": emails,
+		})
+	}
+
+	if filter.IsAdmin != nil {
+		builder = builder.Where(squirrel.Eq{
+			ColumnIsAdmin: *filter.IsAdmin,
+		})
+	}
+
+	// Атрибуты пользователя должны содержать указанные (использует GIN-индекс)
+	if len(filter.Attributes) > 0 {
+		builder = builder.Where(squirrel.Expr(ColumnAttributes+" @> ?", filter.Attributes))
+	}
+
+	if filter.WithDeleted == nil || *filter.WithDeleted == false {
+		builder = builder.Where(squirrel.Eq{
+			ColumnDeleted: false,
+		})
+	}
+
+	return builder
+}
+
+// attributesOrEmpty заменяет отсутствующие атрибуты пустым объектом, так как
+// колонка attributes не допускает NULL
+func attributesOrEmpty(attributes map[string]any) map[string]any {
+	if attributes == nil {
+		return map[string]any{}
+	}
+	return attributes
+}
+
+// isEmailExists проверяет, что ошибка вызвана нарушением уникальности email
+func isEmailExists(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == codeUniqueViolation && pgErr.ConstraintName == indexUsersEmail
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"boilerplate/internal/pkg/clients/db"
+	"boilerplate/internal/pkg/metadata"
+)
+
+type UserHistory struct {
+	ID        int            `db:"id"`
+	UserID    int            `db:"user_id"`
+	Version   int            `db:"version"`
+	Operation string         `db:"operation"`
+	Data      map[string]any `db:"data"`
+	ChangedBy *int           `db:"changed_by"`
+	ChangedAt time.Time      `db:"changed_at"`
+}
+
+type UsersHistoryRepo interface {
+	// List возвращает снимки пользователя в порядке изменения
+	List(ctx context.Context, userID int) ([]*UserHistory, error)
+	// GetAsOf возвращает пользователя в состоянии на указанный момент. Если
+	// пользователя тогда еще не было, возвращает pgx.ErrNoRows
+	GetAsOf(ctx context.Context, userID int, asOf time.Time) (*User, error)
+}
+
+type usersHistoryRepo struct {
+	client db.Client
+}
+
+func NewUsersHistoryRepo(client db.Client) UsersHistoryRepo {
+	return &usersHistoryRepo{
+		client: client,
+	}
+}
+
+func (r *usersHistoryRepo) List(ctx context.Context, userID int) ([]*UserHistory, error) {
+	builder := sq.Select("*").
+		From(TableUsersHistory).
+		Where(squirrel.Eq{
+			ColumnUserID: userID,
+		}).
+		OrderBy(ColumnChangedAt+" ASC", ColumnID+" ASC")
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query list users history: %w", err)
+	}
+	defer rows.Close()
+
+	history, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[UserHistory])
+	if err != nil {
+		return nil, fmt.Errorf("collect users history: %w", err)
+	}
+
+	return history, nil
+}
+
+// GetAsOf восстанавливает пользователя из последнего снимка до asOf. Поля,
+// которых не было в снимке (добавленные позже колонки), берутся из текущего
+// состояния пользователя. Пароль в снимках не хранится и не возвращается
+func (r *usersHistoryRepo) GetAsOf(ctx context.Context, userID int, asOf time.Time) (*User, error) {
+	builder := sq.Select("(jsonb_populate_record("+TableUsers+", "+TableUsersHistory+"."+ColumnData+")).*").
+		From(TableUsersHistory).
+		Join(TableUsers+" ON "+TableUsers+"."+ColumnID+" = "+TableUsersHistory+"."+ColumnUserID).
+		Where(squirrel.Eq{
+			TableUsersHistory + "." + ColumnUserID: userID,
+		}).
+		Where(squirrel.LtOrEq{
+			TableUsersHistory + "." + ColumnChangedAt: asOf,
+		}).
+		OrderBy(TableUsersHistory+"."+ColumnChangedAt+" DESC", TableUsersHistory+"."+ColumnID+" DESC").
+		Limit(1)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := r.client.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute query get user as of: %w", err)
+	}
+	defer rows.Close()
+
+	user, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[User])
+	if err != nil {
+		return nil, fmt.Errorf("collect user: %w", err)
+	}
+
+	user.Password = ""
+
+	return user, nil
+}
+
+// withHistory дополняет запрос, изменяющий пользователя и возвращающий его
+// (RETURNING *), записью снимка пользователя в users_history. Снимок пишется
+// тем же запросом, поэтому история не расходится с данными и без транзакции.
+// Вместо хеша пароля сохраняется его отпечаток, чтобы видеть смену пароля
+func withHistory(ctx context.Context, query squirrel.Sqlizer, operation string) (string, []any, error) {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return "", nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	var changedBy *int
+	if userID, exists := metadata.GetUserID(ctx); exists {
+		changedBy = &userID
+	}
+
+	args = append(args, operation, changedBy)
+
+	sql = fmt.Sprintf(`WITH changed AS (%s), history AS (
+	INSERT INTO %s (%s, %s, %s, %s, %s, %s)
+	SELECT %s, %s, $%d, to_jsonb(changed) || jsonb_build_object('%s', md5(coalesce(%s, ''))), $%d, now()
+	FROM changed
+)
+SELECT * FROM changed`,
+		sql,
+		TableUsersHistory, ColumnUserID, ColumnVersion, ColumnOperation, ColumnData, ColumnChangedBy, ColumnChangedAt,
+		ColumnID, ColumnVersion, len(args)-1, ColumnPassword, ColumnPassword, len(args),
+	)
+
+	return sql, args, nil
+}
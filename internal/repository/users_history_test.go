@@ -0,0 +1,74 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/metadata"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/repository"
+)
+
+func TestUsersHistory(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	admin := suite_factory.NewUserFactory().WithAdmin().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), admin)
+	require.NoError(t, err)
+
+	ctx := metadata.WithUserID(sp.Context(), admin.ID)
+
+	user := suite_factory.NewUserFactory().Build()
+	err = sp.GetRepo().Users().Create(ctx, user)
+	require.NoError(t, err)
+	originalName := user.Name
+
+	user.Name = "renamed " + user.Name
+	err = sp.GetRepo().Users().Update(ctx, user, repository.ColumnName)
+	require.NoError(t, err)
+
+	err = sp.GetRepo().Users().Delete(ctx, user.ID, user.Version)
+	require.NoError(t, err)
+
+	history, err := sp.GetRepo().UsersHistory().List(sp.Context(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+
+	operations := []model.UserHistoryOperation{
+		model.UserHistoryOperationCreate,
+		model.UserHistoryOperationUpdate,
+		model.UserHistoryOperationDelete,
+	}
+	for i, snapshot := range history {
+		require.Equal(t, string(operations[i]), snapshot.Operation)
+		require.Equal(t, i+1, snapshot.Version)
+		require.NotNil(t, snapshot.ChangedBy)
+		require.Equal(t, admin.ID, *snapshot.ChangedBy)
+		require.NotEqual(t, user.Password, snapshot.Data[repository.ColumnPassword])
+	}
+	require.Equal(t, originalName, history[0].Data[repository.ColumnName])
+	require.Equal(t, user.Name, history[1].Data[repository.ColumnName])
+	require.Equal(t, true, history[2].Data[repository.ColumnDeleted])
+
+	createdUser, err := sp.GetRepo().UsersHistory().GetAsOf(sp.Context(), user.ID, history[0].ChangedAt)
+	require.NoError(t, err)
+	require.Equal(t, originalName, createdUser.Name)
+	require.Equal(t, 1, createdUser.Version)
+	require.False(t, createdUser.Deleted)
+	require.Empty(t, createdUser.Password)
+
+	deletedUser, err := sp.GetRepo().UsersHistory().GetAsOf(sp.Context(), user.ID, history[2].ChangedAt.Add(time.Hour))
+	require.NoError(t, err)
+	require.True(t, deletedUser.Deleted)
+
+	_, err = sp.GetRepo().UsersHistory().GetAsOf(sp.Context(), user.ID, history[0].ChangedAt.Add(-time.Hour))
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
@@ -1,6 +1,8 @@
 package repository_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/jackc/pgx/v5"
@@ -41,10 +43,16 @@ func TestUserCRUD(t *testing.T) {
 	require.False(t, createdUser.Deleted)
 	require.NotEmpty(t, createdUser.CreatedAt)
 	require.NotEmpty(t, createdUser.UpdatedAt)
+	require.Equal(t, 1, createdUser.Version)
 
-	user = suite_factory.NewUserFactory().WithID(user.ID).Build()
+	user = suite_factory.NewUserFactory().WithID(user.ID).WithVersion(createdUser.Version).Build()
 	err = sp.GetRepo().Users().Update(sp.Context(), user)
 	require.NoError(t, err)
+	require.Equal(t, 2, user.Version)
+
+	staleUser := suite_factory.NewUserFactory().WithID(user.ID).WithVersion(createdUser.Version).Build()
+	err = sp.GetRepo().Users().Update(sp.Context(), staleUser)
+	require.ErrorIs(t, err, repository.ErrVersionConflict)
 
 	updatedUser, err := sp.GetRepo().Users().Get(sp.Context(), user.ID)
 	require.NoError(t, err)
@@ -56,8 +64,12 @@ func TestUserCRUD(t *testing.T) {
 	require.False(t, updatedUser.Deleted)
 	require.NotEmpty(t, updatedUser.CreatedAt)
 	require.NotEmpty(t, updatedUser.UpdatedAt)
+	require.Equal(t, 2, updatedUser.Version)
+
+	err = sp.GetRepo().Users().Delete(sp.Context(), user.ID, createdUser.Version)
+	require.ErrorIs(t, err, repository.ErrVersionConflict)
 
-	err = sp.GetRepo().Users().Delete(sp.Context(), user.ID)
+	err = sp.GetRepo().Users().Delete(sp.Context(), user.ID, updatedUser.Version)
 	require.NoError(t, err)
 
 	deletedUser, err := sp.GetRepo().Users().Get(sp.Context(), user.ID)
@@ -67,6 +79,62 @@ func TestUserCRUD(t *testing.T) {
 	require.NotEmpty(t, deletedUser.DeletedAt)
 }
 
+func TestUserEmailUnique(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	// Email уникален без учета регистра
+	duplicate := suite_factory.NewUserFactory().Build()
+	duplicate.Email = strings.ToUpper(user.Email)
+	err = sp.GetRepo().Users().Create(sp.Context(), duplicate)
+	require.ErrorIs(t, err, repository.ErrEmailExists)
+
+	other := suite_factory.NewUserFactory().Build()
+	err = sp.GetRepo().Users().Create(sp.Context(), other)
+	require.NoError(t, err)
+
+	other.Email = strings.ToUpper(user.Email)
+	err = sp.GetRepo().Users().Update(sp.Context(), other, repository.ColumnEmail)
+	require.ErrorIs(t, err, repository.ErrEmailExists)
+
+	// Поиск по email тоже не учитывает регистр
+	users, err := sp.GetRepo().Users().Search(sp.Context(), &repository.UserFilter{
+		Emails: []string{strings.ToUpper(user.Email)},
+	})
+	require.NoError(t, err)
+	require.Len(t, users.Result, 1)
+	require.Equal(t, user.ID, users.Result[0].ID)
+}
+
+func TestUserUpdateColumns(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	changedUser := suite_factory.NewUserFactory().WithID(user.ID).WithVersion(user.Version).Build()
+	err = sp.GetRepo().Users().Update(sp.Context(), changedUser, repository.ColumnName)
+	require.NoError(t, err)
+
+	updatedUser, err := sp.GetRepo().Users().Get(sp.Context(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, changedUser.Name, updatedUser.Name)
+	require.Equal(t, user.Email, updatedUser.Email)
+
+	err = sp.GetRepo().Users().Update(sp.Context(), updatedUser, repository.ColumnIsAdmin)
+	require.Error(t, err)
+}
+
 func TestUserSearch(t *testing.T) {
 	t.Parallel()
 
@@ -80,7 +148,7 @@ func TestUserSearch(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	err := sp.GetRepo().Users().Delete(sp.Context(), users[3].ID)
+	err := sp.GetRepo().Users().Delete(sp.Context(), users[3].ID, users[3].Version)
 	require.NoError(t, err)
 
 	type testCase struct {
@@ -184,3 +252,42 @@ func TestUserSearch(t *testing.T) {
 		})
 	}
 }
+
+func TestUserCountAndEach(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	users := suite_factory.NewUserFactory().Builds(3)
+	for _, user := range users {
+		err := sp.GetRepo().Users().Create(sp.Context(), user)
+		require.NoError(t, err)
+	}
+
+	err := sp.GetRepo().Users().Delete(sp.Context(), users[2].ID, users[2].Version)
+	require.NoError(t, err)
+
+	filter := &repository.UserFilter{
+		// Limit игнорируется при подсчете и построчном чтении
+		Limit: utils.Ptr(1),
+	}
+
+	count, err := sp.GetRepo().Users().Count(sp.Context(), filter)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	ids := []int{}
+	err = sp.GetRepo().Users().Each(sp.Context(), filter, func(user *repository.User) error {
+		ids = append(ids, user.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{users[0].ID, users[1].ID}, ids)
+
+	errStop := errors.New("stop")
+	err = sp.GetRepo().Users().Each(sp.Context(), &repository.UserFilter{}, func(_ *repository.User) error {
+		return errStop
+	})
+	require.ErrorIs(t, err, errStop)
+}
@@ -2,17 +2,21 @@ package service_provider
 
 import (
 	"boilerplate/internal/model"
-	model_mocks "boilerplate/internal/model/mocks"
 	"boilerplate/internal/pkg/clients/chrome"
 	"boilerplate/internal/pkg/clients/mail"
 	"boilerplate/internal/pkg/clients/s3"
+	"boilerplate/internal/pkg/lock"
+	"boilerplate/internal/pkg/schema_registry"
 )
 
 type clients struct {
-	s3Client     s3.Client
-	chromeClient chrome.Client
-	brokerClient model.BrokerClient
-	mailClient   mail.Client
+	s3Client       s3.Client
+	chromeClient   chrome.Client
+	brokerClient   model.BrokerClient
+	mailClient     mail.Client
+	locker         lock.Locker
+	elector        lock.LeaderElector
+	schemaRegistry schema_registry.Registry
 }
 
 func (p *Provider) GetS3Client() s3.Client {
@@ -24,9 +28,6 @@ func (p *Provider) GetChromeClient() chrome.Client {
 }
 
 func (p *Provider) GetBrokerClient() model.BrokerClient {
-	if p.clients.brokerClient == nil {
-		p.clients.brokerClient = &model_mocks.BrokerClient{}
-	}
 	return p.clients.brokerClient
 }
 
@@ -38,3 +39,20 @@ func (p *Provider) GetMailClient() mail.Client {
 	}
 	return p.clients.mailClient
 }
+
+func (p *Provider) GetLocker() lock.Locker {
+	return p.clients.locker
+}
+
+func (p *Provider) GetLeaderElector() lock.LeaderElector {
+	if p.clients.elector == nil {
+		p.clients.elector = lock.NewLeaderElector(
+			p.GetLogger(),
+			p.GetLocker())
+	}
+	return p.clients.elector
+}
+
+func (p *Provider) GetSchemaRegistry() schema_registry.Registry {
+	return p.clients.schemaRegistry
+}
@@ -4,7 +4,10 @@ import (
 	"boilerplate/internal/model"
 	"boilerplate/internal/pkg/clients/chrome"
 	"boilerplate/internal/pkg/clients/s3"
+	"boilerplate/internal/pkg/lock"
 	logger_pkg "boilerplate/internal/pkg/logger"
+	"boilerplate/internal/pkg/schema"
+	"boilerplate/internal/pkg/schema_registry"
 	"boilerplate/internal/repository"
 )
 
@@ -14,8 +17,11 @@ type Provider struct {
 	repo     repository.Repo
 	clients  clients
 	services services
+
+	attributesValidator schema.Validator
 }
 
+// nolint: revive
 func NewProvider(
 	config *model.Config,
 	logger logger_pkg.Logger,
@@ -23,16 +29,22 @@ func NewProvider(
 	s3Client s3.Client,
 	chromeClient chrome.Client,
 	brokerClient model.BrokerClient,
+	locker lock.Locker,
+	schemaRegistry schema_registry.Registry,
+	attributesValidator schema.Validator,
 ) *Provider {
 	return &Provider{
 		config: config,
 		logger: logger,
 		repo:   repo,
 		clients: clients{
-			s3Client:     s3Client,
-			chromeClient: chromeClient,
-			brokerClient: brokerClient,
+			s3Client:       s3Client,
+			chromeClient:   chromeClient,
+			brokerClient:   brokerClient,
+			locker:         locker,
+			schemaRegistry: schemaRegistry,
 		},
+		attributesValidator: attributesValidator,
 	}
 }
 
@@ -2,12 +2,30 @@ package service_provider
 
 import (
 	"boilerplate/internal/services/auth"
+	"boilerplate/internal/services/broker"
+	"boilerplate/internal/services/groups"
+	"boilerplate/internal/services/inbox"
+	"boilerplate/internal/services/notifications"
+	"boilerplate/internal/services/outbox"
+	"boilerplate/internal/services/preferences"
+	"boilerplate/internal/services/scheduler"
+	"boilerplate/internal/services/user_exports"
+	"boilerplate/internal/services/user_imports"
 	"boilerplate/internal/services/users"
 )
 
 type services struct {
-	auth  auth.Service
-	users users.Service
+	auth          auth.Service
+	users         users.Service
+	userImports   user_imports.Service
+	userExports   user_exports.Service
+	preferences   preferences.Service
+	groups        groups.Service
+	outbox        outbox.Service
+	notifications notifications.Service
+	broker        broker.Service
+	inbox         inbox.Service
+	scheduler     scheduler.Service
 }
 
 func (p *Provider) GetAuthService() auth.Service {
@@ -15,6 +33,7 @@ func (p *Provider) GetAuthService() auth.Service {
 		p.services.auth = auth.NewService(
 			&p.config.API,
 			p.GetUsersService(),
+			p.GetPreferencesService(),
 		)
 	}
 	return p.services.auth
@@ -22,7 +41,103 @@ func (p *Provider) GetAuthService() auth.Service {
 
 func (p *Provider) GetUsersService() users.Service {
 	if p.services.users == nil {
-		p.services.users = users.NewService(p.repo)
+		p.services.users = users.NewService(
+			&p.config.Users,
+			p.repo,
+			p.attributesValidator,
+			p.GetMailClient(),
+		)
 	}
 	return p.services.users
 }
+
+func (p *Provider) GetUserImportsService() user_imports.Service {
+	if p.services.userImports == nil {
+		p.services.userImports = user_imports.NewService(
+			p.repo,
+			p.GetS3Client(),
+		)
+	}
+	return p.services.userImports
+}
+
+func (p *Provider) GetUserExportsService() user_exports.Service {
+	if p.services.userExports == nil {
+		p.services.userExports = user_exports.NewService(
+			p.repo,
+			p.GetS3Client(),
+			p.GetChromeClient(),
+		)
+	}
+	return p.services.userExports
+}
+
+func (p *Provider) GetPreferencesService() preferences.Service {
+	if p.services.preferences == nil {
+		p.services.preferences = preferences.NewService(
+			p.repo,
+		)
+	}
+	return p.services.preferences
+}
+
+func (p *Provider) GetGroupsService() groups.Service {
+	if p.services.groups == nil {
+		p.services.groups = groups.NewService(
+			p.repo,
+		)
+	}
+	return p.services.groups
+}
+
+func (p *Provider) GetOutboxService() outbox.Service {
+	if p.services.outbox == nil {
+		p.services.outbox = outbox.NewService(
+			&p.config.Outbox,
+			p.repo,
+			p.GetBrokerClient(),
+		)
+	}
+	return p.services.outbox
+}
+
+func (p *Provider) GetNotificationsService() notifications.Service {
+	if p.services.notifications == nil {
+		p.services.notifications = notifications.NewService(
+			p.repo,
+			p.GetMailClient(),
+			p.GetPreferencesService(),
+		)
+	}
+	return p.services.notifications
+}
+
+func (p *Provider) GetBrokerService() broker.Service {
+	if p.services.broker == nil {
+		p.services.broker = broker.NewService(
+			p.GetBrokerClient(),
+			p.GetSchemaRegistry(),
+		)
+	}
+	return p.services.broker
+}
+
+func (p *Provider) GetInboxService() inbox.Service {
+	if p.services.inbox == nil {
+		p.services.inbox = inbox.NewService(
+			&p.config.Inbox,
+			p.repo,
+		)
+	}
+	return p.services.inbox
+}
+
+func (p *Provider) GetSchedulerService() scheduler.Service {
+	if p.services.scheduler == nil {
+		p.services.scheduler = scheduler.NewService(
+			p.logger.With("service", "scheduler"),
+			p.repo,
+		)
+	}
+	return p.services.scheduler
+}
@@ -94,7 +94,7 @@ func TestLoginCorrectPassword(t *testing.T) {
 	require.True(t, exists)
 	require.Equal(t, createdUser.Name, userName)
 
-	err = sp.GetUserService().Delete(sp.Context(), createdUser.ID)
+	err = sp.GetUserService().Delete(sp.Context(), &users.UserDeleteRequest{ID: createdUser.ID})
 	require.NoError(t, err)
 
 	res, err = sp.GetAuthService().Login(sp.Context(), &auth.AuthLoginRequest{
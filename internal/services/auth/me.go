@@ -4,14 +4,14 @@ import (
 	"context"
 
 	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
 	"boilerplate/internal/pkg/metadata"
-	users_service "boilerplate/internal/services/users"
 )
 
-func (s *service) Me(ctx context.Context) (*users_service.User, error) {
+func (s *service) Me(ctx context.Context) (*AuthMeResponse, error) {
 	userID, exists := metadata.GetUserID(ctx)
 	if !exists {
-		return nil, errors_pkg.NewUnauthorizedError("Не авторизованы")
+		return nil, errors_pkg.NewUnauthorizedError(i18n.T(ctx, i18n.KeyUnauthorized))
 	}
 
 	user, err := s.usersService.Get(ctx, userID)
@@ -19,5 +19,13 @@ func (s *service) Me(ctx context.Context) (*users_service.User, error) {
 		return nil, err
 	}
 
-	return user, nil
+	prefs, err := s.preferencesService.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthMeResponse{
+		User:        user,
+		Preferences: prefs,
+	}, nil
 }
@@ -9,6 +9,7 @@ import (
 
 	errors_pkg "boilerplate/internal/pkg/errors"
 	gin_pkg "boilerplate/internal/pkg/gin"
+	"boilerplate/internal/pkg/i18n"
 	suite_factory "boilerplate/internal/pkg/suite/factory"
 	suite_provider "boilerplate/internal/pkg/suite/provider"
 )
@@ -41,7 +42,9 @@ func TestMe_CorrectUser(t *testing.T) {
 	res, err := sp.GetAuthService().Me(gCtx)
 	require.NoError(t, err)
 	require.NotNil(t, res)
-	require.Equal(t, user.ID, res.ID)
-	require.Equal(t, user.Name, res.Name)
-	require.Equal(t, user.Email, res.Email)
+	require.Equal(t, user.ID, res.User.ID)
+	require.Equal(t, user.Name, res.User.Name)
+	require.Equal(t, user.Email, res.User.Email)
+	require.NotNil(t, res.Preferences)
+	require.Equal(t, i18n.DefaultLocale, res.Preferences.Locale)
 }
@@ -1,6 +1,9 @@
 package auth
 
-import "boilerplate/internal/services/users"
+import (
+	"boilerplate/internal/services/preferences"
+	"boilerplate/internal/services/users"
+)
 
 type AuthLoginRequest struct {
 	Email    string `json:"email"`
@@ -13,6 +16,11 @@ type AuthLoginResponse struct {
 	User         *users.User `json:"user"`
 }
 
+type AuthMeResponse struct {
+	User        *users.User              `json:"user"`
+	Preferences *preferences.Preferences `json:"preferences"`
+}
+
 type AuthRefreshRequest struct {
 	RefreshToken string `json:"refresh_token"`
 }
@@ -30,6 +38,7 @@ type AuthValidateRequest struct {
 type AuthValidateResponse struct {
 	UserID       *int    `json:"user_id"`
 	UserName     *string `json:"user_name"`
+	IsAdmin      bool    `json:"is_admin"`
 	AccessToken  *string `json:"access_token"`
 	RefreshToken *string `json:"refresh_token"`
 }
@@ -62,7 +62,7 @@ func TestRefreshCorrectToken(t *testing.T) {
 	require.NotEmpty(t, res.AccessToken)
 	require.NotEmpty(t, res.RefreshToken)
 
-	err = sp.GetUserService().Delete(sp.Context(), createdUser.ID)
+	err = sp.GetUserService().Delete(sp.Context(), &users.UserDeleteRequest{ID: createdUser.ID})
 	require.NoError(t, err)
 
 	res, err = sp.GetAuthService().Refresh(sp.Context(), &auth.AuthRefreshRequest{
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"boilerplate/internal/model"
+	"boilerplate/internal/services/preferences"
 	"boilerplate/internal/services/users"
 )
 
@@ -11,21 +12,24 @@ type Service interface {
 	GetConfig() *model.ConfigAPI
 	Login(ctx context.Context, req *AuthLoginRequest) (*AuthLoginResponse, error)
 	Refresh(ctx context.Context, req *AuthRefreshRequest) (*AuthRefreshResponse, error)
-	Me(ctx context.Context) (*users.User, error)
+	Me(ctx context.Context) (*AuthMeResponse, error)
 	Validate(ctx context.Context, req *AuthValidateRequest) (*AuthValidateResponse, error)
 }
 
 type service struct {
-	config       *model.ConfigAPI
-	usersService users.Service
+	config             *model.ConfigAPI
+	usersService       users.Service
+	preferencesService preferences.Service
 }
 
 func NewService(
 	config *model.ConfigAPI,
 	usersService users.Service,
+	preferencesService preferences.Service,
 ) Service {
 	return &service{
-		config:       config,
-		usersService: usersService,
+		config:             config,
+		usersService:       usersService,
+		preferencesService: preferencesService,
 	}
 }
@@ -40,6 +40,8 @@ func (s *service) Validate(ctx context.Context, req *AuthValidateRequest) (*Auth
 			return nil, errUnauthorized
 		}
 
+		resp.IsAdmin = user.IsAdmin
+
 		return resp, nil
 	}
 
@@ -73,6 +75,8 @@ func (s *service) Validate(ctx context.Context, req *AuthValidateRequest) (*Auth
 		return nil, errUnauthorized
 	}
 
+	resp.IsAdmin = user.IsAdmin
+
 	newAccessToken, err := jwt_pkg.GenerateAccessToken(user.ID, user.Name, s.config)
 	if err != nil {
 		return nil, fmt.Errorf("create access token: %w", err)
@@ -67,6 +67,7 @@ func TestValidateCorrectTokens(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.NotNil(t, res)
+	require.False(t, res.IsAdmin)
 
 	res, err = sp.GetAuthService().Validate(sp.Context(), &auth.AuthValidateRequest{
 		RefreshToken: utils.Ptr(loginRes.RefreshToken),
@@ -74,7 +75,7 @@ func TestValidateCorrectTokens(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	err = sp.GetUserService().Delete(sp.Context(), createdUser.ID)
+	err = sp.GetUserService().Delete(sp.Context(), &users.UserDeleteRequest{ID: createdUser.ID})
 	require.NoError(t, err)
 
 	res, err = sp.GetAuthService().Validate(sp.Context(), &auth.AuthValidateRequest{
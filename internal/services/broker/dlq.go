@@ -0,0 +1,108 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"boilerplate/internal/model"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/topics"
+)
+
+// dlqHeaders заголовки, добавленные при перемещении в DLQ
+var dlqHeaders = []string{
+	model.BrokerHeaderDLQSubject,
+	model.BrokerHeaderDLQConsumer,
+	model.BrokerHeaderDLQError,
+	model.BrokerHeaderDLQAttempts,
+	model.BrokerHeaderDLQFailedAt,
+}
+
+func checkDLQTopic(ctx context.Context, topic string) error {
+	if !topics.IsDLQ(topic) {
+		return errors_pkg.NewNotFoundError(i18n.T(ctx, i18n.KeyDLQTopicUnknown, topic))
+	}
+	return nil
+}
+
+func (s *service) getDLQMessage(ctx context.Context, topic string, id uint64) (*DLQMessage, error) {
+	message, err := s.brokerClient.GetMessage(ctx, topic, id)
+	if err != nil {
+		if errors.Is(err, model.ErrBrokerMessageNotFound) {
+			return nil, errors_pkg.NewNotFoundError(i18n.T(ctx, i18n.KeyDLQMessageNotFound, id, topic))
+		}
+		return nil, fmt.Errorf("get message: %w", err)
+	}
+
+	return toDLQMessage(topic, message), nil
+}
+
+// scan обходит сообщения топика с номерами больше afterID, пока fn возвращает true
+func (s *service) scan(ctx context.Context, topic string, afterID uint64, fn func(*DLQMessage) (bool, error)) error {
+	from := afterID + 1
+	for {
+		messages, err := s.brokerClient.GetMessages(ctx, topic, from, scanBatchSize)
+		if err != nil {
+			return fmt.Errorf("get messages: %w", err)
+		}
+
+		for _, message := range messages {
+			next, err := fn(toDLQMessage(topic, message))
+			if err != nil || !next {
+				return err
+			}
+		}
+
+		if len(messages) < scanBatchSize {
+			return nil
+		}
+		from = messages[len(messages)-1].ID + 1
+	}
+}
+
+// lastID возвращает номер последнего сообщения топика или 0, если топик пуст
+func (s *service) lastID(ctx context.Context, topic string) (uint64, error) {
+	stats, err := s.brokerClient.GetStreamStats(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get stream stats: %w", err)
+	}
+
+	for _, stat := range stats {
+		if stat.Topic == topic {
+			return stat.LastID, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// replay публикует сообщение в исходный subject без заголовков DLQ и удаляет
+// его из DLQ. Сообщение без исходного subject не повторяется
+func (s *service) replay(ctx context.Context, message *DLQMessage) (bool, error) {
+	if message.Subject == "" {
+		return false, nil
+	}
+
+	headers := maps.Clone(message.message.Headers)
+	for _, name := range dlqHeaders {
+		delete(headers, name)
+	}
+
+	err := s.brokerClient.PublishMessage(ctx, &model.BrokerMessage{
+		Subject: message.Subject,
+		Headers: headers,
+		Data:    message.Data,
+	})
+	if err != nil {
+		return false, fmt.Errorf("publish message %d: %w", message.ID, err)
+	}
+
+	if err := s.brokerClient.DeleteMessage(ctx, message.Topic, message.ID); err != nil {
+		return false, fmt.Errorf("delete message %d: %w", message.ID, err)
+	}
+
+	return true, nil
+}
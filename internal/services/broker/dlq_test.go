@@ -0,0 +1,248 @@
+package broker_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/cloudevents"
+	"boilerplate/internal/pkg/convert"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/services/broker"
+	"boilerplate/internal/topics"
+	"boilerplate/pkg/pb/events"
+)
+
+const consumerName = "test-consumer"
+
+// dlqFixture консьюмер топика user-created, который не обрабатывает сообщения,
+// пока fail установлен
+type dlqFixture struct {
+	sp       *suite_provider.Provider
+	client   model.BrokerClient
+	service  broker.Service
+	fail     atomic.Bool
+	received chan *events.UserCreated
+}
+
+func newDLQFixture(t *testing.T) *dlqFixture {
+	t.Helper()
+
+	sp, cleanup := suite_provider.NewProvider()
+	t.Cleanup(cleanup)
+
+	return newDLQFixtureWithClient(t, sp, sp.GetNatsClient())
+}
+
+// newMemoryDLQFixture фикстура на брокере в памяти, который обрабатывает
+// сообщение и перемещает его в DLQ синхронно при публикации
+func newMemoryDLQFixture(t *testing.T) *dlqFixture {
+	t.Helper()
+
+	sp, cleanup := suite_provider.NewProvider()
+	t.Cleanup(cleanup)
+
+	return newDLQFixtureWithClient(t, sp, sp.GetBrokerClient())
+}
+
+func newDLQFixtureWithClient(t *testing.T, sp *suite_provider.Provider, client model.BrokerClient) *dlqFixture {
+	t.Helper()
+
+	f := &dlqFixture{
+		sp:       sp,
+		client:   client,
+		received: make(chan *events.UserCreated, 10),
+	}
+	f.service = broker.NewService(f.client, sp.GetSchemaRegistry())
+	f.fail.Store(true)
+
+	require.NoError(t, topics.CreateOrUpdateTopics(sp.Context(), f.client))
+
+	topic := topics.Topics[topics.TopicUserCreated]
+	topic.Retry.InitialDelay = 10 * time.Millisecond
+
+	err := cloudevents.Subscribe(sp.Context(), f.client, consumerName, "", topic, func(_ context.Context, _ string, data *events.UserCreated) error {
+		if f.fail.Load() {
+			return errors.New("user " + strconv.FormatInt(data.GetUserId(), 10) + " failed\nwith details")
+		}
+		f.received <- data
+		return nil
+	})
+	require.NoError(t, err)
+
+	return f
+}
+
+func (f *dlqFixture) publish(t *testing.T, userID int) {
+	t.Helper()
+
+	key := strconv.Itoa(userID)
+	err := f.client.Publish(f.sp.Context(), topics.TopicUserCreated, nil, key,
+		&events.UserCreated{UserId: convert.ToInt64(userID)}, model.WithEventID("event-"+key))
+	require.NoError(t, err)
+}
+
+// waitDLQ ждет, пока в DLQ окажется count сообщений
+func (f *dlqFixture) waitDLQ(t *testing.T, count int) []*broker.DLQMessage {
+	t.Helper()
+
+	var messages []*broker.DLQMessage
+	require.Eventually(t, func() bool {
+		resp, err := f.service.ListDLQMessages(f.sp.Context(), &broker.DLQListRequest{Topic: topics.TopicUserCreatedDLQ})
+		require.NoError(t, err)
+		messages = resp.Result
+		return len(messages) == count
+	}, 10*time.Second, 20*time.Millisecond)
+
+	return messages
+}
+
+func TestDLQ(t *testing.T) {
+	t.Parallel()
+
+	f := newDLQFixture(t)
+	ctx := f.sp.Context()
+
+	f.publish(t, 1)
+	f.publish(t, 2)
+
+	messages := f.waitDLQ(t, 2)
+
+	byUser := map[string]*broker.DLQMessage{}
+	for _, message := range messages {
+		byUser[message.Headers["X-Key"]] = message
+	}
+
+	first := byUser["1"]
+	require.NotNil(t, first)
+	require.Equal(t, topics.TopicUserCreatedDLQ, first.Topic)
+	require.Equal(t, topics.TopicUserCreated+".p"+strconv.Itoa(*topics.Partition(topics.TopicUserCreated, "1")), first.Subject)
+	require.Contains(t, first.Consumer, consumerName)
+	require.Equal(t, "user 1 failed with details", first.Error)
+	require.Equal(t, topics.Topics[topics.TopicUserCreated].Retry.MaxAttempts, first.Attempts)
+	require.NotZero(t, first.FailedAt)
+	require.Equal(t, "event-1", first.Headers["ce-id"])
+	require.Equal(t, "events.UserCreated", first.Headers["ce-type"])
+
+	// Фильтр по подстроке ошибки
+	resp, err := f.service.ListDLQMessages(ctx, &broker.DLQListRequest{
+		Topic:  topics.TopicUserCreatedDLQ,
+		Filter: broker.DLQFilter{Error: utils.Ptr("user 2")},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Result, 1)
+	require.Equal(t, byUser["2"].ID, resp.Result[0].ID)
+
+	// Постраничный обход
+	resp, err = f.service.ListDLQMessages(ctx, &broker.DLQListRequest{
+		Topic:   topics.TopicUserCreatedDLQ,
+		AfterID: utils.Ptr(messages[0].ID),
+		Limit:   utils.Ptr(10),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Result, 1)
+	require.Equal(t, messages[1].ID, resp.Result[0].ID)
+
+	got, err := f.service.GetDLQMessage(ctx, topics.TopicUserCreatedDLQ, first.ID)
+	require.NoError(t, err)
+	require.Equal(t, first.Error, got.Error)
+
+	_, err = f.service.GetDLQMessage(ctx, topics.TopicUserCreatedDLQ, 1000)
+	require.True(t, errors_pkg.IsErrNotFound(err))
+
+	// Только DLQ-топики
+	_, err = f.service.ListDLQMessages(ctx, &broker.DLQListRequest{Topic: topics.TopicUserCreated})
+	require.True(t, errors_pkg.IsErrNotFound(err))
+
+	_, err = f.service.ReplayDLQMessages(ctx, &broker.DLQReplayRequest{Topic: topics.TopicUserCreatedDLQ})
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+
+	_, err = f.service.ReplayDLQMessages(ctx, &broker.DLQReplayRequest{Topic: topics.TopicUserCreatedDLQ, IDs: []uint64{first.ID, 1000}})
+	require.True(t, errors_pkg.IsErrNotFound(err))
+
+	// Повтор по номеру возвращает исходное событие в основной топик
+	f.fail.Store(false)
+
+	replayResp, err := f.service.ReplayDLQMessages(ctx, &broker.DLQReplayRequest{
+		Topic: topics.TopicUserCreatedDLQ,
+		IDs:   []uint64{first.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{first.ID}, replayResp.Replayed)
+	require.Empty(t, replayResp.Skipped)
+
+	select {
+	case data := <-f.received:
+		require.Equal(t, int64(1), data.GetUserId())
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "replayed message not received")
+	}
+
+	f.waitDLQ(t, 1)
+
+	replayResp, err = f.service.ReplayDLQMessages(ctx, &broker.DLQReplayRequest{
+		Topic: topics.TopicUserCreatedDLQ,
+		All:   true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{byUser["2"].ID}, replayResp.Replayed)
+
+	select {
+	case data := <-f.received:
+		require.Equal(t, int64(2), data.GetUserId())
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "replayed message not received")
+	}
+
+	f.waitDLQ(t, 0)
+}
+
+func TestReplayDLQMessagesFailedAgain(t *testing.T) {
+	t.Parallel()
+
+	f := newMemoryDLQFixture(t)
+
+	// Полная порция обхода DLQ: после нее обход читает следующую, в которую
+	// попадают повторенные сообщения
+	const count = 100
+	for userID := 1; userID <= count; userID++ {
+		f.publish(t, userID)
+	}
+	messages := f.waitDLQ(t, count)
+
+	// Консьюмер по-прежнему не обрабатывает сообщения, поэтому каждое
+	// повторенное сообщение сразу возвращается в конец DLQ
+	resp, err := f.service.ReplayDLQMessages(f.sp.Context(), &broker.DLQReplayRequest{
+		Topic: topics.TopicUserCreatedDLQ,
+		All:   true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Replayed, count)
+
+	again := f.waitDLQ(t, count)
+	require.Greater(t, again[0].ID, messages[count-1].ID)
+}
+
+func TestPurgeDLQ(t *testing.T) {
+	t.Parallel()
+
+	f := newDLQFixture(t)
+
+	f.publish(t, 1)
+	f.publish(t, 2)
+	f.waitDLQ(t, 2)
+
+	purged, err := f.service.PurgeDLQ(f.sp.Context(), topics.TopicUserCreatedDLQ)
+	require.NoError(t, err)
+	require.Equal(t, 2, purged)
+
+	f.waitDLQ(t, 0)
+}
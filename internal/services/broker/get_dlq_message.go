@@ -0,0 +1,13 @@
+package broker
+
+import (
+	"context"
+)
+
+func (s *service) GetDLQMessage(ctx context.Context, topic string, id uint64) (*DLQMessage, error) {
+	if err := checkDLQTopic(ctx, topic); err != nil {
+		return nil, err
+	}
+
+	return s.getDLQMessage(ctx, topic, id)
+}
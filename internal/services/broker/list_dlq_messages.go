@@ -0,0 +1,33 @@
+package broker
+
+import (
+	"context"
+)
+
+func (s *service) ListDLQMessages(ctx context.Context, req *DLQListRequest) (*DLQListResponse, error) {
+	if err := checkDLQTopic(ctx, req.Topic); err != nil {
+		return nil, err
+	}
+
+	var afterID uint64
+	if req.AfterID != nil {
+		afterID = *req.AfterID
+	}
+	limit := limitOrDefault(req.Limit)
+
+	resp := &DLQListResponse{
+		Result: []*DLQMessage{},
+	}
+
+	err := s.scan(ctx, req.Topic, afterID, func(message *DLQMessage) (bool, error) {
+		if req.Filter.match(message) {
+			resp.Result = append(resp.Result, message)
+		}
+		return len(resp.Result) < limit, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,114 @@
+package broker
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"boilerplate/internal/model"
+)
+
+const (
+	// defaultLimit размер страницы, если лимит не указан
+	defaultLimit = 100
+	// scanBatchSize сколько сообщений читается из топика за один запрос
+	scanBatchSize = 100
+)
+
+// DLQMessage сообщение, перемещенное в DLQ после исчерпания попыток обработки
+type DLQMessage struct {
+	ID    uint64 `json:"id"`
+	Topic string `json:"topic"`
+	// Subject исходный subject сообщения, в который оно возвращается при повторе.
+	// Пустой для сообщений, перемещенных в DLQ без него
+	Subject  string            `json:"subject"`
+	Consumer string            `json:"consumer"`
+	Error    string            `json:"error"`
+	Attempts int               `json:"attempts"`
+	FailedAt time.Time         `json:"failed_at"`
+	Headers  map[string]string `json:"headers"`
+	Data     []byte            `json:"data"`
+
+	message *model.BrokerMessage
+}
+
+// DLQFilter отбирает сообщения DLQ. Пустой фильтр подходит всем сообщениям
+type DLQFilter struct {
+	Subject  *string `json:"subject"`
+	Consumer *string `json:"consumer"`
+	// Error подстрока текста ошибки
+	Error *string `json:"error"`
+}
+
+type DLQListRequest struct {
+	Topic  string    `json:"-"`
+	Filter DLQFilter `json:"filter"`
+	// AfterID возвращает сообщения с номерами больше указанного
+	AfterID *uint64 `json:"after_id"`
+	Limit   *int    `json:"limit"`
+}
+
+type DLQListResponse struct {
+	Result []*DLQMessage `json:"messages"`
+}
+
+// DLQReplayRequest выбирает сообщения для повтора: по номерам, по фильтру или все
+type DLQReplayRequest struct {
+	Topic  string     `json:"-"`
+	IDs    []uint64   `json:"ids"`
+	Filter *DLQFilter `json:"filter"`
+	All    bool       `json:"all"`
+}
+
+type DLQReplayResponse struct {
+	Replayed []uint64 `json:"replayed"`
+	// Skipped сообщения без исходного subject, которые остались в DLQ
+	Skipped []uint64 `json:"skipped"`
+}
+
+func (f *DLQFilter) match(message *DLQMessage) bool {
+	if f.Subject != nil && message.Subject != *f.Subject {
+		return false
+	}
+	if f.Consumer != nil && message.Consumer != *f.Consumer {
+		return false
+	}
+	if f.Error != nil && !strings.Contains(message.Error, *f.Error) {
+		return false
+	}
+	return true
+}
+
+func toDLQMessage(topic string, message *model.BrokerMessage) *DLQMessage {
+	res := &DLQMessage{
+		ID:       message.ID,
+		Topic:    topic,
+		Subject:  message.Header(model.BrokerHeaderDLQSubject),
+		Consumer: message.Header(model.BrokerHeaderDLQConsumer),
+		Error:    message.Header(model.BrokerHeaderDLQError),
+		FailedAt: message.Time,
+		Headers:  make(map[string]string, len(message.Headers)),
+		Data:     message.Data,
+		message:  message,
+	}
+
+	if attempts, err := strconv.Atoi(message.Header(model.BrokerHeaderDLQAttempts)); err == nil {
+		res.Attempts = attempts
+	}
+	if failedAt, err := time.Parse(time.RFC3339Nano, message.Header(model.BrokerHeaderDLQFailedAt)); err == nil {
+		res.FailedAt = failedAt
+	}
+
+	for name, values := range message.Headers {
+		res.Headers[name] = strings.Join(values, ", ")
+	}
+
+	return res
+}
+
+func limitOrDefault(limit *int) int {
+	if limit == nil {
+		return defaultLimit
+	}
+	return *limit
+}
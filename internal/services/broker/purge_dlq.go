@@ -0,0 +1,19 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *service) PurgeDLQ(ctx context.Context, topic string) (int, error) {
+	if err := checkDLQTopic(ctx, topic); err != nil {
+		return 0, err
+	}
+
+	purged, err := s.brokerClient.PurgeTopic(ctx, topic)
+	if err != nil {
+		return 0, fmt.Errorf("purge topic: %w", err)
+	}
+
+	return purged, nil
+}
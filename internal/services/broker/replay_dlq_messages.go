@@ -0,0 +1,96 @@
+package broker
+
+import (
+	"context"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+)
+
+func (s *service) ReplayDLQMessages(ctx context.Context, req *DLQReplayRequest) (*DLQReplayResponse, error) {
+	if err := checkDLQTopic(ctx, req.Topic); err != nil {
+		return nil, err
+	}
+
+	targets := 0
+	if len(req.IDs) > 0 {
+		targets++
+	}
+	if req.Filter != nil {
+		targets++
+	}
+	if req.All {
+		targets++
+	}
+	if targets != 1 {
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyDLQReplayTarget))
+	}
+
+	resp := &DLQReplayResponse{
+		Replayed: []uint64{},
+		Skipped:  []uint64{},
+	}
+
+	replay := func(message *DLQMessage) (bool, error) {
+		replayed, err := s.replay(ctx, message)
+		if err != nil {
+			return false, err
+		}
+
+		if replayed {
+			resp.Replayed = append(resp.Replayed, message.ID)
+		} else {
+			resp.Skipped = append(resp.Skipped, message.ID)
+		}
+		return true, nil
+	}
+
+	if len(req.IDs) > 0 {
+		// Все сообщения проверяются до повтора, чтобы неверный номер не
+		// приводил к частичному повтору
+		messages := make([]*DLQMessage, 0, len(req.IDs))
+		for _, id := range req.IDs {
+			message, err := s.getDLQMessage(ctx, req.Topic, id)
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, message)
+		}
+
+		for _, message := range messages {
+			if _, err := replay(message); err != nil {
+				return nil, err
+			}
+		}
+
+		return resp, nil
+	}
+
+	filter := &DLQFilter{}
+	if req.Filter != nil {
+		filter = req.Filter
+	}
+
+	// Повторенное сообщение, которое снова не обработано, возвращается в конец
+	// DLQ. Обход ограничен сообщениями, сохраненными до начала повтора, иначе
+	// он никогда не закончится
+	lastID, err := s.lastID(ctx, req.Topic)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.scan(ctx, req.Topic, 0, func(message *DLQMessage) (bool, error) {
+		if message.ID > lastID {
+			return false, nil
+		}
+		if !filter.match(message) {
+			return true, nil
+		}
+		return replay(message)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
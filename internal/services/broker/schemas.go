@@ -0,0 +1,37 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/pkg/schema_registry"
+)
+
+func (s *service) ListSchemas(ctx context.Context, topic *string) ([]*schema_registry.Schema, error) {
+	name := ""
+	if topic != nil {
+		name = *topic
+	}
+
+	schemas, err := s.schemaRegistry.List(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("list schemas: %w", err)
+	}
+
+	return schemas, nil
+}
+
+func (s *service) GetSchema(ctx context.Context, topic string, version int) (*schema_registry.Schema, error) {
+	schema, err := s.schemaRegistry.Get(ctx, topic, version)
+	if err != nil {
+		if errors.Is(err, schema_registry.ErrSchemaNotFound) {
+			return nil, errors_pkg.NewNotFoundError(i18n.T(ctx, i18n.KeySchemaNotFound, version, topic))
+		}
+		return nil, fmt.Errorf("get schema: %w", err)
+	}
+
+	return schema, nil
+}
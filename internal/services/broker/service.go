@@ -0,0 +1,42 @@
+package broker
+
+import (
+	"context"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/schema_registry"
+)
+
+type Service interface {
+	// ListDLQMessages возвращает сообщения DLQ-топика по возрастанию номера
+	ListDLQMessages(ctx context.Context, req *DLQListRequest) (*DLQListResponse, error)
+	GetDLQMessage(ctx context.Context, topic string, id uint64) (*DLQMessage, error)
+	// ReplayDLQMessages публикует сообщения DLQ-топика в исходный subject и
+	// удаляет их из DLQ
+	ReplayDLQMessages(ctx context.Context, req *DLQReplayRequest) (*DLQReplayResponse, error)
+	// PurgeDLQ удаляет все сообщения DLQ-топика и возвращает их количество
+	PurgeDLQ(ctx context.Context, topic string) (int, error)
+	// GetStreamStats возвращает состояние топиков приложения
+	GetStreamStats(ctx context.Context) ([]*model.BrokerStreamStats, error)
+	// GetConsumerStats возвращает состояние консьюмеров партиций приложения
+	GetConsumerStats(ctx context.Context) ([]*model.BrokerConsumerStats, error)
+	// ListSchemas возвращает версии схем событий топика, а если topic не
+	// задан - схемы всех топиков
+	ListSchemas(ctx context.Context, topic *string) ([]*schema_registry.Schema, error)
+	GetSchema(ctx context.Context, topic string, version int) (*schema_registry.Schema, error)
+}
+
+type service struct {
+	brokerClient   model.BrokerClient
+	schemaRegistry schema_registry.Registry
+}
+
+func NewService(
+	brokerClient model.BrokerClient,
+	schemaRegistry schema_registry.Registry,
+) Service {
+	return &service{
+		brokerClient:   brokerClient,
+		schemaRegistry: schemaRegistry,
+	}
+}
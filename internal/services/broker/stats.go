@@ -0,0 +1,26 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+
+	"boilerplate/internal/model"
+)
+
+func (s *service) GetStreamStats(ctx context.Context) ([]*model.BrokerStreamStats, error) {
+	stats, err := s.brokerClient.GetStreamStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get stream stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (s *service) GetConsumerStats(ctx context.Context) ([]*model.BrokerConsumerStats, error) {
+	stats, err := s.brokerClient.GetConsumerStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get consumer stats: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,47 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/db"
+	"boilerplate/internal/pkg/metadata"
+)
+
+func (s *service) AddMembers(ctx context.Context, req *GroupMembersRequest) ([]int, error) {
+	userIDs, err := memberIDs(ctx, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var addedBy *int
+	if userID, exists := metadata.GetUserID(ctx); exists {
+		addedBy = &userID
+	}
+
+	var added []int
+
+	// Событие сохраняется в outbox в транзакции изменения состава группы
+	err = s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+		if _, err := s.getGroup(ctx, req.GroupID); err != nil {
+			return err
+		}
+
+		if err := s.checkUsersExist(ctx, userIDs); err != nil {
+			return err
+		}
+
+		added, err = s.repo.Groups().AddMembers(ctx, req.GroupID, userIDs, addedBy)
+		if err != nil {
+			return fmt.Errorf("add group members: %w", err)
+		}
+
+		return s.addMembersChangedEvent(ctx, req.GroupID, model.GroupMembersActionAdded, added)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
@@ -0,0 +1,37 @@
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/pkg/metadata"
+	"boilerplate/internal/repository"
+)
+
+func (s *service) Create(ctx context.Context, req *GroupCreateRequest) (*Group, error) {
+	group := &repository.Group{
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+	}
+	if group.Name == "" {
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyGroupNameRequired))
+	}
+
+	if userID, exists := metadata.GetUserID(ctx); exists {
+		group.CreatedBy = &userID
+	}
+
+	err := s.repo.Groups().Create(ctx, group)
+	if err != nil {
+		if errors.Is(err, repository.ErrGroupNameExists) {
+			return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyGroupNameExists))
+		}
+		return nil, fmt.Errorf("create group: %w", err)
+	}
+
+	return toGroup(group), nil
+}
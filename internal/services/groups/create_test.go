@@ -0,0 +1,70 @@
+package groups_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/metadata"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/services/groups"
+)
+
+func TestCreateGroup(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	admin := suite_factory.NewUserFactory().WithAdmin().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), admin)
+	require.NoError(t, err)
+
+	ctx := metadata.WithUserID(sp.Context(), admin.ID)
+	name := gofakeit.UUID()
+
+	group, err := sp.GetGroupsService().Create(ctx, &groups.GroupCreateRequest{
+		Name:        " " + name + " ",
+		Description: "Описание",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, group.ID)
+	require.Equal(t, name, group.Name)
+	require.Equal(t, "Описание", group.Description)
+	require.Equal(t, &admin.ID, group.CreatedBy)
+
+	_, err = sp.GetGroupsService().Create(ctx, &groups.GroupCreateRequest{
+		Name: name,
+	})
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+
+	_, err = sp.GetGroupsService().Create(ctx, &groups.GroupCreateRequest{
+		Name: "  ",
+	})
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+
+	updated, err := sp.GetGroupsService().Update(ctx, &groups.GroupUpdateRequest{
+		ID:   group.ID,
+		Name: utils.Ptr(name + "-updated"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, name+"-updated", updated.Name)
+	require.Equal(t, group.Description, updated.Description)
+
+	gotGroup, err := sp.GetGroupsService().Get(ctx, group.ID)
+	require.NoError(t, err)
+	require.Equal(t, updated, gotGroup)
+
+	_, err = sp.GetGroupsService().Get(ctx, -1)
+	require.True(t, errors_pkg.IsErrNotFound(err))
+
+	_, err = sp.GetGroupsService().Update(ctx, &groups.GroupUpdateRequest{
+		ID:   -1,
+		Name: utils.Ptr(name),
+	})
+	require.True(t, errors_pkg.IsErrNotFound(err))
+}
@@ -0,0 +1,31 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/db"
+)
+
+// Delete удаляет группу. Об исключении участников сохраняется событие, как при
+// RemoveMembers
+func (s *service) Delete(ctx context.Context, id int) error {
+	return s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+		if _, err := s.getGroup(ctx, id); err != nil {
+			return err
+		}
+
+		removed, err := s.repo.Groups().RemoveMembers(ctx, id, nil)
+		if err != nil {
+			return fmt.Errorf("remove group members: %w", err)
+		}
+
+		err = s.repo.Groups().Delete(ctx, id)
+		if err != nil {
+			return fmt.Errorf("delete group: %w", err)
+		}
+
+		return s.addMembersChangedEvent(ctx, id, model.GroupMembersActionRemoved, removed)
+	})
+}
@@ -0,0 +1,38 @@
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/repository"
+)
+
+func (s *service) Get(ctx context.Context, id int) (*Group, error) {
+	group, err := s.getGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGroup(group), nil
+}
+
+func (s *service) getGroup(ctx context.Context, id int) (*repository.Group, error) {
+	group, err := s.repo.Groups().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errNotFound(ctx, id)
+		}
+		return nil, fmt.Errorf("get group: %w", err)
+	}
+
+	return group, nil
+}
+
+func errNotFound(ctx context.Context, id int) error {
+	return errors_pkg.NewNotFoundError(i18n.T(ctx, i18n.KeyGroupNotFound, id))
+}
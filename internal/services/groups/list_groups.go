@@ -0,0 +1,31 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+
+	"boilerplate/internal/repository"
+)
+
+func (s *service) ListGroups(ctx context.Context, req *GroupListRequest) (*GroupListResponse, error) {
+	groups, err := s.repo.Groups().Search(ctx, &repository.GroupFilter{
+		Name:     req.Name,
+		MemberID: req.MemberID,
+		Limit:    limitOrDefault(req.Limit),
+		Offset:   req.Offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search groups: %w", err)
+	}
+
+	resp := &GroupListResponse{
+		Result: make([]*Group, 0, len(groups.Result)),
+		Total:  groups.Total,
+	}
+
+	for _, group := range groups.Result {
+		resp.Result = append(resp.Result, toGroup(group))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,28 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *service) ListMembers(ctx context.Context, req *GroupListMembersRequest) (*GroupListMembersResponse, error) {
+	if _, err := s.getGroup(ctx, req.GroupID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.Groups().ListMembers(ctx, req.GroupID, limitOrDefault(req.Limit), req.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("list group members: %w", err)
+	}
+
+	resp := &GroupListMembersResponse{
+		Result: make([]*GroupMember, 0, len(members.Result)),
+		Total:  members.Total,
+	}
+
+	for _, member := range members.Result {
+		resp.Result = append(resp.Result, toGroupMember(member))
+	}
+
+	return resp, nil
+}
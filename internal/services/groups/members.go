@@ -0,0 +1,84 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"boilerplate/internal/model"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/pkg/metadata"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/repository"
+	"boilerplate/internal/topics"
+)
+
+// memberIDs проверяет, что пользователи указаны, и убирает повторы
+func memberIDs(ctx context.Context, userIDs []int) ([]int, error) {
+	if len(userIDs) == 0 {
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyGroupUsersRequired))
+	}
+
+	ids := slices.Clone(userIDs)
+	slices.Sort(ids)
+	return slices.Compact(ids), nil
+}
+
+// checkUsersExist проверяет, что все пользователи существуют и не удалены
+func (s *service) checkUsersExist(ctx context.Context, userIDs []int) error {
+	users, err := s.repo.Users().Search(ctx, &repository.UserFilter{
+		IDs: userIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("search users: %w", err)
+	}
+
+	found := make(map[int]struct{}, len(users.Result))
+	for _, user := range users.Result {
+		found[user.ID] = struct{}{}
+	}
+
+	var missing []int
+	for _, userID := range userIDs {
+		if _, ok := found[userID]; !ok {
+			missing = append(missing, userID)
+		}
+	}
+
+	if len(missing) > 0 {
+		return errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyGroupUsersNotFound, utils.JoinInt(missing, ", ")))
+	}
+
+	return nil
+}
+
+// addMembersChangedEvent сохраняет в outbox событие об изменении состава
+// группы. Вызывается в транзакции изменения, чтобы событие не опередило коммит.
+// События одной группы публикуются с одним ключом и обрабатываются по порядку
+func (s *service) addMembersChangedEvent(ctx context.Context, groupID int, action model.GroupMembersAction, userIDs []int) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	event := &model.GroupMembersChangedEvent{
+		GroupID: groupID,
+		Action:  action,
+		UserIDs: userIDs,
+	}
+	if userID, exists := metadata.GetUserID(ctx); exists {
+		event.ChangedBy = &userID
+	}
+
+	message, err := repository.NewOutboxMessage(topics.TopicGroupMembersChanged, string(model.OutboxAggregateGroup), strconv.Itoa(groupID), event)
+	if err != nil {
+		return fmt.Errorf("new group members changed message: %w", err)
+	}
+
+	if err := s.repo.Outbox().Add(ctx, message); err != nil {
+		return fmt.Errorf("add group members changed message: %w", err)
+	}
+
+	return nil
+}
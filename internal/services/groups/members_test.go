@@ -0,0 +1,175 @@
+package groups_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/metadata"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/services/groups"
+	"boilerplate/internal/topics"
+)
+
+func TestGroupMembers(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	users := suite_factory.NewUserFactory().Builds(3)
+	for _, user := range users {
+		err := sp.GetRepo().Users().Create(sp.Context(), user)
+		require.NoError(t, err)
+	}
+
+	ctx := metadata.WithUserID(sp.Context(), users[0].ID)
+
+	group, err := sp.GetGroupsService().Create(ctx, &groups.GroupCreateRequest{
+		Name: gofakeit.UUID(),
+	})
+	require.NoError(t, err)
+
+	// requireEvents проверяет события, сохраненные в outbox после предыдущей
+	// проверки
+	saved := 0
+	requireEvents := func(want ...*model.GroupMembersChangedEvent) {
+		messages, err := sp.GetRepo().Outbox().List(sp.Context(), string(model.OutboxAggregateGroup), strconv.Itoa(group.ID))
+		require.NoError(t, err)
+		messages = messages[saved:]
+		require.Len(t, messages, len(want))
+		saved += len(messages)
+
+		for i, message := range messages {
+			require.Equal(t, topics.TopicGroupMembersChanged, message.Topic)
+
+			event := &model.GroupMembersChangedEvent{}
+			require.NoError(t, json.Unmarshal(message.Payload, event))
+			require.Equal(t, want[i], event)
+		}
+	}
+	event := func(action model.GroupMembersAction, userIDs ...int) *model.GroupMembersChangedEvent {
+		return &model.GroupMembersChangedEvent{
+			GroupID:   group.ID,
+			Action:    action,
+			UserIDs:   userIDs,
+			ChangedBy: &users[0].ID,
+		}
+	}
+
+	added, err := sp.GetGroupsService().AddMembers(ctx, &groups.GroupMembersRequest{
+		GroupID: group.ID,
+		UserIDs: []int{users[1].ID, users[0].ID, users[1].ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{users[0].ID, users[1].ID}, added)
+	requireEvents(event(model.GroupMembersActionAdded, users[0].ID, users[1].ID))
+
+	// Пользователи уже в группе: событие не публикуется
+	added, err = sp.GetGroupsService().AddMembers(ctx, &groups.GroupMembersRequest{
+		GroupID: group.ID,
+		UserIDs: []int{users[0].ID},
+	})
+	require.NoError(t, err)
+	require.Empty(t, added)
+	requireEvents()
+
+	_, err = sp.GetGroupsService().AddMembers(ctx, &groups.GroupMembersRequest{
+		GroupID: group.ID,
+		UserIDs: []int{users[2].ID},
+	})
+	require.NoError(t, err)
+	requireEvents(event(model.GroupMembersActionAdded, users[2].ID))
+
+	members, err := sp.GetGroupsService().ListMembers(ctx, &groups.GroupListMembersRequest{
+		GroupID: group.ID,
+		Limit:   utils.Ptr(2),
+		Offset:  utils.Ptr(1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, members.Total)
+	require.Len(t, members.Result, 2)
+	require.Equal(t, users[2].ID, members.Result[1].UserID)
+	require.Equal(t, &users[0].ID, members.Result[1].AddedBy)
+
+	list, err := sp.GetGroupsService().ListGroups(ctx, &groups.GroupListRequest{
+		MemberID: &users[2].ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, list.Total)
+	require.Equal(t, group.ID, list.Result[0].ID)
+
+	removed, err := sp.GetGroupsService().RemoveMembers(ctx, &groups.GroupMembersRequest{
+		GroupID: group.ID,
+		UserIDs: []int{users[2].ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{users[2].ID}, removed)
+	requireEvents(event(model.GroupMembersActionRemoved, users[2].ID))
+
+	list, err = sp.GetGroupsService().ListGroups(ctx, &groups.GroupListRequest{
+		MemberID: &users[2].ID,
+	})
+	require.NoError(t, err)
+	require.Zero(t, list.Total)
+
+	// При удалении группы исключаются все участники
+	err = sp.GetGroupsService().Delete(ctx, group.ID)
+	require.NoError(t, err)
+	requireEvents(event(model.GroupMembersActionRemoved, users[0].ID, users[1].ID))
+
+	_, err = sp.GetGroupsService().Get(ctx, group.ID)
+	require.True(t, errors_pkg.IsErrNotFound(err))
+}
+
+func TestAddGroupMembersValidation(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	deletedUser := suite_factory.NewUserFactory().Build()
+	err = sp.GetRepo().Users().Create(sp.Context(), deletedUser)
+	require.NoError(t, err)
+	err = sp.GetRepo().Users().Delete(sp.Context(), deletedUser.ID, deletedUser.Version)
+	require.NoError(t, err)
+
+	group, err := sp.GetGroupsService().Create(sp.Context(), &groups.GroupCreateRequest{
+		Name: gofakeit.UUID(),
+	})
+	require.NoError(t, err)
+
+	_, err = sp.GetGroupsService().AddMembers(sp.Context(), &groups.GroupMembersRequest{
+		GroupID: group.ID,
+	})
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+
+	_, err = sp.GetGroupsService().AddMembers(sp.Context(), &groups.GroupMembersRequest{
+		GroupID: group.ID,
+		UserIDs: []int{user.ID, deletedUser.ID},
+	})
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+	require.Contains(t, err.Error(), strconv.Itoa(deletedUser.ID))
+
+	_, err = sp.GetGroupsService().AddMembers(sp.Context(), &groups.GroupMembersRequest{
+		GroupID: -1,
+		UserIDs: []int{user.ID},
+	})
+	require.True(t, errors_pkg.IsErrNotFound(err))
+
+	// Ничего не добавлено
+	members, err := sp.GetRepo().Groups().ListMembers(sp.Context(), group.ID, nil, nil)
+	require.NoError(t, err)
+	require.Zero(t, members.Total)
+}
@@ -0,0 +1,98 @@
+package groups
+
+import (
+	"time"
+
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/repository"
+)
+
+const (
+	// defaultLimit размер страницы, если лимит не указан
+	defaultLimit = 100
+)
+
+type Group struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedBy   *int      `json:"created_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type GroupCreateRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type GroupUpdateRequest struct {
+	ID          int     `json:"-"`
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+}
+
+type GroupListRequest struct {
+	// MemberID отбирает группы, в которых состоит пользователь
+	MemberID *int    `json:"member_id"`
+	Name     *string `json:"name"`
+	Limit    *int    `json:"limit"`
+	Offset   *int    `json:"offset"`
+}
+
+type GroupListResponse struct {
+	Result []*Group `json:"groups"`
+	Total  int      `json:"total"`
+}
+
+type GroupMembersRequest struct {
+	GroupID int   `json:"-"`
+	UserIDs []int `json:"user_ids"`
+}
+
+type GroupMember struct {
+	UserID  int       `json:"user_id"`
+	Name    string    `json:"name"`
+	Email   string    `json:"email"`
+	AddedBy *int      `json:"added_by,omitempty"`
+	AddedAt time.Time `json:"added_at"`
+}
+
+type GroupListMembersRequest struct {
+	GroupID int  `json:"-"`
+	Limit   *int `json:"limit"`
+	Offset  *int `json:"offset"`
+}
+
+type GroupListMembersResponse struct {
+	Result []*GroupMember `json:"members"`
+	Total  int            `json:"total"`
+}
+
+func limitOrDefault(limit *int) *int {
+	if limit == nil {
+		return utils.Ptr(defaultLimit)
+	}
+	return limit
+}
+
+func toGroup(group *repository.Group) *Group {
+	return &Group{
+		ID:          group.ID,
+		Name:        group.Name,
+		Description: group.Description,
+		CreatedBy:   group.CreatedBy,
+		CreatedAt:   group.CreatedAt,
+		UpdatedAt:   group.UpdatedAt,
+	}
+}
+
+func toGroupMember(member *repository.GroupMember) *GroupMember {
+	return &GroupMember{
+		UserID:  member.UserID,
+		Name:    member.Name,
+		Email:   member.Email,
+		AddedBy: member.AddedBy,
+		AddedAt: member.AddedAt,
+	}
+}
@@ -0,0 +1,36 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/db"
+)
+
+func (s *service) RemoveMembers(ctx context.Context, req *GroupMembersRequest) ([]int, error) {
+	userIDs, err := memberIDs(ctx, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []int
+
+	err = s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+		if _, err := s.getGroup(ctx, req.GroupID); err != nil {
+			return err
+		}
+
+		removed, err = s.repo.Groups().RemoveMembers(ctx, req.GroupID, userIDs)
+		if err != nil {
+			return fmt.Errorf("remove group members: %w", err)
+		}
+
+		return s.addMembersChangedEvent(ctx, req.GroupID, model.GroupMembersActionRemoved, removed)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,34 @@
+package groups
+
+import (
+	"context"
+
+	"boilerplate/internal/repository"
+)
+
+type Service interface {
+	Create(ctx context.Context, req *GroupCreateRequest) (*Group, error)
+	Get(ctx context.Context, id int) (*Group, error)
+	Update(ctx context.Context, req *GroupUpdateRequest) (*Group, error)
+	Delete(ctx context.Context, id int) error
+	ListGroups(ctx context.Context, req *GroupListRequest) (*GroupListResponse, error)
+	// AddMembers добавляет пользователей в группу и возвращает тех, кого в
+	// группе еще не было
+	AddMembers(ctx context.Context, req *GroupMembersRequest) ([]int, error)
+	// RemoveMembers исключает пользователей из группы и возвращает тех, кто в
+	// ней состоял
+	RemoveMembers(ctx context.Context, req *GroupMembersRequest) ([]int, error)
+	ListMembers(ctx context.Context, req *GroupListMembersRequest) (*GroupListMembersResponse, error)
+}
+
+type service struct {
+	repo repository.Repo
+}
+
+func NewService(
+	repo repository.Repo,
+) Service {
+	return &service{
+		repo: repo,
+	}
+}
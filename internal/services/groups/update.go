@@ -0,0 +1,40 @@
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/repository"
+)
+
+func (s *service) Update(ctx context.Context, req *GroupUpdateRequest) (*Group, error) {
+	group, err := s.getGroup(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		group.Name = strings.TrimSpace(*req.Name)
+		if group.Name == "" {
+			return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyGroupNameRequired))
+		}
+	}
+
+	if req.Description != nil {
+		group.Description = *req.Description
+	}
+
+	err = s.repo.Groups().Update(ctx, group)
+	if err != nil {
+		if errors.Is(err, repository.ErrGroupNameExists) {
+			return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyGroupNameExists))
+		}
+		return nil, fmt.Errorf("update group: %w", err)
+	}
+
+	return toGroup(group), nil
+}
@@ -0,0 +1,16 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func (s *service) Cleanup(ctx context.Context) (int, error) {
+	deleted, err := s.repo.Inbox().DeleteProcessed(ctx, time.Duration(s.config.Retention)*time.Second)
+	if err != nil {
+		return 0, fmt.Errorf("delete processed messages: %w", err)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,41 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+
+	"boilerplate/internal/pkg/clients/db"
+	"boilerplate/internal/pkg/cloudevents"
+)
+
+func (s *service) Handle(ctx context.Context, consumer string, fn func(ctx context.Context) error) (bool, error) {
+	event, ok := cloudevents.FromContext(ctx)
+	if !ok || event.ID == "" {
+		return true, fn(ctx)
+	}
+
+	processed := false
+	err := s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+		claimed, err := s.repo.Inbox().Claim(ctx, consumer, event.ID)
+		if err != nil {
+			return fmt.Errorf("claim message %s: %w", event.ID, err)
+		}
+		if !claimed {
+			return nil
+		}
+
+		// Транзакции обработчика становятся точками сохранения этой транзакции,
+		// поэтому при ошибке отметка откатывается вместе с ними
+		if err := fn(ctx); err != nil {
+			return err
+		}
+
+		processed = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return processed, nil
+}
@@ -0,0 +1,73 @@
+package inbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/pkg/clients/db"
+	"boilerplate/internal/pkg/cloudevents"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+)
+
+func TestHandle(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	consumer := gofakeit.Word()
+	ctx := cloudevents.WithEvent(sp.Context(), &cloudevents.Event{ID: gofakeit.UUID()})
+
+	// Ошибка обработки откатывает отметку и изменения обработчика, в том
+	// числе сделанные во вложенной транзакции
+	user := suite_factory.NewUserFactory().Build()
+	errHandle := errors.New("handle failed")
+	processed, err := sp.GetInboxService().Handle(ctx, consumer, func(ctx context.Context) error {
+		err := sp.GetRepo().Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+			return sp.GetRepo().Users().Create(ctx, user)
+		})
+		require.NoError(t, err)
+		return errHandle
+	})
+	require.ErrorIs(t, err, errHandle)
+	require.False(t, processed)
+
+	_, err = sp.GetRepo().Users().Get(sp.Context(), user.ID)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	calls := 0
+	handle := func(context.Context) error {
+		calls++
+		return nil
+	}
+
+	processed, err = sp.GetInboxService().Handle(ctx, consumer, handle)
+	require.NoError(t, err)
+	require.True(t, processed)
+
+	// Повторная доставка того же сообщения пропускается
+	processed, err = sp.GetInboxService().Handle(ctx, consumer, handle)
+	require.NoError(t, err)
+	require.False(t, processed)
+	require.Equal(t, 1, calls)
+
+	// Отметка уникальна только в пределах консьюмера
+	processed, err = sp.GetInboxService().Handle(ctx, gofakeit.Word()+"-other", handle)
+	require.NoError(t, err)
+	require.True(t, processed)
+	require.Equal(t, 2, calls)
+
+	// Сообщение без идентификатора обрабатывается всегда
+	for range 2 {
+		processed, err = sp.GetInboxService().Handle(sp.Context(), consumer, handle)
+		require.NoError(t, err)
+		require.True(t, processed)
+	}
+	require.Equal(t, 4, calls)
+}
@@ -0,0 +1,33 @@
+package inbox
+
+import (
+	"context"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/repository"
+)
+
+type Service interface {
+	// Handle выполняет обработку сообщения консьюмером в одной транзакции с
+	// отметкой в inbox и возвращает false, если консьюмер уже обработал это
+	// сообщение. Идентификатор сообщения берется из события в контексте,
+	// сообщение без идентификатора обрабатывается без проверки
+	Handle(ctx context.Context, consumer string, fn func(ctx context.Context) error) (bool, error)
+	// Cleanup удаляет отметки старше срока хранения и возвращает их количество
+	Cleanup(ctx context.Context) (int, error)
+}
+
+type service struct {
+	config *model.ConfigInbox
+	repo   repository.Repo
+}
+
+func NewService(
+	config *model.ConfigInbox,
+	repo repository.Repo,
+) Service {
+	return &service{
+		config: config,
+		repo:   repo,
+	}
+}
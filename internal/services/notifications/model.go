@@ -0,0 +1,28 @@
+package notifications
+
+import (
+	"html/template"
+
+	"boilerplate/internal/pkg/i18n"
+)
+
+// Kind вид уведомления. Вместе с ключом определяет, было ли уведомление
+// уже отправлено
+type Kind string
+
+const (
+	KindWelcome Kind = "welcome"
+)
+
+// welcomeTemplates тексты приветственного письма по языкам
+var welcomeTemplates = map[string]*template.Template{
+	i18n.LocaleRU: template.Must(template.New("welcome_ru").Parse(`<p>Здравствуйте, {{.Name}}!</p>
+<p>Для вас создана учетная запись. Для входа используйте email {{.Email}}.</p>`)),
+	i18n.LocaleEN: template.Must(template.New("welcome_en").Parse(`<p>Hello, {{.Name}}!</p>
+<p>An account has been created for you. Use {{.Email}} to sign in.</p>`)),
+}
+
+type welcomeLetter struct {
+	Name  string
+	Email string
+}
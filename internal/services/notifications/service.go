@@ -0,0 +1,33 @@
+package notifications
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/clients/mail"
+	"boilerplate/internal/repository"
+	"boilerplate/internal/services/preferences"
+)
+
+type Service interface {
+	// SendWelcome отправляет пользователю приветственное письмо на его языке.
+	// Письмо отправляется не больше одного раза, повторные вызовы ничего не делают
+	SendWelcome(ctx context.Context, userID int) error
+}
+
+type service struct {
+	repo               repository.Repo
+	mailClient         mail.Client
+	preferencesService preferences.Service
+}
+
+func NewService(
+	repo repository.Repo,
+	mailClient mail.Client,
+	preferencesService preferences.Service,
+) Service {
+	return &service{
+		repo:               repo,
+		mailClient:         mailClient,
+		preferencesService: preferencesService,
+	}
+}
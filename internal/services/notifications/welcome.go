@@ -0,0 +1,66 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+
+	"boilerplate/internal/pkg/clients/db"
+	"boilerplate/internal/pkg/i18n"
+)
+
+func (s *service) SendWelcome(ctx context.Context, userID int) error {
+	user, err := s.repo.Users().Get(ctx, userID)
+	if err != nil {
+		// Пользователь мог быть удален до обработки события
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("get user: %w", err)
+	}
+	if user.Deleted {
+		return nil
+	}
+
+	prefs, err := s.preferencesService.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("get preferences: %w", err)
+	}
+	if !prefs.Notifications.Email {
+		return nil
+	}
+
+	tmpl, ok := welcomeTemplates[prefs.Locale]
+	if !ok {
+		tmpl = welcomeTemplates[i18n.DefaultLocale]
+	}
+
+	body := &bytes.Buffer{}
+	if err := tmpl.Execute(body, &welcomeLetter{Name: user.Name, Email: user.Email}); err != nil {
+		return fmt.Errorf("execute template %s: %w", tmpl.Name(), err)
+	}
+
+	subject := i18n.Translate(prefs.Locale, i18n.KeyWelcomeSubject)
+
+	// Отметка и отправка в одной транзакции: если отправка не удалась, отметка
+	// откатывается и письмо отправится при повторной доставке события
+	return s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+		claimed, err := s.repo.SentNotifications().Claim(ctx, string(KindWelcome), strconv.Itoa(userID))
+		if err != nil {
+			return fmt.Errorf("claim notification: %w", err)
+		}
+		if !claimed {
+			return nil
+		}
+
+		if err := s.mailClient.Send(ctx, user.Email, subject, body.String(), nil); err != nil {
+			return fmt.Errorf("send mail to %s: %w", user.Email, err)
+		}
+
+		return nil
+	})
+}
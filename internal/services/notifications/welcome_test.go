@@ -0,0 +1,99 @@
+package notifications_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/pkg/clients/mail"
+	mail_mocks "boilerplate/internal/pkg/clients/mail/mocks"
+	"boilerplate/internal/pkg/i18n"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/services/preferences"
+)
+
+func TestSendWelcome(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	mailClient, ok := sp.GetMailClient().(*mail_mocks.Client)
+	require.True(t, ok)
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	_, err = sp.GetPreferencesService().Update(sp.Context(), &preferences.PreferencesUpdateRequest{
+		UserID: user.ID,
+		Locale: utils.Ptr(i18n.LocaleEN),
+	})
+	require.NoError(t, err)
+
+	// Неудачная отправка не отмечает письмо отправленным
+	errSend := errors.New("smtp unavailable")
+	mailClient.EXPECT().
+		Send(mock.Anything, user.Email, mock.Anything, mock.Anything, mock.Anything).
+		Return(errSend).
+		Once()
+
+	err = sp.GetNotificationsService().SendWelcome(sp.Context(), user.ID)
+	require.ErrorIs(t, err, errSend)
+
+	var subject, body string
+	mailClient.EXPECT().
+		Send(mock.Anything, user.Email, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _, s, b string, _ []*mail.Attachment) {
+			subject, body = s, b
+		}).
+		Return(nil).
+		Once()
+
+	err = sp.GetNotificationsService().SendWelcome(sp.Context(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Welcome!", subject)
+	require.Contains(t, body, "Hello, "+user.Name)
+
+	// Повторный вызов не отправляет письмо еще раз
+	err = sp.GetNotificationsService().SendWelcome(sp.Context(), user.ID)
+	require.NoError(t, err)
+
+	mailClient.AssertNumberOfCalls(t, "Send", 2)
+}
+
+func TestSendWelcomeDisabled(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	_, err = sp.GetPreferencesService().Update(sp.Context(), &preferences.PreferencesUpdateRequest{
+		UserID: user.ID,
+		Notifications: preferences.PreferencesNotificationsUpdate{
+			Email: utils.Ptr(false),
+		},
+	})
+	require.NoError(t, err)
+
+	// Пользователь отказался от информационных писем, а несуществующему
+	// пользователю отправлять некому
+	err = sp.GetNotificationsService().SendWelcome(sp.Context(), user.ID)
+	require.NoError(t, err)
+
+	err = sp.GetNotificationsService().SendWelcome(sp.Context(), user.ID+1)
+	require.NoError(t, err)
+
+	mailClient, ok := sp.GetMailClient().(*mail_mocks.Client)
+	require.True(t, ok)
+	mailClient.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,16 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func (s *service) Cleanup(ctx context.Context) (int, error) {
+	deleted, err := s.repo.Outbox().DeletePublished(ctx, time.Duration(s.config.Retention)*time.Second)
+	if err != nil {
+		return 0, fmt.Errorf("delete published messages: %w", err)
+	}
+
+	return deleted, nil
+}
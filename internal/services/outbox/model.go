@@ -0,0 +1,15 @@
+package outbox
+
+// RelayResult количество сообщений пачки по результату публикации
+type RelayResult struct {
+	Published int
+	// Retried сообщения, публикация которых отложена до следующей попытки
+	Retried int
+	// Failed сообщения, исчерпавшие попытки публикации
+	Failed int
+}
+
+// Total количество обработанных сообщений
+func (r *RelayResult) Total() int {
+	return r.Published + r.Retried + r.Failed
+}
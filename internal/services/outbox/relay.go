@@ -0,0 +1,137 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/db"
+	"boilerplate/internal/pkg/metadata"
+	"boilerplate/internal/pkg/schema_registry"
+	"boilerplate/internal/repository"
+
+	// Регистрирует типы событий, сохраняемых в outbox
+	_ "boilerplate/pkg/pb/events"
+)
+
+// Relay выбирает сообщения с блокировкой строк, поэтому несколько экземпляров
+// приложения не публикуют одно сообщение одновременно. Сообщение, опубликованное
+// до сбоя коммита, будет опубликовано повторно
+func (s *service) Relay(ctx context.Context) (*RelayResult, error) {
+	result := &RelayResult{}
+
+	err := s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+		messages, err := s.repo.Outbox().FetchPending(ctx, s.config.BatchSize)
+		if err != nil {
+			return fmt.Errorf("fetch pending messages: %w", err)
+		}
+
+		published := make([]int, 0, len(messages))
+
+		for _, message := range messages {
+			err := s.publish(ctx, message)
+			if err == nil {
+				published = append(published, message.ID)
+				continue
+			}
+
+			// Событие, не соответствующее схеме топика, не пройдет проверку и
+			// при повторной публикации
+			attempt := message.Attempts + 1
+			if attempt >= s.config.MaxAttempts || errors.Is(err, schema_registry.ErrEventInvalid) {
+				if err := s.repo.Outbox().MarkFailed(ctx, message.ID, err.Error()); err != nil {
+					return fmt.Errorf("mark message %d failed: %w", message.ID, err)
+				}
+				result.Failed++
+				continue
+			}
+
+			if err := s.repo.Outbox().MarkRetry(ctx, message.ID, err.Error(), s.retryDelay(attempt)); err != nil {
+				return fmt.Errorf("mark message %d retry: %w", message.ID, err)
+			}
+			result.Retried++
+		}
+
+		if err := s.repo.Outbox().MarkPublished(ctx, published); err != nil {
+			return fmt.Errorf("mark messages published: %w", err)
+		}
+		result.Published = len(published)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// publish отправляет сообщение с метаданными и контекстом трассировки запроса,
+// в котором оно было создано. Сообщения одного ключа попадают в одну партицию топика, id события
+// не меняется при повторной публикации
+func (s *service) publish(ctx context.Context, message *repository.OutboxMessage) error {
+	data, err := decodePayload(message)
+	if err != nil {
+		return err
+	}
+
+	if message.RequestID != nil {
+		ctx = metadata.WithRequestID(ctx, *message.RequestID)
+	}
+	if message.ActorID != nil {
+		ctx = metadata.WithUserID(ctx, *message.ActorID)
+	}
+	if message.IP != nil {
+		ctx = metadata.WithIP(ctx, *message.IP)
+	}
+	ctx = metadata.WithTraceContext(ctx, message.TraceContext)
+
+	err = s.brokerClient.Publish(ctx, message.Topic, nil, message.Key, data,
+		model.WithEventID(fmt.Sprintf("outbox-%d", message.ID)),
+		model.WithEventTime(message.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("publish message %d: %w", message.ID, err)
+	}
+
+	return nil
+}
+
+// decodePayload восстанавливает proto-сообщение по имени типа, чтобы оно было
+// опубликовано в protobuf. Payload без типа публикуется как есть в JSON
+func decodePayload(message *repository.OutboxMessage) (any, error) {
+	if message.Type == "" {
+		return message.Payload, nil
+	}
+
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(message.Type))
+	if err != nil {
+		return nil, fmt.Errorf("find message type %s: %w", message.Type, err)
+	}
+
+	data := messageType.New().Interface()
+	if err := protojson.Unmarshal(message.Payload, data); err != nil {
+		return nil, fmt.Errorf("unmarshal message %d payload: %w", message.ID, err)
+	}
+
+	return data, nil
+}
+
+// retryDelay задержка перед попыткой attempt + 1: удваивается с каждой
+// попыткой, но не превышает максимальную
+func (s *service) retryDelay(attempt int) time.Duration {
+	delay := time.Duration(s.config.RetryDelay) * time.Second
+	maxDelay := time.Duration(s.config.MaxRetryDelay) * time.Second
+
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay)
+}
@@ -0,0 +1,132 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/memory_broker"
+	"boilerplate/internal/pkg/schema_registry"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/repository"
+	"boilerplate/internal/topics"
+	"boilerplate/pkg/pb/events"
+)
+
+func TestRelay(t *testing.T) {
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	brokerClient, ok := sp.GetBrokerClient().(memory_broker.Client)
+	require.True(t, ok)
+
+	// События добавляются в запросе с контекстом трассировки
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithRemoteSpanContext(sp.Context(), spanContext)
+
+	// Брокер недоступен для событий пользователя 2, а событие пользователя 3
+	// не соответствует схеме топика
+	publishedTraceIDs := []trace.TraceID{}
+	brokerClient.SetPublishHook(func(ctx context.Context, message *memory_broker.Message) error {
+		publishedTraceIDs = append(publishedTraceIDs, trace.SpanContextFromContext(ctx).TraceID())
+
+		switch message.Key {
+		case "2":
+			return errors.New("unavailable")
+		case "3":
+			return fmt.Errorf("%w %s", schema_registry.ErrEventInvalid, message.Topic)
+		default:
+			return nil
+		}
+	})
+
+	add := func(topic string, userID int, payload any) *repository.OutboxMessage {
+		message, err := repository.NewOutboxMessage(topic, string(model.OutboxAggregateUser), strconv.Itoa(userID), payload)
+		require.NoError(t, err)
+
+		err = sp.GetRepo().Outbox().Add(ctx, message)
+		require.NoError(t, err)
+		return message
+	}
+
+	createdEvent := &events.UserCreated{UserId: 1, Name: "created"}
+	updatedEvent := &events.UserUpdated{UserId: 1, Fields: []string{"name"}}
+
+	created := add(topics.TopicUserCreated, 1, createdEvent)
+	updated := add(topics.TopicUserUpdated, 1, updatedEvent)
+	add(topics.TopicUserCreated, 2, map[string]any{"user_id": 2})
+	add(topics.TopicUserCreated, 3, map[string]any{"user_id": 3})
+	require.Contains(t, created.TraceContext["traceparent"], spanContext.TraceID().String())
+
+	// Proto-сообщения публикуются в исходном типе с постоянным id события
+	requirePublished := func(message *repository.OutboxMessage, want proto.Message) {
+		published := brokerClient.Published(message.Topic)
+		require.Len(t, published, 1)
+		require.Equal(t, message.Key, published[0].Key)
+		require.Equal(t, fmt.Sprintf("outbox-%d", message.ID), published[0].Event.ID)
+
+		got := want.ProtoReflect().New().Interface()
+		require.NoError(t, published[0].Decode(got))
+		require.True(t, proto.Equal(want, got))
+	}
+
+	// События пользователя публикуются по одному за пачку в порядке записи
+	result, err := sp.GetOutboxService().Relay(sp.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Published)
+	require.Equal(t, 1, result.Retried)
+	// Событие, не прошедшее проверку схемы, не публикуется повторно
+	require.Equal(t, 1, result.Failed)
+	requirePublished(created, createdEvent)
+
+	result, err = sp.GetOutboxService().Relay(sp.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Published)
+	require.Zero(t, result.Retried)
+	requirePublished(updated, updatedEvent)
+
+	// Публикация продолжает трассу запроса, в котором создано событие
+	require.NotEmpty(t, publishedTraceIDs)
+	for _, traceID := range publishedTraceIDs {
+		require.Equal(t, spanContext.TraceID(), traceID)
+	}
+
+	// Отложенное сообщение не публикуется до следующей попытки
+	result, err = sp.GetOutboxService().Relay(sp.Context())
+	require.NoError(t, err)
+	require.Zero(t, result.Total())
+
+	// Сообщение пользователя 2 не сохранено в топике
+	require.Len(t, brokerClient.Published(topics.TopicUserCreated), 1)
+
+	messages, err := sp.GetRepo().Outbox().List(sp.Context(), string(model.OutboxAggregateUser), "2")
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.Equal(t, 1, messages[0].Attempts)
+	require.Contains(t, *messages[0].LastError, "unavailable")
+	require.Nil(t, messages[0].PublishedAt)
+
+	messages, err = sp.GetRepo().Outbox().List(sp.Context(), string(model.OutboxAggregateUser), "3")
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.NotNil(t, messages[0].FailedAt)
+	require.Contains(t, *messages[0].LastError, schema_registry.ErrEventInvalid.Error())
+
+	messages, err = sp.GetRepo().Outbox().List(sp.Context(), string(model.OutboxAggregateUser), "1")
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	for _, message := range messages {
+		require.NotNil(t, message.PublishedAt)
+	}
+}
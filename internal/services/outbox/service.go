@@ -0,0 +1,34 @@
+package outbox
+
+import (
+	"context"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/repository"
+)
+
+type Service interface {
+	// Relay публикует в брокер пачку сообщений outbox, готовых к отправке
+	Relay(ctx context.Context) (*RelayResult, error)
+	// Cleanup удаляет опубликованные сообщения старше срока хранения и
+	// возвращает их количество
+	Cleanup(ctx context.Context) (int, error)
+}
+
+type service struct {
+	config       *model.ConfigOutbox
+	repo         repository.Repo
+	brokerClient model.BrokerClient
+}
+
+func NewService(
+	config *model.ConfigOutbox,
+	repo repository.Repo,
+	brokerClient model.BrokerClient,
+) Service {
+	return &service{
+		config:       config,
+		repo:         repo,
+		brokerClient: brokerClient,
+	}
+}
@@ -0,0 +1,55 @@
+package preferences
+
+import (
+	"context"
+	"sync"
+)
+
+type cacheKey struct{}
+
+// cache настройки пользователей, прочитанные за время запроса
+type cache struct {
+	mu    sync.Mutex
+	items map[int]*Preferences
+}
+
+// WithCache включает кэширование настроек в контексте запроса: повторные
+// вызовы Get в рамках запроса не обращаются к базе
+func WithCache(ctx context.Context) context.Context {
+	if getCache(ctx) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, cacheKey{}, &cache{
+		items: map[int]*Preferences{},
+	})
+}
+
+func getCache(ctx context.Context) *cache {
+	if c, ok := ctx.Value(cacheKey{}).(*cache); ok {
+		return c
+	}
+	return nil
+}
+
+func (c *cache) get(userID int) (*Preferences, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prefs, ok := c.items[userID]
+	return prefs, ok
+}
+
+func (c *cache) set(userID int, prefs *Preferences) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items[userID] = prefs
+}
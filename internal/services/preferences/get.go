@@ -0,0 +1,33 @@
+package preferences
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *service) Get(ctx context.Context, userID int) (*Preferences, error) {
+	if prefs, ok := getCache(ctx).get(userID); ok {
+		return prefs, nil
+	}
+
+	rows, err := s.repo.UserPreferences().List(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list user preferences: %w", err)
+	}
+
+	prefs := defaultPreferences()
+	for _, row := range rows {
+		setting, ok := settings[row.Key]
+		if !ok {
+			continue
+		}
+
+		// Значение, которое перестало проходить проверку (например, язык
+		// больше не поддерживается), заменяется значением по умолчанию
+		_ = setting.decode(ctx, row.Value, prefs)
+	}
+
+	getCache(ctx).set(userID, prefs)
+
+	return prefs, nil
+}
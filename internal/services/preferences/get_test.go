@@ -0,0 +1,80 @@
+package preferences_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/pkg/i18n"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/services/preferences"
+)
+
+func TestGetPreferencesDefaults(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	prefs, err := sp.GetPreferencesService().Get(sp.Context(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, &preferences.Preferences{
+		Locale:   i18n.DefaultLocale,
+		Timezone: preferences.DefaultTimezone,
+		Notifications: preferences.PreferencesNotifications{
+			Email:    true,
+			Security: true,
+		},
+	}, prefs)
+}
+
+func TestGetPreferencesCache(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	ctx := preferences.WithCache(sp.Context())
+
+	prefs, err := sp.GetPreferencesService().Get(ctx, user.ID)
+	require.NoError(t, err)
+
+	// Изменение в обход сервиса не видно до конца запроса
+	err = sp.GetRepo().UserPreferences().Set(sp.Context(), user.ID, map[string]json.RawMessage{
+		preferences.KeyLocale: json.RawMessage(`"en"`),
+	})
+	require.NoError(t, err)
+
+	cached, err := sp.GetPreferencesService().Get(ctx, user.ID)
+	require.NoError(t, err)
+	require.Same(t, prefs, cached)
+	require.Equal(t, i18n.LocaleRU, cached.Locale)
+
+	// Без кэша читается сохраненное значение
+	actual, err := sp.GetPreferencesService().Get(sp.Context(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, i18n.LocaleEN, actual.Locale)
+
+	// Изменение через сервис обновляет кэш
+	updated, err := sp.GetPreferencesService().Update(ctx, &preferences.PreferencesUpdateRequest{
+		UserID:   user.ID,
+		Timezone: utils.Ptr("Europe/Moscow"),
+	})
+	require.NoError(t, err)
+
+	cached, err = sp.GetPreferencesService().Get(ctx, user.ID)
+	require.NoError(t, err)
+	require.Same(t, updated, cached)
+	require.Equal(t, "Europe/Moscow", cached.Timezone)
+}
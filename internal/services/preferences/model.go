@@ -0,0 +1,82 @@
+package preferences
+
+import (
+	"time"
+
+	"boilerplate/internal/pkg/i18n"
+)
+
+const (
+	KeyLocale                = "locale"
+	KeyTimezone              = "timezone"
+	KeyNotificationsEmail    = "notifications.email"
+	KeyNotificationsSecurity = "notifications.security"
+)
+
+// DefaultTimezone часовой пояс по умолчанию
+const DefaultTimezone = "UTC"
+
+type Preferences struct {
+	Locale        string                   `json:"locale"`
+	Timezone      string                   `json:"timezone"`
+	Notifications PreferencesNotifications `json:"notifications"`
+}
+
+// PreferencesNotifications какие письма получает пользователь
+type PreferencesNotifications struct {
+	// Email информационные письма
+	Email bool `json:"email"`
+	// Security письма о входе, смене email и пароля
+	Security bool `json:"security"`
+}
+
+// Location возвращает часовой пояс пользователя, при ошибке UTC
+func (p *Preferences) Location() *time.Location {
+	location, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+func defaultPreferences() *Preferences {
+	return &Preferences{
+		Locale:   i18n.DefaultLocale,
+		Timezone: DefaultTimezone,
+		Notifications: PreferencesNotifications{
+			Email:    true,
+			Security: true,
+		},
+	}
+}
+
+// PreferencesUpdateRequest изменяет только переданные настройки
+type PreferencesUpdateRequest struct {
+	UserID        int                            `json:"-"`
+	Locale        *string                        `json:"locale"`
+	Timezone      *string                        `json:"timezone"`
+	Notifications PreferencesNotificationsUpdate `json:"notifications"`
+}
+
+type PreferencesNotificationsUpdate struct {
+	Email    *bool `json:"email"`
+	Security *bool `json:"security"`
+}
+
+// apply переносит переданные настройки в prefs и возвращает их ключи
+func (r *PreferencesUpdateRequest) apply(prefs *Preferences) []string {
+	var keys []string
+	keys = applyValue(keys, KeyLocale, &prefs.Locale, r.Locale)
+	keys = applyValue(keys, KeyTimezone, &prefs.Timezone, r.Timezone)
+	keys = applyValue(keys, KeyNotificationsEmail, &prefs.Notifications.Email, r.Notifications.Email)
+	keys = applyValue(keys, KeyNotificationsSecurity, &prefs.Notifications.Security, r.Notifications.Security)
+	return keys
+}
+
+func applyValue[T any](keys []string, key string, dst, src *T) []string {
+	if src == nil {
+		return keys
+	}
+	*dst = *src
+	return append(keys, key)
+}
@@ -0,0 +1,26 @@
+package preferences
+
+import (
+	"context"
+
+	"boilerplate/internal/repository"
+)
+
+type Service interface {
+	// Get возвращает настройки пользователя. Для неуказанных настроек
+	// возвращаются значения по умолчанию
+	Get(ctx context.Context, userID int) (*Preferences, error)
+	Update(ctx context.Context, req *PreferencesUpdateRequest) (*Preferences, error)
+}
+
+type service struct {
+	repo repository.Repo
+}
+
+func NewService(
+	repo repository.Repo,
+) Service {
+	return &service{
+		repo: repo,
+	}
+}
@@ -0,0 +1,95 @@
+package preferences
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	// Часовые пояса не должны зависеть от tzdata в образе
+	_ "time/tzdata"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+)
+
+// setting настройка, которая хранится в таблице отдельной парой ключ-значение
+type setting interface {
+	// decode читает сохраненное значение в prefs
+	decode(ctx context.Context, raw json.RawMessage, prefs *Preferences) error
+	// encode проверяет значение из prefs и кодирует его для сохранения
+	encode(ctx context.Context, prefs *Preferences) (json.RawMessage, error)
+}
+
+// typedSetting настройка типа T, хранящаяся в поле Preferences
+type typedSetting[T any] struct {
+	field    func(prefs *Preferences) *T
+	validate func(ctx context.Context, value T) error
+}
+
+var settings = map[string]setting{
+	KeyLocale: typedSetting[string]{
+		field:    func(prefs *Preferences) *string { return &prefs.Locale },
+		validate: validateLocale,
+	},
+	KeyTimezone: typedSetting[string]{
+		field:    func(prefs *Preferences) *string { return &prefs.Timezone },
+		validate: validateTimezone,
+	},
+	KeyNotificationsEmail: typedSetting[bool]{
+		field: func(prefs *Preferences) *bool { return &prefs.Notifications.Email },
+	},
+	KeyNotificationsSecurity: typedSetting[bool]{
+		field: func(prefs *Preferences) *bool { return &prefs.Notifications.Security },
+	},
+}
+
+func (s typedSetting[T]) decode(ctx context.Context, raw json.RawMessage, prefs *Preferences) error {
+	var value T
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return fmt.Errorf("unmarshal: %w", err)
+	}
+
+	if s.validate != nil {
+		if err := s.validate(ctx, value); err != nil {
+			return err
+		}
+	}
+
+	*s.field(prefs) = value
+	return nil
+}
+
+func (s typedSetting[T]) encode(ctx context.Context, prefs *Preferences) (json.RawMessage, error) {
+	value := *s.field(prefs)
+
+	if s.validate != nil {
+		if err := s.validate(ctx, value); err != nil {
+			return nil, err
+		}
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+	return raw, nil
+}
+
+func validateLocale(ctx context.Context, locale string) error {
+	if !i18n.IsSupported(locale) {
+		return errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyLocaleUnsupported, locale))
+	}
+	return nil
+}
+
+func validateTimezone(ctx context.Context, timezone string) error {
+	// Пустая строка и Local в LoadLocation означают UTC и часовой пояс сервера
+	if timezone == "" || timezone == "Local" {
+		return errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyTimezoneUnknown, timezone))
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyTimezoneUnknown, timezone))
+	}
+	return nil
+}
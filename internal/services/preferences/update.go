@@ -0,0 +1,38 @@
+package preferences
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+func (s *service) Update(ctx context.Context, req *PreferencesUpdateRequest) (*Preferences, error) {
+	prefs, err := s.Get(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Закэшированные настройки не меняются до сохранения
+	updated := *prefs
+	keys := req.apply(&updated)
+	if len(keys) == 0 {
+		return prefs, nil
+	}
+
+	values := make(map[string]json.RawMessage, len(keys))
+	for _, key := range keys {
+		values[key], err = settings[key].encode(ctx, &updated)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.repo.UserPreferences().Set(ctx, req.UserID, values)
+	if err != nil {
+		return nil, fmt.Errorf("set user preferences: %w", err)
+	}
+
+	getCache(ctx).set(req.UserID, &updated)
+
+	return &updated, nil
+}
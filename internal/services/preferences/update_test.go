@@ -0,0 +1,102 @@
+package preferences_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/pkg/metadata"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/services/preferences"
+)
+
+func TestUpdatePreferences(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	updated, err := sp.GetPreferencesService().Update(sp.Context(), &preferences.PreferencesUpdateRequest{
+		UserID:   user.ID,
+		Locale:   utils.Ptr(i18n.LocaleEN),
+		Timezone: utils.Ptr("Asia/Tokyo"),
+		Notifications: preferences.PreferencesNotificationsUpdate{
+			Email: utils.Ptr(false),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, i18n.LocaleEN, updated.Locale)
+	require.Equal(t, "Asia/Tokyo", updated.Location().String())
+	require.False(t, updated.Notifications.Email)
+	require.True(t, updated.Notifications.Security)
+
+	// Повторное изменение не затрагивает остальные настройки
+	updated, err = sp.GetPreferencesService().Update(sp.Context(), &preferences.PreferencesUpdateRequest{
+		UserID: user.ID,
+		Notifications: preferences.PreferencesNotificationsUpdate{
+			Security: utils.Ptr(false),
+		},
+	})
+	require.NoError(t, err)
+
+	prefs, err := sp.GetPreferencesService().Get(sp.Context(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, updated, prefs)
+	require.Equal(t, &preferences.Preferences{
+		Locale:   i18n.LocaleEN,
+		Timezone: "Asia/Tokyo",
+		Notifications: preferences.PreferencesNotifications{
+			Email:    false,
+			Security: false,
+		},
+	}, prefs)
+}
+
+func TestUpdatePreferencesValidation(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	_, err = sp.GetPreferencesService().Update(sp.Context(), &preferences.PreferencesUpdateRequest{
+		UserID: user.ID,
+		Locale: utils.Ptr("de"),
+	})
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+	require.Equal(t, "Язык de не поддерживается", err.Error())
+
+	ctx := metadata.WithLocale(sp.Context(), i18n.LocaleEN)
+	for _, timezone := range []string{"", "Local", "Mars/Olympus"} {
+		_, err = sp.GetPreferencesService().Update(ctx, &preferences.PreferencesUpdateRequest{
+			UserID:   user.ID,
+			Timezone: utils.Ptr(timezone),
+		})
+		require.True(t, errors_pkg.IsErrBadRequest(err), timezone)
+		require.Equal(t, "Unknown time zone "+timezone, err.Error())
+	}
+
+	// Ошибка в одной настройке не сохраняет остальные
+	_, err = sp.GetPreferencesService().Update(sp.Context(), &preferences.PreferencesUpdateRequest{
+		UserID:   user.ID,
+		Locale:   utils.Ptr(i18n.LocaleEN),
+		Timezone: utils.Ptr("Mars/Olympus"),
+	})
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+
+	prefs, err := sp.GetPreferencesService().Get(sp.Context(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, i18n.DefaultLocale, prefs.Locale)
+	require.Equal(t, preferences.DefaultTimezone, prefs.Timezone)
+}
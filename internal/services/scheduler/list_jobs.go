@@ -0,0 +1,23 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *service) ListJobs(ctx context.Context) ([]*JobInfo, error) {
+	states, err := s.repo.ScheduledJobs().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list scheduled jobs: %w", err)
+	}
+
+	// В базе могут остаться задачи, которые больше не регистрируются
+	res := make([]*JobInfo, 0, len(states))
+	for _, state := range states {
+		if _, ok := s.getJob(state.Name); ok {
+			res = append(res, toJobInfo(state))
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,142 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"boilerplate/internal/repository"
+)
+
+const (
+	// tickInterval интервал проверки задач, которые пора запустить
+	tickInterval = time.Second
+	// missedAfter опоздание, после которого запуск считается пропущенным
+	missedAfter = time.Minute
+)
+
+// CatchUpPolicy определяет, что делать с запусками, пропущенными, пока
+// планировщик не работал, например, когда ни один экземпляр не был лидером
+type CatchUpPolicy string
+
+const (
+	// CatchUpSkip пропущенные запуски не выполняются
+	CatchUpSkip CatchUpPolicy = "skip"
+	// CatchUpOnce все пропущенные запуски заменяются одним
+	CatchUpOnce CatchUpPolicy = "once"
+	// CatchUpAll каждый пропущенный запуск выполняется по очереди
+	CatchUpAll CatchUpPolicy = "all"
+)
+
+// JobStatus статус запуска задачи
+type JobStatus string
+
+const (
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+	// JobStatusInterrupted запуск прерван остановкой экземпляра или потерей
+	// лидерства
+	JobStatusInterrupted JobStatus = "interrupted"
+)
+
+// Job периодическая задача
+type Job struct {
+	// Name уникальное имя задачи
+	Name string
+	// Schedule cron-выражение из пяти полей или дескриптор вида @hourly и
+	// @every 10m
+	Schedule string
+	// Timezone часовой пояс IANA, в котором вычисляется расписание. По
+	// умолчанию UTC
+	Timezone string
+	// CatchUp политика пропущенных запусков. По умолчанию CatchUpSkip
+	CatchUp CatchUpPolicy
+	// Run выполняет задачу. Контекст отменяется при остановке экземпляра и
+	// потере лидерства
+	Run func(ctx context.Context) error
+}
+
+// JobInfo состояние задачи
+type JobInfo struct {
+	Name      string        `json:"name"`
+	Schedule  string        `json:"schedule"`
+	Timezone  string        `json:"timezone"`
+	CatchUp   CatchUpPolicy `json:"catch_up"`
+	Paused    bool          `json:"paused"`
+	NextRunAt time.Time     `json:"next_run_at"`
+	// TriggeredAt время запроса на запуск вручную, который еще не выполнен
+	TriggeredAt *time.Time `json:"triggered_at"`
+	// LastRun последний запуск, nil, если задача еще не запускалась
+	LastRun *JobRun `json:"last_run"`
+}
+
+// JobRun запуск задачи
+type JobRun struct {
+	StartedAt time.Time `json:"started_at"`
+	Status    JobStatus `json:"status"`
+	Error     *string   `json:"error"`
+	// Duration nil, пока запуск выполняется
+	Duration *time.Duration `json:"duration"`
+}
+
+// job зарегистрированная задача с разобранным расписанием
+type job struct {
+	Job
+	schedule cron.Schedule
+	location *time.Location
+}
+
+// next возвращает первый запуск после t
+func (j *job) next(t time.Time) time.Time {
+	return j.schedule.Next(t.In(j.location)).UTC()
+}
+
+// plan решает, нужно ли выполнить задачу со следующим запуском nextRunAt в
+// момент now, и возвращает время запуска после этого
+func (j *job) plan(nextRunAt, now time.Time) (bool, time.Time) {
+	if nextRunAt.After(now) {
+		return false, nextRunAt
+	}
+
+	switch j.CatchUp {
+	case CatchUpAll:
+		// Следующий пропущенный запуск выполнится при следующей проверке
+		return true, j.next(nextRunAt)
+	case CatchUpOnce:
+		return true, j.next(now)
+	default:
+		// Выполняется только запуск, который опоздал не больше чем на
+		// missedAfter
+		threshold := now.Add(-missedAfter)
+		run := !nextRunAt.Before(threshold) || !j.next(threshold).After(now)
+		return run, j.next(now)
+	}
+}
+
+func toJobInfo(state *repository.ScheduledJob) *JobInfo {
+	res := &JobInfo{
+		Name:        state.Name,
+		Schedule:    state.Schedule,
+		Timezone:    state.Timezone,
+		CatchUp:     CatchUpPolicy(state.CatchUp),
+		Paused:      state.Paused,
+		NextRunAt:   state.NextRunAt,
+		TriggeredAt: state.TriggeredAt,
+	}
+
+	if state.LastRunAt != nil && state.LastStatus != nil {
+		res.LastRun = &JobRun{
+			StartedAt: *state.LastRunAt,
+			Status:    JobStatus(*state.LastStatus),
+			Error:     state.LastError,
+		}
+		if state.LastDurationMs != nil {
+			duration := time.Duration(*state.LastDurationMs) * time.Millisecond
+			res.LastRun.Duration = &duration
+		}
+	}
+
+	return res
+}
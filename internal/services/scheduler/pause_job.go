@@ -0,0 +1,14 @@
+package scheduler
+
+import (
+	"context"
+)
+
+func (s *service) PauseJob(ctx context.Context, name string) (*JobInfo, error) {
+	if _, ok := s.getJob(name); !ok {
+		return nil, errJobNotFound(ctx, name)
+	}
+
+	state, err := s.repo.ScheduledJobs().Pause(ctx, name)
+	return s.jobInfo(ctx, name, state, err)
+}
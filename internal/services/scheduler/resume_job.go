@@ -0,0 +1,16 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+func (s *service) ResumeJob(ctx context.Context, name string) (*JobInfo, error) {
+	j, ok := s.getJob(name)
+	if !ok {
+		return nil, errJobNotFound(ctx, name)
+	}
+
+	state, err := s.repo.ScheduledJobs().Resume(ctx, name, j.next(time.Now()))
+	return s.jobInfo(ctx, name, state, err)
+}
@@ -0,0 +1,172 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/repository"
+)
+
+func (s *service) Run(ctx context.Context) {
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	synced := false
+	for {
+		if !synced {
+			synced = s.sync(ctx)
+		}
+		if synced {
+			s.tick(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			s.wg.Wait()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sync сохраняет расписание зарегистрированных задач и отмечает прерванными
+// запуски, которые не завершил прежний лидер
+func (s *service) sync(ctx context.Context) bool {
+	s.mu.RLock()
+	jobs := make([]*job, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		jobs = append(jobs, j)
+	}
+	s.mu.RUnlock()
+
+	now := time.Now().UTC()
+	for _, j := range jobs {
+		err := s.repo.ScheduledJobs().Sync(ctx, &repository.ScheduledJob{
+			Name:      j.Name,
+			Schedule:  j.Schedule,
+			Timezone:  j.Timezone,
+			CatchUp:   string(j.CatchUp),
+			NextRunAt: j.next(now),
+		})
+		if err != nil {
+			s.logger.ErrorKV(ctx, "sync scheduled job error", "job", j.Name, "error", err.Error())
+			return false
+		}
+	}
+
+	interrupted, err := s.repo.ScheduledJobs().SetStatus(ctx, string(JobStatusRunning), string(JobStatusInterrupted))
+	if err != nil {
+		s.logger.ErrorKV(ctx, "mark interrupted scheduled jobs error", "error", err.Error())
+		return false
+	}
+	if interrupted > 0 {
+		s.logger.WarnKV(ctx, "scheduled job runs interrupted", "count", interrupted)
+	}
+
+	return true
+}
+
+// tick запускает задачи, которые пора выполнить по расписанию или вручную
+func (s *service) tick(ctx context.Context) {
+	states, err := s.repo.ScheduledJobs().List(ctx)
+	if err != nil {
+		s.logger.ErrorKV(ctx, "list scheduled jobs error", "error", err.Error())
+		return
+	}
+
+	now := time.Now().UTC()
+	for _, state := range states {
+		j, ok := s.getJob(state.Name)
+		if !ok || s.isRunning(state.Name) {
+			continue
+		}
+
+		run, nextRunAt := false, state.NextRunAt
+		if !state.Paused {
+			run, nextRunAt = j.plan(state.NextRunAt, now)
+		}
+		manual := state.TriggeredAt != nil
+
+		if !run && !manual {
+			if !nextRunAt.Equal(state.NextRunAt) {
+				s.logger.WarnKV(ctx, "scheduled job run missed", "job", j.Name, "scheduled_at", state.NextRunAt)
+				if err = s.repo.ScheduledJobs().Reschedule(ctx, j.Name, nextRunAt); err != nil {
+					s.logger.ErrorKV(ctx, "reschedule job error", "job", j.Name, "error", err.Error())
+				}
+			}
+			continue
+		}
+
+		err = s.repo.ScheduledJobs().StartRun(ctx, j.Name, string(JobStatusRunning), nextRunAt, manual)
+		if err != nil {
+			s.logger.ErrorKV(ctx, "start scheduled job run error", "job", j.Name, "error", err.Error())
+			continue
+		}
+
+		s.setRunning(j.Name, true)
+		s.wg.Go(func() {
+			defer s.setRunning(j.Name, false)
+			s.execute(ctx, j, manual)
+		})
+	}
+}
+
+// execute выполняет задачу и сохраняет результат запуска
+func (s *service) execute(ctx context.Context, j *job, manual bool) {
+	s.logger.InfoKV(ctx, "scheduled job started", "job", j.Name, "manual", manual)
+
+	startedAt := time.Now()
+	err := runJob(ctx, j)
+	duration := time.Since(startedAt)
+
+	status := JobStatusSucceeded
+	var lastError *string
+	if err != nil {
+		status = JobStatusFailed
+		if ctx.Err() != nil {
+			status = JobStatusInterrupted
+		}
+		lastError = utils.Ptr(err.Error())
+		s.logger.ErrorKV(ctx, "scheduled job failed", "job", j.Name, "status", status, "error", err.Error())
+	} else {
+		s.logger.InfoKV(ctx, "scheduled job finished", "job", j.Name, "duration", duration.String())
+	}
+
+	// Результат сохраняется и после остановки планировщика
+	err = s.repo.ScheduledJobs().FinishRun(context.WithoutCancel(ctx), j.Name, string(status), lastError, duration)
+	if err != nil {
+		s.logger.ErrorKV(ctx, "finish scheduled job run error", "job", j.Name, "error", err.Error())
+	}
+}
+
+// runJob выполняет задачу, превращая панику в ошибку, чтобы она не
+// останавливала планировщик
+func runJob(ctx context.Context, j *job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return j.Run(ctx)
+}
+
+func (s *service) isRunning(name string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.running[name]
+}
+
+func (s *service) setRunning(name string, running bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if running {
+		s.running[name] = true
+	} else {
+		delete(s.running, name)
+	}
+}
@@ -0,0 +1,245 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/repository"
+	"boilerplate/internal/services/scheduler"
+)
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	service := scheduler.NewService(sp.GetLogger(), nil)
+	run := func(context.Context) error { return nil }
+
+	err := service.Register(scheduler.Job{Name: "job", Schedule: "*/5 * * * *", Timezone: "Europe/Moscow", Run: run})
+	require.NoError(t, err)
+
+	err = service.Register(scheduler.Job{Name: "descriptor", Schedule: "@every 10m", CatchUp: scheduler.CatchUpAll, Run: run})
+	require.NoError(t, err)
+
+	invalid := []scheduler.Job{
+		{Name: "job", Schedule: "@hourly", Run: run},
+		{Name: "", Schedule: "@hourly", Run: run},
+		{Name: "no-run", Schedule: "@hourly"},
+		{Name: "schedule", Schedule: "* * *", Run: run},
+		{Name: "timezone", Schedule: "@hourly", Timezone: "Mars/Olympus", Run: run},
+		{Name: "catch-up", Schedule: "@hourly", CatchUp: "sometimes", Run: run},
+	}
+	for _, job := range invalid {
+		require.Error(t, service.Register(job), job.Name)
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	service := sp.GetSchedulerService()
+	ctx := sp.Context()
+
+	var succeeded, failed, manual atomic.Int32
+	succeededJob := gofakeit.UUID()
+	failedJob := gofakeit.UUID()
+	manualJob := gofakeit.UUID()
+
+	err := service.Register(
+		scheduler.Job{
+			Name:     succeededJob,
+			Schedule: "@every 1s",
+			Run: func(context.Context) error {
+				succeeded.Add(1)
+				return nil
+			},
+		},
+		scheduler.Job{
+			Name:     failedJob,
+			Schedule: "@every 1s",
+			Run: func(context.Context) error {
+				failed.Add(1)
+				return errors.New("job failed")
+			},
+		},
+		scheduler.Job{
+			Name:     manualJob,
+			Schedule: "@yearly",
+			Run: func(context.Context) error {
+				manual.Add(1)
+				return nil
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	// До первого запуска на лидере задачи нет в базе
+	_, err = service.TriggerJob(ctx, manualJob)
+	require.True(t, errors_pkg.IsErrNotFound(err))
+
+	runService(ctx, t, service)
+
+	require.Eventually(t, func() bool {
+		return succeeded.Load() >= 2 && failed.Load() >= 1
+	}, 10*time.Second, 100*time.Millisecond)
+
+	job := getJob(t, service, succeededJob)
+	require.NotNil(t, job.LastRun)
+	require.Equal(t, "UTC", job.Timezone)
+	require.Equal(t, scheduler.CatchUpSkip, job.CatchUp)
+	require.True(t, job.NextRunAt.After(job.LastRun.StartedAt))
+
+	require.Eventually(t, func() bool {
+		job = getJob(t, service, failedJob)
+		return job.LastRun.Status == scheduler.JobStatusFailed
+	}, 5*time.Second, 100*time.Millisecond)
+	require.Equal(t, "job failed", *job.LastRun.Error)
+	require.NotNil(t, job.LastRun.Duration)
+
+	// Запуск вручную выполняется и у приостановленной задачи
+	job, err = service.PauseJob(ctx, manualJob)
+	require.NoError(t, err)
+	require.True(t, job.Paused)
+	require.Nil(t, job.LastRun)
+
+	job, err = service.TriggerJob(ctx, manualJob)
+	require.NoError(t, err)
+	require.NotNil(t, job.TriggeredAt)
+
+	require.Eventually(t, func() bool {
+		job = getJob(t, service, manualJob)
+		return job.LastRun != nil && job.LastRun.Status == scheduler.JobStatusSucceeded
+	}, 5*time.Second, 100*time.Millisecond)
+	require.Nil(t, job.TriggeredAt)
+	require.Equal(t, int32(1), manual.Load())
+
+	// Приостановленная задача не запускается по расписанию
+	_, err = service.PauseJob(ctx, succeededJob)
+	require.NoError(t, err)
+	time.Sleep(1500 * time.Millisecond)
+	paused := succeeded.Load()
+	time.Sleep(2 * time.Second)
+	require.Equal(t, paused, succeeded.Load())
+
+	job, err = service.ResumeJob(ctx, succeededJob)
+	require.NoError(t, err)
+	require.False(t, job.Paused)
+	require.True(t, job.NextRunAt.After(time.Now().Add(-time.Second)))
+
+	require.Eventually(t, func() bool {
+		return succeeded.Load() > paused
+	}, 5*time.Second, 100*time.Millisecond)
+
+	_, err = service.PauseJob(ctx, gofakeit.UUID())
+	require.True(t, errors_pkg.IsErrNotFound(err))
+}
+
+func TestCatchUp(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	service := sp.GetSchedulerService()
+	ctx := sp.Context()
+
+	// Ежегодная задача, которая не запускалась три года: последние
+	// пропущенные запуски в начале этого и двух прошлых лет
+	now := time.Now().UTC()
+	missedAt := time.Date(now.Year()-2, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runs := map[scheduler.CatchUpPolicy]*atomic.Int32{}
+	names := map[scheduler.CatchUpPolicy]string{}
+	for _, policy := range []scheduler.CatchUpPolicy{scheduler.CatchUpSkip, scheduler.CatchUpOnce, scheduler.CatchUpAll} {
+		counter := &atomic.Int32{}
+		runs[policy] = counter
+		names[policy] = gofakeit.UUID()
+
+		err := service.Register(scheduler.Job{
+			Name:     names[policy],
+			Schedule: "@yearly",
+			CatchUp:  policy,
+			Run: func(context.Context) error {
+				counter.Add(1)
+				return nil
+			},
+		})
+		require.NoError(t, err)
+
+		err = sp.GetRepo().ScheduledJobs().Sync(ctx, &repository.ScheduledJob{
+			Name:      names[policy],
+			Schedule:  "@yearly",
+			Timezone:  "UTC",
+			CatchUp:   string(policy),
+			NextRunAt: missedAt,
+		})
+		require.NoError(t, err)
+	}
+
+	runService(ctx, t, service)
+
+	nextYear := time.Date(now.Year()+1, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.Eventually(t, func() bool {
+		for _, name := range names {
+			if !getJob(t, service, name).NextRunAt.Equal(nextYear) {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// Дожидаемся завершения последних запусков
+	require.Eventually(t, func() bool {
+		return runs[scheduler.CatchUpAll].Load() == 3
+	}, 5*time.Second, 100*time.Millisecond)
+
+	require.Equal(t, int32(0), runs[scheduler.CatchUpSkip].Load())
+	require.Equal(t, int32(1), runs[scheduler.CatchUpOnce].Load())
+	require.Nil(t, getJob(t, service, names[scheduler.CatchUpSkip]).LastRun)
+}
+
+// runService запускает планировщик до завершения теста
+func runService(ctx context.Context, t *testing.T, service scheduler.Service) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		service.Run(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+func getJob(t *testing.T, service scheduler.Service, name string) *scheduler.JobInfo {
+	t.Helper()
+
+	jobs, err := service.ListJobs(context.Background())
+	require.NoError(t, err)
+
+	for _, job := range jobs {
+		if job.Name == name {
+			return job
+		}
+	}
+
+	require.FailNow(t, "job not found", name)
+	return nil
+}
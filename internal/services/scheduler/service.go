@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	logger_pkg "boilerplate/internal/pkg/logger"
+	"boilerplate/internal/repository"
+)
+
+type Service interface {
+	// Register добавляет задачи. Вызывается при запуске приложения на каждом
+	// экземпляре, до Run
+	Register(jobs ...Job) error
+	// ListJobs возвращает зарегистрированные задачи по имени
+	ListJobs(ctx context.Context) ([]*JobInfo, error)
+	// TriggerJob запрашивает запуск задачи вне расписания. Задача запускается
+	// лидером при следующей проверке, в том числе приостановленная
+	TriggerJob(ctx context.Context, name string) (*JobInfo, error)
+	// PauseJob приостанавливает запуски задачи по расписанию
+	PauseJob(ctx context.Context, name string) (*JobInfo, error)
+	// ResumeJob возобновляет запуски задачи со следующего по расписанию.
+	// Запуски за время паузы не выполняются
+	ResumeJob(ctx context.Context, name string) (*JobInfo, error)
+	// Run запускает задачи по расписанию до отмены ctx и ждет завершения
+	// выполняющихся задач. Вызывается только на лидере
+	Run(ctx context.Context)
+}
+
+type service struct {
+	logger logger_pkg.Logger
+	repo   repository.Repo
+
+	mu   sync.RWMutex
+	jobs map[string]*job
+	// running задачи, которые выполняются в данный момент
+	running map[string]bool
+	wg      sync.WaitGroup
+}
+
+func NewService(
+	logger logger_pkg.Logger,
+	repo repository.Repo,
+) Service {
+	return &service{
+		logger:  logger,
+		repo:    repo,
+		jobs:    map[string]*job{},
+		running: map[string]bool{},
+	}
+}
+
+func (s *service) Register(jobs ...Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, j := range jobs {
+		if j.Name == "" {
+			return errors.New("job name is required")
+		}
+		if _, exists := s.jobs[j.Name]; exists {
+			return fmt.Errorf("job %s already registered", j.Name)
+		}
+		if j.Run == nil {
+			return fmt.Errorf("job %s has no run function", j.Name)
+		}
+
+		schedule, err := cron.ParseStandard(j.Schedule)
+		if err != nil {
+			return fmt.Errorf("parse job %s schedule: %w", j.Name, err)
+		}
+
+		if j.Timezone == "" {
+			j.Timezone = time.UTC.String()
+		}
+		location, err := time.LoadLocation(j.Timezone)
+		if err != nil {
+			return fmt.Errorf("load job %s timezone: %w", j.Name, err)
+		}
+
+		switch j.CatchUp {
+		case "":
+			j.CatchUp = CatchUpSkip
+		case CatchUpSkip, CatchUpOnce, CatchUpAll:
+		default:
+			return fmt.Errorf("job %s has unknown catch up policy %s", j.Name, j.CatchUp)
+		}
+
+		s.jobs[j.Name] = &job{
+			Job:      j,
+			schedule: schedule,
+			location: location,
+		}
+	}
+
+	return nil
+}
+
+func (s *service) getJob(name string) (*job, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	j, ok := s.jobs[name]
+	return j, ok
+}
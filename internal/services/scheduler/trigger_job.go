@@ -0,0 +1,39 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/repository"
+)
+
+func (s *service) TriggerJob(ctx context.Context, name string) (*JobInfo, error) {
+	if _, ok := s.getJob(name); !ok {
+		return nil, errJobNotFound(ctx, name)
+	}
+
+	state, err := s.repo.ScheduledJobs().Trigger(ctx, name)
+	return s.jobInfo(ctx, name, state, err)
+}
+
+// jobInfo возвращает состояние задачи после ее изменения в базе
+func (s *service) jobInfo(ctx context.Context, name string, state *repository.ScheduledJob, err error) (*JobInfo, error) {
+	if err != nil {
+		// Задача сохраняется в базе, когда планировщик запускается на лидере
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errJobNotFound(ctx, name)
+		}
+		return nil, fmt.Errorf("update scheduled job: %w", err)
+	}
+
+	return toJobInfo(state), nil
+}
+
+func errJobNotFound(ctx context.Context, name string) error {
+	return errors_pkg.NewNotFoundError(i18n.T(ctx, i18n.KeyScheduledJobNotFound, name))
+}
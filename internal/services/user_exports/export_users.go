@@ -0,0 +1,90 @@
+package user_exports
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/db"
+	"boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/pkg/metadata"
+	"boilerplate/internal/repository"
+	"boilerplate/internal/topics"
+)
+
+// ExportUsers создает выгрузку пользователей. Небольшие выгрузки формируются
+// сразу, крупные обрабатываются асинхронно через брокер
+func (s *service) ExportUsers(ctx context.Context, req *ExportUsersRequest) (*UserExport, error) {
+	if _, exists := contentTypes[req.Format]; !exists {
+		return nil, errors.NewBadRequestError(i18n.T(ctx, i18n.KeyUserExportFormatUnknown, req.Format))
+	}
+
+	total, err := s.repo.Users().Count(ctx, toUserFilter(&req.Filter))
+	if err != nil {
+		return nil, fmt.Errorf("count users: %w", err)
+	}
+
+	if req.Format == model.UserExportFormatPDF && total > pdfLimit {
+		return nil, errors.NewBadRequestError(i18n.T(ctx, i18n.KeyUserExportPDFLimit, pdfLimit))
+	}
+
+	filter, err := json.Marshal(&req.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("marshal filter: %w", err)
+	}
+
+	userExport := &repository.UserExport{
+		Status: string(model.UserExportStatusPending),
+		Format: string(req.Format),
+		Filter: filter,
+	}
+
+	if userID, exists := metadata.GetUserID(ctx); exists {
+		userExport.CreatedBy = &userID
+	}
+
+	async := total > syncLimit
+
+	// Асинхронная выгрузка попадает к консьюмеру через outbox только после
+	// коммита строки выгрузки
+	err = s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+		err := s.repo.UserExports().Create(ctx, userExport)
+		if err != nil {
+			return fmt.Errorf("create user export: %w", err)
+		}
+
+		if !async {
+			return nil
+		}
+
+		message, err := repository.NewOutboxMessage(topics.TopicUserExportCreated, string(model.OutboxAggregateUserExport), strconv.Itoa(userExport.ID), &model.UserExportCreatedEvent{
+			ExportID: userExport.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("new user export created message: %w", err)
+		}
+
+		if err := s.repo.Outbox().Add(ctx, message); err != nil {
+			return fmt.Errorf("add user export created message: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if async {
+		return toUserExport(userExport), nil
+	}
+
+	err = s.Process(ctx, userExport.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, userExport.ID)
+}
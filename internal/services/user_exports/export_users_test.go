@@ -0,0 +1,104 @@
+package user_exports_test
+
+import (
+	"encoding/csv"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+
+	"boilerplate/internal/model"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/services/user_exports"
+)
+
+func TestExportUsers(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	users := suite_factory.NewUserFactory().Builds(3)
+	for _, user := range users {
+		err := sp.GetRepo().Users().Create(sp.Context(), user)
+		require.NoError(t, err)
+	}
+
+	filter := user_exports.UserExportFilter{
+		Emails: []string{users[0].Email, users[1].Email},
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		userExport, err := sp.GetUserExportsService().ExportUsers(sp.Context(), &user_exports.ExportUsersRequest{
+			Format: model.UserExportFormatCSV,
+			Filter: filter,
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.UserExportStatusCompleted, userExport.Status)
+		require.Nil(t, userExport.Error)
+		require.Equal(t, 2, userExport.Total)
+		require.NotNil(t, userExport.DownloadURL)
+		require.NotNil(t, userExport.FinishedAt)
+
+		file, err := sp.GetUserExportsService().GetFile(sp.Context(), userExport.ID)
+		require.NoError(t, err)
+		require.Equal(t, "users.csv", file.FileName)
+		require.Equal(t, "text/csv", file.ContentType)
+		defer func() {
+			_ = file.Content.Close()
+		}()
+
+		records, err := csv.NewReader(file.Content).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		require.Equal(t, "email", records[0][2])
+		require.Equal(t, strconv.Itoa(users[0].ID), records[1][0])
+		require.Equal(t, users[0].Email, records[1][2])
+		require.Equal(t, users[1].Email, records[2][2])
+	})
+
+	t.Run("xlsx", func(t *testing.T) {
+		userExport, err := sp.GetUserExportsService().ExportUsers(sp.Context(), &user_exports.ExportUsersRequest{
+			Format: model.UserExportFormatXLSX,
+			Filter: filter,
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.UserExportStatusCompleted, userExport.Status)
+		require.Equal(t, 2, userExport.Total)
+
+		file, err := sp.GetUserExportsService().GetFile(sp.Context(), userExport.ID)
+		require.NoError(t, err)
+		require.Equal(t, "users.xlsx", file.FileName)
+		defer func() {
+			_ = file.Content.Close()
+		}()
+
+		workbook, err := excelize.OpenReader(file.Content)
+		require.NoError(t, err)
+		defer func() {
+			_ = workbook.Close()
+		}()
+
+		rows, err := workbook.GetRows(workbook.GetSheetName(0))
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		require.Equal(t, users[0].Email, rows[1][2])
+		require.Equal(t, users[1].Email, rows[2][2])
+	})
+}
+
+func TestExportUsersUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	_, err := sp.GetUserExportsService().ExportUsers(sp.Context(), &user_exports.ExportUsersRequest{
+		Format: "doc",
+	})
+	require.Error(t, err)
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+}
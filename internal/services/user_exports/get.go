@@ -0,0 +1,24 @@
+package user_exports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+)
+
+func (s *service) Get(ctx context.Context, id int) (*UserExport, error) {
+	userExport, err := s.repo.UserExports().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors_pkg.NewNotFoundError(i18n.T(ctx, i18n.KeyUserExportNotFound, id))
+		}
+		return nil, fmt.Errorf("get user export: %w", err)
+	}
+
+	return toUserExport(userExport), nil
+}
@@ -0,0 +1,40 @@
+package user_exports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/jackc/pgx/v5"
+
+	"boilerplate/internal/model"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+)
+
+// GetFile открывает файл выгрузки в S3, не загружая его в память
+func (s *service) GetFile(ctx context.Context, id int) (*UserExportFile, error) {
+	userExport, err := s.repo.UserExports().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors_pkg.NewNotFoundError(i18n.T(ctx, i18n.KeyUserExportNotFound, id))
+		}
+		return nil, fmt.Errorf("get user export: %w", err)
+	}
+
+	if userExport.FilePath == nil {
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserExportFileNotReady, id))
+	}
+
+	reader, err := s.s3Client.DownloadFile(ctx, *userExport.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("download file: %w", err)
+	}
+
+	return &UserExportFile{
+		FileName:    path.Base(*userExport.FilePath),
+		ContentType: contentTypes[model.UserExportFormat(userExport.Format)],
+		Content:     reader,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package user_exports_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/repository"
+)
+
+func TestGetUserExport(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	userExport := &repository.UserExport{
+		Status: string(model.UserExportStatusPending),
+		Format: string(model.UserExportFormatCSV),
+		Filter: []byte(`{}`),
+	}
+	err := sp.GetRepo().UserExports().Create(sp.Context(), userExport)
+	require.NoError(t, err)
+
+	gotExport, err := sp.GetUserExportsService().Get(sp.Context(), userExport.ID)
+	require.NoError(t, err)
+	require.Equal(t, userExport.ID, gotExport.ID)
+	require.Equal(t, model.UserExportStatusPending, gotExport.Status)
+	require.Equal(t, model.UserExportFormatCSV, gotExport.Format)
+	require.Nil(t, gotExport.DownloadURL)
+
+	_, err = sp.GetUserExportsService().GetFile(sp.Context(), userExport.ID)
+	require.Error(t, err)
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+}
+
+func TestGetUserExportNotFound(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	_, err := sp.GetUserExportsService().Get(sp.Context(), -1)
+	require.Error(t, err)
+	require.True(t, errors_pkg.IsErrNotFound(err))
+
+	_, err = sp.GetUserExportsService().GetFile(sp.Context(), -1)
+	require.Error(t, err)
+	require.True(t, errors_pkg.IsErrNotFound(err))
+}
@@ -0,0 +1,77 @@
+package user_exports
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/repository"
+)
+
+type UserExport struct {
+	ID          int                    `json:"id"`
+	Status      model.UserExportStatus `json:"status"`
+	Format      model.UserExportFormat `json:"format"`
+	Total       int                    `json:"total"`
+	DownloadURL *string                `json:"download_url,omitempty"`
+	Error       *string                `json:"error,omitempty"`
+	CreatedBy   *int                   `json:"created_by,omitempty"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+	FinishedAt  *time.Time             `json:"finished_at,omitempty"`
+}
+
+type UserExportFilter struct {
+	IDs         []int          `json:"ids,omitempty"`
+	Name        *string        `json:"name,omitempty"`
+	Emails      []string       `json:"emails,omitempty"`
+	IsAdmin     *bool          `json:"is_admin,omitempty"`
+	Attributes  map[string]any `json:"attributes,omitempty"`
+	WithDeleted *bool          `json:"with_deleted,omitempty"`
+}
+
+type ExportUsersRequest struct {
+	Format model.UserExportFormat `json:"format"`
+	Filter UserExportFilter       `json:"filter"`
+}
+
+// UserExportFile файл выгрузки. Content читается из S3 по мере передачи и
+// закрывается вызывающим
+type UserExportFile struct {
+	FileName    string        `json:"file_name"`
+	ContentType string        `json:"content_type"`
+	Content     io.ReadCloser `json:"-"`
+}
+
+func toUserExport(userExport *repository.UserExport) *UserExport {
+	res := &UserExport{
+		ID:         userExport.ID,
+		Status:     model.UserExportStatus(userExport.Status),
+		Format:     model.UserExportFormat(userExport.Format),
+		Total:      userExport.Total,
+		Error:      userExport.Error,
+		CreatedBy:  userExport.CreatedBy,
+		CreatedAt:  userExport.CreatedAt,
+		UpdatedAt:  userExport.UpdatedAt,
+		FinishedAt: userExport.FinishedAt,
+	}
+
+	if userExport.FilePath != nil {
+		downloadURL := fmt.Sprintf("/api/users/exports/%d/file", userExport.ID)
+		res.DownloadURL = &downloadURL
+	}
+
+	return res
+}
+
+func toUserFilter(filter *UserExportFilter) *repository.UserFilter {
+	return &repository.UserFilter{
+		IDs:         filter.IDs,
+		Name:        filter.Name,
+		Emails:      filter.Emails,
+		IsAdmin:     filter.IsAdmin,
+		Attributes:  filter.Attributes,
+		WithDeleted: filter.WithDeleted,
+	}
+}
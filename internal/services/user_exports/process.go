@@ -0,0 +1,110 @@
+package user_exports
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/repository"
+)
+
+// Process формирует файл выгрузки и загружает его в S3. Повторный вызов для
+// уже обрабатываемой выгрузки формирует файл заново
+func (s *service) Process(ctx context.Context, id int) error {
+	userExport, err := s.repo.UserExports().Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get user export: %w", err)
+	}
+
+	switch model.UserExportStatus(userExport.Status) {
+	case model.UserExportStatusPending, model.UserExportStatusProcessing:
+	default:
+		return nil
+	}
+
+	userExport.Status = string(model.UserExportStatusProcessing)
+	userExport.Total = 0
+	err = s.repo.UserExports().Update(ctx, userExport)
+	if err != nil {
+		return fmt.Errorf("update user export: %w", err)
+	}
+
+	err = s.process(ctx, userExport)
+	if err != nil {
+		userExport.Status = string(model.UserExportStatusFailed)
+		userExport.Error = utils.Ptr(err.Error())
+		userExport.FinishedAt = utils.Ptr(time.Now().UTC())
+
+		if err := s.repo.UserExports().Update(ctx, userExport); err != nil {
+			return fmt.Errorf("update user export: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *service) process(ctx context.Context, userExport *repository.UserExport) error {
+	filter := &UserExportFilter{}
+	err := json.Unmarshal(userExport.Filter, filter)
+	if err != nil {
+		return fmt.Errorf("unmarshal filter: %w", err)
+	}
+
+	// Файл собирается во временном файле, чтобы не держать выгрузку в памяти
+	file, err := os.CreateTemp("", "user-export-*")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+
+	format := model.UserExportFormat(userExport.Format)
+	writer, err := s.newFileWriter(format, file)
+	if err != nil {
+		return fmt.Errorf("new file writer: %w", err)
+	}
+
+	total := 0
+	err = s.repo.Users().Each(ctx, toUserFilter(filter), func(user *repository.User) error {
+		total++
+		return writer.Write(user)
+	})
+	if err != nil {
+		_ = writer.Close(ctx)
+		return fmt.Errorf("write users: %w", err)
+	}
+
+	err = writer.Close(ctx)
+	if err != nil {
+		return fmt.Errorf("close file writer: %w", err)
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return fmt.Errorf("seek file: %w", err)
+	}
+
+	path := filePath(userExport.ID, format)
+	err = s.s3Client.UploadFile(ctx, path, file)
+	if err != nil {
+		return fmt.Errorf("upload file: %w", err)
+	}
+
+	userExport.Status = string(model.UserExportStatusCompleted)
+	userExport.Total = total
+	userExport.FilePath = &path
+	userExport.FinishedAt = utils.Ptr(time.Now().UTC())
+	err = s.repo.UserExports().Update(ctx, userExport)
+	if err != nil {
+		return fmt.Errorf("update user export: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package user_exports_test
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/repository"
+)
+
+func TestProcessUserExport(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	userExport := &repository.UserExport{
+		Status: string(model.UserExportStatusPending),
+		Format: string(model.UserExportFormatCSV),
+		Filter: []byte(`{"emails":["` + user.Email + `"]}`),
+	}
+	err = sp.GetRepo().UserExports().Create(sp.Context(), userExport)
+	require.NoError(t, err)
+
+	err = sp.GetUserExportsService().Process(sp.Context(), userExport.ID)
+	require.NoError(t, err)
+
+	processedExport, err := sp.GetUserExportsService().Get(sp.Context(), userExport.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.UserExportStatusCompleted, processedExport.Status)
+	require.Equal(t, 1, processedExport.Total)
+	require.NotNil(t, processedExport.DownloadURL)
+
+	// Завершенная выгрузка повторно не обрабатывается
+	err = sp.GetUserExportsService().Process(sp.Context(), userExport.ID)
+	require.NoError(t, err)
+}
+
+func TestProcessUserExportNotFound(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	// Сообщение о несуществующей выгрузке обрабатывается повторно
+	err := sp.GetUserExportsService().Process(sp.Context(), -1)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
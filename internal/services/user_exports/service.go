@@ -0,0 +1,43 @@
+package user_exports
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/clients/chrome"
+	"boilerplate/internal/pkg/clients/s3"
+	"boilerplate/internal/repository"
+)
+
+const (
+	// syncLimit максимальное количество пользователей, которое выгружается
+	// сразу в запросе. Более крупные выгрузки выполняются асинхронно
+	syncLimit = 1000
+	// pdfLimit максимальное количество пользователей в PDF, так как документ
+	// формируется в памяти целиком
+	pdfLimit = 5000
+)
+
+type Service interface {
+	ExportUsers(ctx context.Context, req *ExportUsersRequest) (*UserExport, error)
+	Get(ctx context.Context, id int) (*UserExport, error)
+	GetFile(ctx context.Context, id int) (*UserExportFile, error)
+	Process(ctx context.Context, id int) error
+}
+
+type service struct {
+	repo         repository.Repo
+	s3Client     s3.Client
+	chromeClient chrome.Client
+}
+
+func NewService(
+	repo repository.Repo,
+	s3Client s3.Client,
+	chromeClient chrome.Client,
+) Service {
+	return &service{
+		repo:         repo,
+		s3Client:     s3Client,
+		chromeClient: chromeClient,
+	}
+}
@@ -0,0 +1,232 @@
+package user_exports
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/chrome"
+	"boilerplate/internal/repository"
+)
+
+var contentTypes = map[model.UserExportFormat]string{
+	model.UserExportFormatCSV:  "text/csv",
+	model.UserExportFormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	model.UserExportFormatPDF:  "application/pdf",
+}
+
+var header = []string{"id", "name", "email", "is_admin", "deleted", "created_at", "updated_at", "deleted_at"}
+
+// fileWriter записывает пользователей в файл выгрузки по одному
+type fileWriter interface {
+	Write(user *repository.User) error
+	// Close дописывает файл. После вызова Close запись невозможна
+	Close(ctx context.Context) error
+}
+
+func filePath(id int, format model.UserExportFormat) string {
+	return fmt.Sprintf("exports/%d/users.%s", id, format)
+}
+
+func (s *service) newFileWriter(format model.UserExportFormat, w io.Writer) (fileWriter, error) {
+	switch format {
+	case model.UserExportFormatCSV:
+		return newCSVWriter(w)
+	case model.UserExportFormatXLSX:
+		return newXLSXWriter(w)
+	case model.UserExportFormatPDF:
+		return newPDFWriter(s.chromeClient, w)
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func toRecord(user *repository.User) []string {
+	deletedAt := ""
+	if user.DeletedAt != nil {
+		deletedAt = user.DeletedAt.Format(time.RFC3339)
+	}
+
+	return []string{
+		strconv.Itoa(user.ID),
+		user.Name,
+		user.Email,
+		strconv.FormatBool(user.IsAdmin),
+		strconv.FormatBool(user.Deleted),
+		user.CreatedAt.Format(time.RFC3339),
+		user.UpdatedAt.Format(time.RFC3339),
+		deletedAt,
+	}
+}
+
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (fileWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return nil, fmt.Errorf("write header: %w", err)
+	}
+
+	return &csvWriter{
+		writer: writer,
+	}, nil
+}
+
+func (w *csvWriter) Write(user *repository.User) error {
+	return w.writer.Write(toRecord(user))
+}
+
+func (w *csvWriter) Close(_ context.Context) error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type xlsxWriter struct {
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	writer io.Writer
+	row    int
+}
+
+func newXLSXWriter(w io.Writer) (fileWriter, error) {
+	file := excelize.NewFile()
+
+	// StreamWriter сбрасывает строки во временный файл, поэтому выгрузка
+	// не накапливается в памяти
+	stream, err := file.NewStreamWriter(file.GetSheetName(0))
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("new stream writer: %w", err)
+	}
+
+	writer := &xlsxWriter{
+		file:   file,
+		stream: stream,
+		writer: w,
+	}
+
+	if err := writer.writeRow(header); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("write header: %w", err)
+	}
+
+	return writer, nil
+}
+
+func (w *xlsxWriter) Write(user *repository.User) error {
+	return w.writeRow(toRecord(user))
+}
+
+func (w *xlsxWriter) writeRow(record []string) error {
+	w.row++
+
+	cell, err := excelize.CoordinatesToCellName(1, w.row)
+	if err != nil {
+		return fmt.Errorf("cell name: %w", err)
+	}
+
+	values := make([]any, 0, len(record))
+	for _, value := range record {
+		values = append(values, value)
+	}
+
+	return w.stream.SetRow(cell, values)
+}
+
+func (w *xlsxWriter) Close(_ context.Context) error {
+	defer func() {
+		_ = w.file.Close()
+	}()
+
+	if err := w.stream.Flush(); err != nil {
+		return fmt.Errorf("flush stream: %w", err)
+	}
+
+	if err := w.file.Write(w.writer); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+
+	return nil
+}
+
+var pdfTemplate = template.Must(template.New("pdf").Parse(`
+{{- define "header" -}}
+<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<style>
+body { font-family: sans-serif; font-size: 10px; margin: 20px; }
+table { width: 100%; border-collapse: collapse; }
+th, td { border: 1px solid #999; padding: 4px; text-align: left; }
+th { background: #eee; }
+thead { display: table-header-group; }
+tr { page-break-inside: avoid; }
+</style>
+</head>
+<body>
+<table>
+<thead><tr>{{ range . }}<th>{{ . }}</th>{{ end }}</tr></thead>
+<tbody>
+{{ end -}}
+{{- define "row" -}}
+<tr>{{ range . }}<td>{{ . }}</td>{{ end }}</tr>
+{{ end -}}
+{{- define "footer" -}}
+</tbody>
+</table>
+</body>
+</html>
+{{ end -}}
+`))
+
+type pdfWriter struct {
+	chromeClient chrome.Client
+	html         *bytes.Buffer
+	writer       io.Writer
+}
+
+func newPDFWriter(chromeClient chrome.Client, w io.Writer) (fileWriter, error) {
+	writer := &pdfWriter{
+		chromeClient: chromeClient,
+		html:         &bytes.Buffer{},
+		writer:       w,
+	}
+
+	if err := pdfTemplate.ExecuteTemplate(writer.html, "header", header); err != nil {
+		return nil, fmt.Errorf("write header: %w", err)
+	}
+
+	return writer, nil
+}
+
+func (w *pdfWriter) Write(user *repository.User) error {
+	return pdfTemplate.ExecuteTemplate(w.html, "row", toRecord(user))
+}
+
+func (w *pdfWriter) Close(ctx context.Context) error {
+	if err := pdfTemplate.ExecuteTemplate(w.html, "footer", nil); err != nil {
+		return fmt.Errorf("write footer: %w", err)
+	}
+
+	pdf, err := w.chromeClient.PrintToPDF(ctx, w.html, true)
+	if err != nil {
+		return fmt.Errorf("print to pdf: %w", err)
+	}
+
+	if _, err := pdf.WriteTo(w.writer); err != nil {
+		return fmt.Errorf("write pdf: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package user_imports
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/db"
+	"boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/pkg/metadata"
+	"boilerplate/internal/repository"
+	"boilerplate/internal/topics"
+)
+
+func (s *service) Create(ctx context.Context, req *UserImportCreateRequest) (*UserImport, error) {
+	if req.FilePath == "" {
+		return nil, errors.NewBadRequestError(i18n.T(ctx, i18n.KeyUserImportFileRequired))
+	}
+
+	userImport := &repository.UserImport{
+		Status:   string(model.UserImportStatusPending),
+		FilePath: req.FilePath,
+		DryRun:   req.DryRun,
+	}
+
+	if userID, exists := metadata.GetUserID(ctx); exists {
+		userImport.CreatedBy = &userID
+	}
+
+	// Событие сохраняется в outbox в транзакции импорта и публикуется после
+	// коммита, поэтому консьюмер всегда найдет импорт
+	err := s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+		err := s.repo.UserImports().Create(ctx, userImport)
+		if err != nil {
+			return fmt.Errorf("create user import: %w", err)
+		}
+
+		message, err := repository.NewOutboxMessage(topics.TopicUserImportCreated, string(model.OutboxAggregateUserImport), strconv.Itoa(userImport.ID), &model.UserImportCreatedEvent{
+			ImportID: userImport.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("new user import created message: %w", err)
+		}
+
+		if err := s.repo.Outbox().Add(ctx, message); err != nil {
+			return fmt.Errorf("add user import created message: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toUserImport(userImport), nil
+}
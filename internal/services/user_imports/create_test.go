@@ -0,0 +1,58 @@
+package user_imports_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/services/user_imports"
+	"boilerplate/internal/topics"
+)
+
+func TestCreateUserImport(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	filePath := gofakeit.UUID() + ".csv"
+
+	userImport, err := sp.GetUserImportsService().Create(sp.Context(), &user_imports.UserImportCreateRequest{
+		FilePath: filePath,
+		DryRun:   true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, userImport)
+	require.NotZero(t, userImport.ID)
+	require.Equal(t, model.UserImportStatusPending, userImport.Status)
+	require.Equal(t, filePath, userImport.FilePath)
+	require.True(t, userImport.DryRun)
+	require.NotEmpty(t, userImport.CreatedAt)
+
+	// Событие сохраняется в outbox вместе с импортом
+	messages, err := sp.GetRepo().Outbox().List(sp.Context(), string(model.OutboxAggregateUserImport), strconv.Itoa(userImport.ID))
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.Equal(t, topics.TopicUserImportCreated, messages[0].Topic)
+
+	event := &model.UserImportCreatedEvent{}
+	require.NoError(t, json.Unmarshal(messages[0].Payload, event))
+	require.Equal(t, userImport.ID, event.ImportID)
+}
+
+func TestCreateUserImportWithoutFile(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	_, err := sp.GetUserImportsService().Create(sp.Context(), &user_imports.UserImportCreateRequest{})
+	require.Error(t, err)
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+}
@@ -0,0 +1,24 @@
+package user_imports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+)
+
+func (s *service) Get(ctx context.Context, id int) (*UserImport, error) {
+	userImport, err := s.repo.UserImports().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors_pkg.NewNotFoundError(i18n.T(ctx, i18n.KeyUserImportNotFound, id))
+		}
+		return nil, fmt.Errorf("get user import: %w", err)
+	}
+
+	return toUserImport(userImport), nil
+}
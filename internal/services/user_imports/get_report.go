@@ -0,0 +1,41 @@
+package user_imports
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+)
+
+func (s *service) GetReport(ctx context.Context, id int) (*UserImportReport, error) {
+	userImport, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if userImport.ReportPath == nil {
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserImportReportNotReady, id))
+	}
+
+	reader, err := s.s3Client.DownloadFile(ctx, *userImport.ReportPath)
+	if err != nil {
+		return nil, fmt.Errorf("download report: %w", err)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("read report: %w", err)
+	}
+
+	return &UserImportReport{
+		FileName:    path.Base(*userImport.ReportPath),
+		ContentType: reportContentType,
+		Content:     content,
+	}, nil
+}
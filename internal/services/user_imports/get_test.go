@@ -0,0 +1,47 @@
+package user_imports_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/repository"
+)
+
+func TestGetUserImport(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	userImport := &repository.UserImport{
+		Status:   string(model.UserImportStatusPending),
+		FilePath: gofakeit.UUID() + ".csv",
+	}
+	err := sp.GetRepo().UserImports().Create(sp.Context(), userImport)
+	require.NoError(t, err)
+
+	gotImport, err := sp.GetUserImportsService().Get(sp.Context(), userImport.ID)
+	require.NoError(t, err)
+	require.NotNil(t, gotImport)
+	require.Equal(t, userImport.ID, gotImport.ID)
+	require.Equal(t, model.UserImportStatusPending, gotImport.Status)
+	require.Equal(t, userImport.FilePath, gotImport.FilePath)
+	require.Nil(t, gotImport.ReportPath)
+	require.Nil(t, gotImport.FinishedAt)
+}
+
+func TestGetUserImportNotFound(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	_, err := sp.GetUserImportsService().Get(sp.Context(), -1)
+	require.Error(t, err)
+	require.True(t, errors_pkg.IsErrNotFound(err))
+}
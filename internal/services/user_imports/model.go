@@ -0,0 +1,72 @@
+package user_imports
+
+import (
+	"time"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/repository"
+)
+
+type UserImport struct {
+	ID         int                    `json:"id"`
+	Status     model.UserImportStatus `json:"status"`
+	FilePath   string                 `json:"file_path"`
+	DryRun     bool                   `json:"dry_run"`
+	Total      int                    `json:"total"`
+	Processed  int                    `json:"processed"`
+	Created    int                    `json:"created"`
+	Failed     int                    `json:"failed"`
+	ReportPath *string                `json:"report_path,omitempty"`
+	Error      *string                `json:"error,omitempty"`
+	CreatedBy  *int                   `json:"created_by,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at"`
+	FinishedAt *time.Time             `json:"finished_at,omitempty"`
+}
+
+type UserImportCreateRequest struct {
+	FilePath string `json:"file_path"`
+	DryRun   bool   `json:"dry_run"`
+}
+
+type UserImportReport struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Content     []byte `json:"-"`
+}
+
+type rowStatus string
+
+const (
+	rowStatusCreated rowStatus = "created"
+	rowStatusValid   rowStatus = "valid"
+	rowStatusError   rowStatus = "error"
+)
+
+type row struct {
+	Line     int
+	Name     string
+	Email    string
+	Password string
+	Status   rowStatus
+	Error    string
+}
+
+func toUserImport(userImport *repository.UserImport) *UserImport {
+	return &UserImport{
+		ID:         userImport.ID,
+		Status:     model.UserImportStatus(userImport.Status),
+		FilePath:   userImport.FilePath,
+		DryRun:     userImport.DryRun,
+		Total:      userImport.Total,
+		Processed:  userImport.Processed,
+		Created:    userImport.Created,
+		Failed:     userImport.Failed,
+		ReportPath: userImport.ReportPath,
+		Error:      userImport.Error,
+		CreatedBy:  userImport.CreatedBy,
+		CreatedAt:  userImport.CreatedAt,
+		UpdatedAt:  userImport.UpdatedAt,
+		FinishedAt: userImport.FinishedAt,
+	}
+}
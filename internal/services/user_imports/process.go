@@ -0,0 +1,182 @@
+package user_imports
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/db"
+	"boilerplate/internal/pkg/pwd"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/repository"
+)
+
+// Process выполняет импорт пользователей. Повторный вызов для уже
+// обрабатываемого импорта продолжает его: пользователи, созданные ранее,
+// попадут в отчет как уже существующие
+func (s *service) Process(ctx context.Context, id int) error {
+	userImport, err := s.repo.UserImports().Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get user import: %w", err)
+	}
+
+	switch model.UserImportStatus(userImport.Status) {
+	case model.UserImportStatusPending, model.UserImportStatusProcessing:
+	default:
+		return nil
+	}
+
+	userImport.Status = string(model.UserImportStatusProcessing)
+	userImport.Total, userImport.Processed, userImport.Created, userImport.Failed = 0, 0, 0, 0
+	err = s.repo.UserImports().Update(ctx, userImport)
+	if err != nil {
+		return fmt.Errorf("update user import: %w", err)
+	}
+
+	err = s.process(ctx, userImport)
+	if err != nil {
+		userImport.Status = string(model.UserImportStatusFailed)
+		userImport.Error = utils.Ptr(err.Error())
+		userImport.FinishedAt = utils.Ptr(time.Now().UTC())
+
+		if err := s.repo.UserImports().Update(ctx, userImport); err != nil {
+			return fmt.Errorf("update user import: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *service) process(ctx context.Context, userImport *repository.UserImport) error {
+	reader, err := s.s3Client.DownloadFile(ctx, userImport.FilePath)
+	if err != nil {
+		return fmt.Errorf("download file: %w", err)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	rows, err := readRows(ctx, reader)
+	if err != nil {
+		return err
+	}
+
+	err = s.validateRows(ctx, rows)
+	if err != nil {
+		return fmt.Errorf("validate rows: %w", err)
+	}
+
+	userImport.Total = len(rows)
+	err = s.repo.UserImports().Update(ctx, userImport)
+	if err != nil {
+		return fmt.Errorf("update user import: %w", err)
+	}
+
+	for batch := range slices.Chunk(rows, batchSize) {
+		err = s.processBatch(ctx, userImport, batch)
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.UserImports().Update(ctx, userImport)
+		if err != nil {
+			return fmt.Errorf("update user import: %w", err)
+		}
+	}
+
+	report, err := buildReport(rows)
+	if err != nil {
+		return fmt.Errorf("build report: %w", err)
+	}
+
+	path := reportPath(userImport.ID)
+	err = s.s3Client.UploadFile(ctx, path, bytes.NewReader(report))
+	if err != nil {
+		return fmt.Errorf("upload report: %w", err)
+	}
+
+	userImport.Status = string(model.UserImportStatusCompleted)
+	userImport.ReportPath = &path
+	userImport.FinishedAt = utils.Ptr(time.Now().UTC())
+	err = s.repo.UserImports().Update(ctx, userImport)
+	if err != nil {
+		return fmt.Errorf("update user import: %w", err)
+	}
+
+	return nil
+}
+
+// processBatch создает пользователей пачки в одной транзакции
+func (s *service) processBatch(ctx context.Context, userImport *repository.UserImport, batch []*row) error {
+	validRows := make([]*row, 0, len(batch))
+	users := make([]*repository.User, 0, len(batch))
+
+	for _, row := range batch {
+		if row.Status == rowStatusError {
+			userImport.Failed++
+			continue
+		}
+
+		validRows = append(validRows, row)
+
+		if userImport.DryRun {
+			row.Status = rowStatusValid
+			continue
+		}
+
+		password, err := pwd.HashPassword(row.Password)
+		if err != nil {
+			return fmt.Errorf("hash password: %w", err)
+		}
+
+		users = append(users, &repository.User{
+			Name:     row.Name,
+			Email:    row.Email,
+			Password: password,
+		})
+	}
+
+	if len(users) > 0 {
+		err := s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+			for _, user := range users {
+				if err := s.repo.Users().Create(ctx, user); err != nil {
+					return fmt.Errorf("create user %s: %w", user.Email, err)
+				}
+				if err := s.addUserCreatedEvent(ctx, user); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("create users: %w", err)
+		}
+
+		for _, row := range validRows {
+			row.Status = rowStatusCreated
+		}
+		userImport.Created += len(users)
+	}
+
+	userImport.Processed += len(batch)
+
+	return nil
+}
+
+// addUserCreatedEvent сохраняет событие о создании пользователя в outbox в
+// транзакции создания
+func (s *service) addUserCreatedEvent(ctx context.Context, user *repository.User) error {
+	message, err := repository.NewUserCreatedMessage(user)
+	if err != nil {
+		return fmt.Errorf("new user created message: %w", err)
+	}
+
+	if err := s.repo.Outbox().Add(ctx, message); err != nil {
+		return fmt.Errorf("add user created message: %w", err)
+	}
+
+	return nil
+}
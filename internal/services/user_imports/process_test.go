@@ -0,0 +1,138 @@
+package user_imports_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/repository"
+)
+
+func TestProcessUserImport(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	for _, dryRun := range []bool{false, true} {
+		t.Run(fmt.Sprintf("dry run %t", dryRun), func(t *testing.T) {
+			existingUser := suite_factory.NewUserFactory().Build()
+			err := sp.GetRepo().Users().Create(sp.Context(), existingUser)
+			require.NoError(t, err)
+
+			validEmails := []string{gofakeit.Email(), gofakeit.Email()}
+			duplicateEmail := gofakeit.Email()
+
+			content := "name,email,password\n" +
+				"User One," + validEmails[0] + ",secret123\n" +
+				"User Two," + validEmails[1] + ",secret456\n" +
+				"Bad Email,not-an-email,secret123\n" +
+				"Duplicate One," + duplicateEmail + ",secret123\n" +
+				"Duplicate Two," + duplicateEmail + ",secret123\n" +
+				"Weak Password," + gofakeit.Email() + ",123\n" +
+				"Existing," + existingUser.Email + ",secret123\n"
+
+			filePath := fmt.Sprintf("imports/%s.csv", gofakeit.UUID())
+			err = sp.GetS3Client().UploadFile(sp.Context(), filePath, bytes.NewReader([]byte(content)))
+			require.NoError(t, err)
+
+			userImport := &repository.UserImport{
+				Status:   string(model.UserImportStatusPending),
+				FilePath: filePath,
+				DryRun:   dryRun,
+			}
+			err = sp.GetRepo().UserImports().Create(sp.Context(), userImport)
+			require.NoError(t, err)
+
+			err = sp.GetUserImportsService().Process(sp.Context(), userImport.ID)
+			require.NoError(t, err)
+
+			processedImport, err := sp.GetUserImportsService().Get(sp.Context(), userImport.ID)
+			require.NoError(t, err)
+			require.Equal(t, model.UserImportStatusCompleted, processedImport.Status)
+			require.Nil(t, processedImport.Error)
+			require.Equal(t, 7, processedImport.Total)
+			require.Equal(t, 7, processedImport.Processed)
+			require.Equal(t, 5, processedImport.Failed)
+			require.NotNil(t, processedImport.ReportPath)
+			require.NotNil(t, processedImport.FinishedAt)
+
+			users, err := sp.GetRepo().Users().Search(sp.Context(), &repository.UserFilter{
+				Emails: validEmails,
+			})
+			require.NoError(t, err)
+
+			expectedStatus := "created"
+			if dryRun {
+				expectedStatus = "valid"
+				require.Zero(t, processedImport.Created)
+				require.Empty(t, users.Result)
+			} else {
+				require.Equal(t, 2, processedImport.Created)
+				require.Len(t, users.Result, 2)
+			}
+
+			report, err := sp.GetUserImportsService().GetReport(sp.Context(), userImport.ID)
+			require.NoError(t, err)
+
+			records, err := csv.NewReader(bytes.NewReader(report.Content)).ReadAll()
+			require.NoError(t, err)
+			require.Len(t, records, 8)
+			require.Equal(t, []string{"line", "email", "status", "error"}, records[0])
+			for _, record := range records[1:3] {
+				require.Equal(t, expectedStatus, record[2])
+				require.Empty(t, record[3])
+			}
+			for _, record := range records[3:] {
+				require.Equal(t, "error", record[2])
+				require.NotEmpty(t, record[3])
+			}
+		})
+	}
+}
+
+func TestProcessUserImportWrongHeader(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	filePath := fmt.Sprintf("imports/%s.csv", gofakeit.UUID())
+	err := sp.GetS3Client().UploadFile(sp.Context(), filePath, bytes.NewReader([]byte("name,email\n")))
+	require.NoError(t, err)
+
+	userImport := &repository.UserImport{
+		Status:   string(model.UserImportStatusPending),
+		FilePath: filePath,
+	}
+	err = sp.GetRepo().UserImports().Create(sp.Context(), userImport)
+	require.NoError(t, err)
+
+	err = sp.GetUserImportsService().Process(sp.Context(), userImport.ID)
+	require.NoError(t, err)
+
+	processedImport, err := sp.GetUserImportsService().Get(sp.Context(), userImport.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.UserImportStatusFailed, processedImport.Status)
+	require.NotNil(t, processedImport.Error)
+	require.Nil(t, processedImport.ReportPath)
+}
+
+func TestProcessUserImportNotFound(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	// Сообщение о несуществующем импорте обрабатывается повторно
+	err := sp.GetUserImportsService().Process(sp.Context(), -1)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
@@ -0,0 +1,39 @@
+package user_imports
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+)
+
+const reportContentType = "text/csv"
+
+func reportPath(id int) string {
+	return fmt.Sprintf("imports/%d/report.csv", id)
+}
+
+// buildReport формирует построчный отчет по импорту
+func buildReport(rows []*row) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	writer := csv.NewWriter(buf)
+
+	err := writer.Write([]string{"line", "email", "status", "error"})
+	if err != nil {
+		return nil, fmt.Errorf("write header: %w", err)
+	}
+
+	for _, row := range rows {
+		err = writer.Write([]string{strconv.Itoa(row.Line), row.Email, string(row.Status), row.Error})
+		if err != nil {
+			return nil, fmt.Errorf("write row %d: %w", row.Line, err)
+		}
+	}
+
+	writer.Flush()
+	if err = writer.Error(); err != nil {
+		return nil, fmt.Errorf("flush report: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,167 @@
+package user_imports
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"slices"
+	"strings"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/pkg/pwd"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/repository"
+)
+
+const (
+	columnName     = "name"
+	columnEmail    = "email"
+	columnPassword = "password"
+)
+
+// readRows читает строки файла импорта. Первая строка файла - заголовок
+// с колонками name, email, password в произвольном порядке
+func readRows(ctx context.Context, r io.Reader) ([]*row, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserImportFileEmpty))
+		}
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.TrimPrefix(column, "\ufeff")
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+
+	for _, column := range []string{columnName, columnEmail, columnPassword} {
+		if _, exists := columns[column]; !exists {
+			return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserImportColumnMissing, column))
+		}
+	}
+
+	field := func(record []string, column string) string {
+		idx := columns[column]
+		if idx >= len(record) {
+			return ""
+		}
+		return record[idx]
+	}
+
+	rows := []*row{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rows = append(rows, &row{
+				Line:   parseErr.StartLine,
+				Status: rowStatusError,
+				Error:  "Некорректная строка файла",
+			})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read row: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		rows = append(rows, &row{
+			Line:     line,
+			Name:     strings.TrimSpace(field(record, columnName)),
+			Email:    strings.TrimSpace(field(record, columnEmail)),
+			Password: field(record, columnPassword),
+		})
+	}
+
+	return rows, nil
+}
+
+// validateRows проверяет строки импорта и помечает ошибочные
+func (s *service) validateRows(ctx context.Context, rows []*row) error {
+	emailsCounter := utils.ToCounter(rows, func(row *row) string {
+		return strings.ToLower(row.Email)
+	})
+
+	for _, row := range rows {
+		if row.Status == rowStatusError {
+			continue
+		}
+
+		switch {
+		case row.Name == "":
+			row.Error = "Не указано имя пользователя"
+		case row.Email == "":
+			row.Error = "Не указан email пользователя"
+		case !isValidEmail(row.Email):
+			row.Error = "Некорректный email пользователя"
+		case emailsCounter.Count(strings.ToLower(row.Email)) > 1:
+			row.Error = "Email повторяется в файле импорта"
+		default:
+			if err := pwd.CheckPolicy(row.Password); err != nil {
+				row.Error = err.Error()
+			}
+		}
+
+		if row.Error != "" {
+			row.Status = rowStatusError
+		}
+	}
+
+	validRows := make([]*row, 0, len(rows))
+	for _, row := range rows {
+		if row.Status != rowStatusError {
+			validRows = append(validRows, row)
+		}
+	}
+
+	for chunk := range slices.Chunk(validRows, batchSize) {
+		emails := make([]string, 0, len(chunk))
+		for _, row := range chunk {
+			emails = append(emails, row.Email)
+		}
+
+		users, err := s.repo.Users().Search(ctx, &repository.UserFilter{
+			Emails:      emails,
+			WithDeleted: utils.Ptr(true),
+		})
+		if err != nil {
+			return fmt.Errorf("search existing users: %w", err)
+		}
+
+		existing := utils.ToSet(users.Result, func(user *repository.User) string {
+			return strings.ToLower(user.Email)
+		})
+
+		for _, row := range chunk {
+			if existing.Has(strings.ToLower(row.Email)) {
+				row.Status = rowStatusError
+				row.Error = "Пользователь с таким email уже существует"
+			}
+		}
+	}
+
+	return nil
+}
+
+func isValidEmail(email string) bool {
+	address, err := mail.ParseAddress(email)
+	if err != nil {
+		return false
+	}
+	return address.Address == email
+}
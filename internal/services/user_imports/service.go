@@ -0,0 +1,35 @@
+package user_imports
+
+import (
+	"context"
+
+	"boilerplate/internal/pkg/clients/s3"
+	"boilerplate/internal/repository"
+)
+
+const (
+	// batchSize количество пользователей, создаваемых в одной транзакции
+	batchSize = 100
+)
+
+type Service interface {
+	Create(ctx context.Context, req *UserImportCreateRequest) (*UserImport, error)
+	Get(ctx context.Context, id int) (*UserImport, error)
+	GetReport(ctx context.Context, id int) (*UserImportReport, error)
+	Process(ctx context.Context, id int) error
+}
+
+type service struct {
+	repo     repository.Repo
+	s3Client s3.Client
+}
+
+func NewService(
+	repo repository.Repo,
+	s3Client s3.Client,
+) Service {
+	return &service{
+		repo:     repo,
+		s3Client: s3Client,
+	}
+}
@@ -0,0 +1,25 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/pkg/schema"
+)
+
+// validateAttributes проверяет атрибуты пользователя по настроенной JSON Schema
+func (s *service) validateAttributes(ctx context.Context, attributes map[string]any) error {
+	err := s.attributesValidator.Validate(attributes)
+	if err != nil {
+		validationErr := &schema.ValidationError{}
+		if errors.As(err, &validationErr) {
+			return errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserAttributesInvalid, validationErr))
+		}
+		return fmt.Errorf("validate attributes: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package users_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/schema"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/services/users"
+)
+
+const attributesSchema = `{
+	"type": "object",
+	"properties": {
+		"department": {"type": "string"},
+		"level": {"type": "integer", "minimum": 1}
+	},
+	"additionalProperties": false
+}`
+
+func newAttributesService(t *testing.T, sp *suite_provider.Provider) users.Service {
+	t.Helper()
+
+	validator, err := schema.NewValidator([]byte(attributesSchema))
+	require.NoError(t, err)
+
+	return users.NewService(&sp.GetConfig().Users, sp.GetRepo(), validator, sp.GetMailClient())
+}
+
+func TestCreateUserAttributes(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	service := newAttributesService(t, sp)
+
+	createdUser, err := service.Create(sp.Context(), &users.UserCreateRequest{
+		Name:       gofakeit.Name(),
+		Email:      gofakeit.Email(),
+		Password:   gofakeit.Word(),
+		Attributes: map[string]any{"department": "sales", "level": float64(2)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"department": "sales", "level": float64(2)}, createdUser.Attributes)
+
+	createdUser, err = service.Create(sp.Context(), &users.UserCreateRequest{
+		Name:     gofakeit.Name(),
+		Email:    gofakeit.Email(),
+		Password: gofakeit.Word(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, createdUser.Attributes)
+
+	_, err = service.Create(sp.Context(), &users.UserCreateRequest{
+		Name:       gofakeit.Name(),
+		Email:      gofakeit.Email(),
+		Password:   gofakeit.Word(),
+		Attributes: map[string]any{"level": float64(0), "unknown": true},
+	})
+	require.Error(t, err)
+	require.True(t, errors.IsErrBadRequest(err))
+}
+
+func TestUpdateUserAttributes(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	service := newAttributesService(t, sp)
+
+	user := suite_factory.NewUserFactory().WithAttributes(map[string]any{"department": "sales"}).Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	_, err = service.Update(sp.Context(), &users.UserUpdateRequest{
+		ID:         user.ID,
+		Attributes: map[string]any{"level": "high"},
+	})
+	require.Error(t, err)
+	require.True(t, errors.IsErrBadRequest(err))
+
+	updatedUser, err := service.Update(sp.Context(), &users.UserUpdateRequest{
+		ID:         user.ID,
+		Attributes: map[string]any{"level": float64(3)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"level": float64(3)}, updatedUser.Attributes)
+
+	// Имя обновляется без изменения атрибутов
+	updatedUser, err = service.Update(sp.Context(), &users.UserUpdateRequest{
+		ID:   user.ID,
+		Name: utils.Ptr(gofakeit.Name()),
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"level": float64(3)}, updatedUser.Attributes)
+
+	// Атрибуты в маске без значения очищаются
+	updatedUser, err = service.Update(sp.Context(), &users.UserUpdateRequest{
+		ID:         user.ID,
+		UpdateMask: []string{"attributes"},
+	})
+	require.NoError(t, err)
+	require.Empty(t, updatedUser.Attributes)
+}
@@ -0,0 +1,100 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/db"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/repository"
+)
+
+// ChangeEmail запрашивает смену email пользователя. Новый email вступает в
+// силу после подтверждения по ссылке, отправленной на него, а на прежний email
+// отправляется ссылка для отмены смены
+func (s *service) ChangeEmail(ctx context.Context, req *UserChangeEmailRequest) (*UserEmailChange, error) {
+	email := strings.TrimSpace(req.Email)
+
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserEmailInvalid))
+	}
+
+	user, err := s.getUser(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkETag(ctx, user, req.ETag)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(user.Email, email) {
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserEmailUnchanged))
+	}
+
+	users, err := s.repo.Users().Search(ctx, &repository.UserFilter{
+		Emails:      []string{email},
+		WithDeleted: utils.Ptr(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search existing users: %w", err)
+	}
+	if len(users.Result) > 0 {
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserEmailExists))
+	}
+
+	confirmToken, confirmTokenHash, err := newToken()
+	if err != nil {
+		return nil, fmt.Errorf("new confirm token: %w", err)
+	}
+
+	undoToken, undoTokenHash, err := newToken()
+	if err != nil {
+		return nil, fmt.Errorf("new undo token: %w", err)
+	}
+
+	now := time.Now().UTC()
+	change := &repository.UserEmailChange{
+		UserID:           user.ID,
+		OldEmail:         user.Email,
+		NewEmail:         email,
+		Status:           string(model.UserEmailChangeStatusPending),
+		ConfirmTokenHash: confirmTokenHash,
+		UndoTokenHash:    undoTokenHash,
+		ExpiresAt:        now.Add(time.Duration(s.config.EmailChangeTTL) * time.Second),
+		UndoExpiresAt:    now.Add(time.Duration(s.config.EmailUndoTTL) * time.Second),
+	}
+
+	// Действует только последний запрос, предыдущие неподтвержденные отменяются
+	err = s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+		err := s.repo.UserEmailChanges().SetStatus(ctx, user.ID, string(model.UserEmailChangeStatusPending), string(model.UserEmailChangeStatusCancelled))
+		if err != nil {
+			return fmt.Errorf("cancel pending email changes: %w", err)
+		}
+
+		err = s.repo.UserEmailChanges().Create(ctx, change)
+		if err != nil {
+			return fmt.Errorf("create email change: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.sendEmailChangeLetters(ctx, user, change, confirmToken, undoToken)
+	if err != nil {
+		return nil, fmt.Errorf("send email change letters: %w", err)
+	}
+
+	return toUserEmailChange(change), nil
+}
@@ -0,0 +1,171 @@
+package users_test
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/mail"
+	mail_mocks "boilerplate/internal/pkg/clients/mail/mocks"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/services/users"
+)
+
+var tokenRe = regexp.MustCompile(`token=([0-9a-f]+)`)
+
+// mailbox запоминает токены из отправленных писем по адресу получателя
+type mailbox struct {
+	mu     sync.Mutex
+	tokens map[string]string
+}
+
+func newMailbox(t *testing.T, sp *suite_provider.Provider) *mailbox {
+	t.Helper()
+
+	box := &mailbox{
+		tokens: map[string]string{},
+	}
+
+	mailClient, ok := sp.GetMailClient().(*mail_mocks.Client)
+	require.True(t, ok)
+
+	mailClient.EXPECT().
+		Send(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, to, _, body string, _ []*mail.Attachment) {
+			box.mu.Lock()
+			defer box.mu.Unlock()
+			if match := tokenRe.FindStringSubmatch(body); match != nil {
+				box.tokens[strings.ToLower(to)] = match[1]
+			}
+		}).
+		Return(nil)
+
+	return box
+}
+
+func (b *mailbox) token(to string) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.tokens[strings.ToLower(to)]
+}
+
+func TestChangeEmail(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	box := newMailbox(t, sp)
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	newEmail := "new." + user.Email
+
+	change, err := sp.GetUserService().ChangeEmail(sp.Context(), &users.UserChangeEmailRequest{
+		ID:    user.ID,
+		Email: newEmail,
+	})
+	require.NoError(t, err)
+	require.Equal(t, model.UserEmailChangeStatusPending, change.Status)
+	require.Equal(t, newEmail, change.NewEmail)
+	require.NotEmpty(t, box.token(newEmail))
+	require.NotEmpty(t, box.token(user.Email))
+
+	// До подтверждения email не меняется
+	gotUser, err := sp.GetUserService().Get(sp.Context(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, user.Email, gotUser.Email)
+
+	confirmedUser, err := sp.GetUserService().ConfirmEmailChange(sp.Context(), box.token(newEmail))
+	require.NoError(t, err)
+	require.Equal(t, newEmail, confirmedUser.Email)
+
+	// Повторно ссылка не действует
+	_, err = sp.GetUserService().ConfirmEmailChange(sp.Context(), box.token(newEmail))
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+
+	// Владелец прежнего email откатывает смену
+	undoneUser, err := sp.GetUserService().UndoEmailChange(sp.Context(), box.token(user.Email))
+	require.NoError(t, err)
+	require.Equal(t, user.Email, undoneUser.Email)
+
+	_, err = sp.GetUserService().UndoEmailChange(sp.Context(), box.token(user.Email))
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+
+	_, err = sp.GetUserService().ConfirmEmailChange(sp.Context(), "unknown")
+	require.True(t, errors_pkg.IsErrNotFound(err))
+}
+
+func TestChangeEmailUndoPending(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	box := newMailbox(t, sp)
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	newEmail := "new." + user.Email
+
+	_, err = sp.GetUserService().ChangeEmail(sp.Context(), &users.UserChangeEmailRequest{
+		ID:    user.ID,
+		Email: newEmail,
+	})
+	require.NoError(t, err)
+
+	undoneUser, err := sp.GetUserService().UndoEmailChange(sp.Context(), box.token(user.Email))
+	require.NoError(t, err)
+	require.Equal(t, user.Email, undoneUser.Email)
+
+	// Отмененную смену нельзя подтвердить
+	_, err = sp.GetUserService().ConfirmEmailChange(sp.Context(), box.token(newEmail))
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+}
+
+func TestChangeEmailValidation(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	newMailbox(t, sp)
+
+	existing := suite_factory.NewUserFactory().Builds(2)
+	for _, user := range existing {
+		err := sp.GetRepo().Users().Create(sp.Context(), user)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		Name  string
+		Email string
+	}{
+		{Name: "invalid email", Email: "not-an-email"},
+		{Name: "same email", Email: strings.ToUpper(existing[0].Email)},
+		{Name: "taken email", Email: strings.ToUpper(existing[1].Email)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := sp.GetUserService().ChangeEmail(sp.Context(), &users.UserChangeEmailRequest{
+				ID:    existing[0].ID,
+				Email: tc.Email,
+			})
+			require.Error(t, err)
+			require.True(t, errors_pkg.IsErrBadRequest(err))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/db"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/repository"
+)
+
+// ConfirmEmailChange устанавливает новый email по токену из письма,
+// отправленного на этот email
+func (s *service) ConfirmEmailChange(ctx context.Context, token string) (*User, error) {
+	change, err := s.repo.UserEmailChanges().GetByConfirmTokenHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newErrEmailChangeNotFound(ctx)
+		}
+		return nil, fmt.Errorf("get email change: %w", err)
+	}
+
+	if model.UserEmailChangeStatus(change.Status) != model.UserEmailChangeStatusPending {
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyEmailChangeCompleted))
+	}
+
+	if isExpired(change.ExpiresAt) {
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyEmailChangeExpired))
+	}
+
+	var user *repository.User
+
+	err = s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+		var err error
+		user, err = s.getUser(ctx, change.UserID)
+		if err != nil {
+			return err
+		}
+
+		err = s.setEmail(ctx, user, change.NewEmail)
+		if err != nil {
+			return err
+		}
+
+		change.Status = string(model.UserEmailChangeStatusConfirmed)
+		change.ConfirmedAt = utils.Ptr(time.Now().UTC())
+
+		err = s.repo.UserEmailChanges().Update(ctx, change)
+		if err != nil {
+			return fmt.Errorf("update email change: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toUser(user), nil
+}
@@ -2,9 +2,12 @@ package users
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/clients/db"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
 	"boilerplate/internal/pkg/pwd"
 	"boilerplate/internal/pkg/utils"
 	"boilerplate/internal/repository"
@@ -12,13 +15,20 @@ import (
 
 func (s *service) Create(ctx context.Context, req *UserCreateRequest) (*User, error) {
 	if req.Name == "" {
-		return nil, errors.NewBadRequestError("Не указано имя пользователя")
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserNameRequired))
 	}
 	if req.Email == "" {
-		return nil, errors.NewBadRequestError("Не указан email пользователя")
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserEmailRequired))
 	}
 	if req.Password == "" {
-		return nil, errors.NewBadRequestError("Не указан пароль пользователя")
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserPasswordRequired))
+	}
+
+	if req.Attributes == nil {
+		req.Attributes = map[string]any{}
+	}
+	if err := s.validateAttributes(ctx, req.Attributes); err != nil {
+		return nil, err
 	}
 
 	users, err := s.repo.Users().Search(ctx, &repository.UserFilter{
@@ -29,12 +39,13 @@ func (s *service) Create(ctx context.Context, req *UserCreateRequest) (*User, er
 		return nil, fmt.Errorf("search existing users: %w", err)
 	}
 	if len(users.Result) > 0 {
-		return nil, errors.NewBadRequestError("Пользователь с таким email уже существует")
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserEmailExists))
 	}
 
 	user := &repository.User{
-		Name:  req.Name,
-		Email: req.Email,
+		Name:       req.Name,
+		Email:      req.Email,
+		Attributes: req.Attributes,
 	}
 
 	if len(req.Password) > 0 {
@@ -45,8 +56,16 @@ func (s *service) Create(ctx context.Context, req *UserCreateRequest) (*User, er
 		}
 	}
 
-	err = s.repo.Users().Create(ctx, user)
+	err = s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+		if err := s.repo.Users().Create(ctx, user); err != nil {
+			return err
+		}
+		return s.addUserCreatedEvent(ctx, user)
+	})
 	if err != nil {
+		if errors.Is(err, repository.ErrEmailExists) {
+			return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserEmailExists))
+		}
 		return nil, fmt.Errorf("create user: %w", err)
 	}
 
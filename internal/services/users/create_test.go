@@ -6,6 +6,9 @@ import (
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/stretchr/testify/require"
 
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/pkg/metadata"
 	"boilerplate/internal/pkg/pwd"
 	suite_factory "boilerplate/internal/pkg/suite/factory"
 	suite_provider "boilerplate/internal/pkg/suite/provider"
@@ -35,3 +38,28 @@ func TestCreateUser(t *testing.T) {
 	require.NotEmpty(t, createdUser.CreatedAt)
 	require.NotEmpty(t, createdUser.UpdatedAt)
 }
+
+func TestCreateUserLocalizedErrors(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	req := &users.UserCreateRequest{
+		Name:     gofakeit.Name(),
+		Email:    user.Email,
+		Password: gofakeit.Word(),
+	}
+
+	_, err = sp.GetUserService().Create(sp.Context(), req)
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+	require.Equal(t, "Пользователь с таким email уже существует", err.Error())
+
+	_, err = sp.GetUserService().Create(metadata.WithLocale(sp.Context(), i18n.LocaleEN), req)
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+	require.Equal(t, "User with this email already exists", err.Error())
+}
@@ -7,20 +7,36 @@ import (
 
 	"github.com/jackc/pgx/v5"
 
+	"boilerplate/internal/pkg/clients/db"
 	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/repository"
 )
 
-func (s *service) Delete(ctx context.Context, id int) error {
-	_, err := s.repo.Users().Get(ctx, id)
+func (s *service) Delete(ctx context.Context, req *UserDeleteRequest) error {
+	user, err := s.repo.Users().Get(ctx, req.ID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return errors_pkg.NewNotFoundError(fmt.Sprintf("Пользователь %d не найден", id))
+			return errors_pkg.NewNotFoundError(i18n.T(ctx, i18n.KeyUserNotFound, req.ID))
 		}
 		return fmt.Errorf("get user: %w", err)
 	}
 
-	err = s.repo.Users().Delete(ctx, id)
+	err = checkETag(ctx, user, req.ETag)
 	if err != nil {
+		return err
+	}
+
+	err = s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+		if err := s.repo.Users().Delete(ctx, user.ID, user.Version); err != nil {
+			return err
+		}
+		return s.addUserDeletedEvent(ctx, user.ID)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return newErrModified(ctx, user.ID)
+		}
 		return fmt.Errorf("delete user: %w", err)
 	}
 
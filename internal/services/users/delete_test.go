@@ -5,8 +5,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/etag"
 	suite_factory "boilerplate/internal/pkg/suite/factory"
 	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/services/users"
 )
 
 func TestDeleteUser(t *testing.T) {
@@ -19,7 +23,9 @@ func TestDeleteUser(t *testing.T) {
 	err := sp.GetRepo().Users().Create(sp.Context(), user)
 	require.NoError(t, err)
 
-	err = sp.GetUserService().Delete(sp.Context(), user.ID)
+	err = sp.GetUserService().Delete(sp.Context(), &users.UserDeleteRequest{
+		ID: user.ID,
+	})
 	require.NoError(t, err)
 
 	deletedUser, err := sp.GetUserService().Get(sp.Context(), user.ID)
@@ -28,3 +34,27 @@ func TestDeleteUser(t *testing.T) {
 	require.True(t, deletedUser.Deleted)
 	require.NotEmpty(t, deletedUser.DeletedAt)
 }
+
+func TestDeleteUserETag(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	err = sp.GetUserService().Delete(sp.Context(), &users.UserDeleteRequest{
+		ID:   user.ID,
+		ETag: utils.Ptr(etag.FromVersion(user.Version + 1)),
+	})
+	require.Error(t, err)
+	require.True(t, errors_pkg.IsErrPreconditionFailed(err))
+
+	err = sp.GetUserService().Delete(sp.Context(), &users.UserDeleteRequest{
+		ID:   user.ID,
+		ETag: utils.Ptr(etag.FromVersion(user.Version)),
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,160 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/url"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/repository"
+)
+
+// tokenSize размер случайной части токенов подтверждения и отмены в байтах
+const tokenSize = 32
+
+const timeLayout = "02.01.2006 15:04 UTC"
+
+// newErrEmailChangeNotFound токен не соответствует ни одному запросу на смену email
+func newErrEmailChangeNotFound(ctx context.Context) error {
+	return errors_pkg.NewNotFoundError(i18n.T(ctx, i18n.KeyEmailChangeNotFound))
+}
+
+var confirmEmailTemplate = template.Must(template.New("confirm").Parse(`<p>Здравствуйте, {{.Name}}!</p>
+<p>Для учетной записи запрошена смена email на {{.NewEmail}}.</p>
+<p>Чтобы подтвердить новый адрес, перейдите по <a href="{{.URL}}">ссылке</a>. Ссылка действительна до {{.ExpiresAt}}.</p>
+<p>Если вы не запрашивали смену email, просто проигнорируйте это письмо.</p>`))
+
+var undoEmailTemplate = template.Must(template.New("undo").Parse(`<p>Здравствуйте, {{.Name}}!</p>
+<p>Для вашей учетной записи запрошена смена email на {{.NewEmail}}.</p>
+<p>Если это были не вы, отмените смену по <a href="{{.URL}}">ссылке</a> до {{.ExpiresAt}}.</p>`))
+
+type emailChangeLetter struct {
+	Name      string
+	NewEmail  string
+	URL       string
+	ExpiresAt string
+}
+
+// newToken возвращает случайный токен для ссылки и его хеш для хранения в БД
+func newToken() (token, hash string, err error) {
+	raw := make([]byte, tokenSize)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", fmt.Errorf("read random: %w", err)
+	}
+
+	token = hex.EncodeToString(raw)
+
+	return token, hashToken(token), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// tokenURL добавляет токен к адресу страницы подтверждения или отмены
+func tokenURL(base, token string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("parse url %s: %w", base, err)
+	}
+
+	query := u.Query()
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// sendEmailChangeLetters отправляет ссылку подтверждения на новый email и
+// ссылку отмены на прежний
+func (s *service) sendEmailChangeLetters(ctx context.Context, user *repository.User, change *repository.UserEmailChange, confirmToken, undoToken string) error {
+	confirmURL, err := tokenURL(s.config.EmailConfirmURL, confirmToken)
+	if err != nil {
+		return err
+	}
+
+	undoURL, err := tokenURL(s.config.EmailUndoURL, undoToken)
+	if err != nil {
+		return err
+	}
+
+	err = s.sendLetter(ctx, change.NewEmail, "Подтверждение смены email", confirmEmailTemplate, &emailChangeLetter{
+		Name:      user.Name,
+		NewEmail:  change.NewEmail,
+		URL:       confirmURL,
+		ExpiresAt: change.ExpiresAt.Format(timeLayout),
+	})
+	if err != nil {
+		return fmt.Errorf("send confirmation: %w", err)
+	}
+
+	err = s.sendLetter(ctx, change.OldEmail, "Смена email учетной записи", undoEmailTemplate, &emailChangeLetter{
+		Name:      user.Name,
+		NewEmail:  change.NewEmail,
+		URL:       undoURL,
+		ExpiresAt: change.UndoExpiresAt.Format(timeLayout),
+	})
+	if err != nil {
+		return fmt.Errorf("send undo notice: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) sendLetter(ctx context.Context, to, subject string, tmpl *template.Template, data *emailChangeLetter) error {
+	body := &bytes.Buffer{}
+	if err := tmpl.Execute(body, data); err != nil {
+		return fmt.Errorf("execute template %s: %w", tmpl.Name(), err)
+	}
+
+	if err := s.mailClient.Send(ctx, to, subject, body.String(), nil); err != nil {
+		return fmt.Errorf("send mail to %s: %w", to, err)
+	}
+
+	return nil
+}
+
+func (s *service) getUser(ctx context.Context, id int) (*repository.User, error) {
+	user, err := s.repo.Users().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors_pkg.NewNotFoundError(i18n.T(ctx, i18n.KeyUserNotFound, id))
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	return user, nil
+}
+
+// setEmail сохраняет новый email пользователя. Вызывается в транзакции
+func (s *service) setEmail(ctx context.Context, user *repository.User, email string) error {
+	user.Email = email
+
+	err := s.repo.Users().Update(ctx, user, repository.ColumnEmail)
+	if err != nil {
+		if errors.Is(err, repository.ErrEmailExists) {
+			return errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserEmailExists))
+		}
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return newErrModified(ctx, user.ID)
+		}
+		return fmt.Errorf("update user email: %w", err)
+	}
+
+	return s.addUserUpdatedEvent(ctx, user, []string{repository.ColumnEmail})
+}
+
+func isExpired(deadline time.Time) bool {
+	return time.Now().UTC().After(deadline)
+}
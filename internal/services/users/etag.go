@@ -0,0 +1,23 @@
+package users
+
+import (
+	"context"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/etag"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/repository"
+)
+
+// checkETag сверяет переданный клиентом ETag с текущей версией пользователя
+func checkETag(ctx context.Context, user *repository.User, expected *string) error {
+	if expected == nil || etag.Match(*expected, etag.FromVersion(user.Version)) {
+		return nil
+	}
+
+	return newErrModified(ctx, user.ID)
+}
+
+func newErrModified(ctx context.Context, id int) error {
+	return errors_pkg.NewPreconditionFailedError(i18n.T(ctx, i18n.KeyUserModified, id))
+}
@@ -0,0 +1,65 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/convert"
+	"boilerplate/internal/repository"
+	"boilerplate/internal/topics"
+	"boilerplate/pkg/pb/events"
+)
+
+// addEvent сохраняет событие пользователя в outbox. Вызывается в транзакции,
+// изменяющей пользователя, чтобы событие не потерялось и не опередило коммит
+func (s *service) addEvent(ctx context.Context, topic string, userID int, event proto.Message) error {
+	message, err := repository.NewOutboxMessage(topic, string(model.OutboxAggregateUser), strconv.Itoa(userID), event)
+	if err != nil {
+		return fmt.Errorf("new %s message: %w", topic, err)
+	}
+
+	return s.addMessage(ctx, message)
+}
+
+// addMessage сохраняет готовое сообщение в outbox в транзакции изменения
+func (s *service) addMessage(ctx context.Context, message *repository.OutboxMessage) error {
+	if err := s.repo.Outbox().Add(ctx, message); err != nil {
+		return fmt.Errorf("add %s message: %w", message.Topic, err)
+	}
+
+	return nil
+}
+
+func (s *service) addUserCreatedEvent(ctx context.Context, user *repository.User) error {
+	message, err := repository.NewUserCreatedMessage(user)
+	if err != nil {
+		return fmt.Errorf("new user created message: %w", err)
+	}
+
+	return s.addMessage(ctx, message)
+}
+
+func (s *service) addUserUpdatedEvent(ctx context.Context, user *repository.User, fields []string) error {
+	return s.addEvent(ctx, topics.TopicUserUpdated, user.ID, &events.UserUpdated{
+		UserId:     convert.ToInt64(user.ID),
+		Name:       user.Name,
+		Email:      user.Email,
+		Attributes: convert.ToStruct(user.Attributes),
+		Fields:     fields,
+		Version:    convert.ToInt64(user.Version),
+		UpdatedAt:  timestamppb.New(user.UpdatedAt),
+	})
+}
+
+func (s *service) addUserDeletedEvent(ctx context.Context, userID int) error {
+	return s.addEvent(ctx, topics.TopicUserDeleted, userID, &events.UserDeleted{
+		UserId:    convert.ToInt64(userID),
+		DeletedAt: timestamppb.New(time.Now().UTC()),
+	})
+}
@@ -0,0 +1,79 @@
+package users_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/metadata"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/services/users"
+	"boilerplate/internal/topics"
+	"boilerplate/pkg/pb/events"
+)
+
+func TestUserEvents(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	admin := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), admin)
+	require.NoError(t, err)
+
+	ctx := metadata.WithUserID(sp.Context(), admin.ID)
+
+	user, err := sp.GetUserService().Create(ctx, &users.UserCreateRequest{
+		Name:     gofakeit.Name(),
+		Email:    gofakeit.Email(),
+		Password: gofakeit.Word(),
+	})
+	require.NoError(t, err)
+
+	user, err = sp.GetUserService().Update(ctx, &users.UserUpdateRequest{
+		ID:       user.ID,
+		Name:     utils.Ptr(gofakeit.Name()),
+		Password: utils.Ptr(gofakeit.Word()),
+	})
+	require.NoError(t, err)
+
+	err = sp.GetUserService().Delete(ctx, &users.UserDeleteRequest{ID: user.ID})
+	require.NoError(t, err)
+
+	messages, err := sp.GetRepo().Outbox().List(sp.Context(), string(model.OutboxAggregateUser), strconv.Itoa(user.ID))
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+
+	require.Equal(t, topics.TopicUserCreated, messages[0].Topic)
+	require.Equal(t, topics.TopicUserUpdated, messages[1].Topic)
+	require.Equal(t, topics.TopicUserDeleted, messages[2].Topic)
+
+	for _, message := range messages {
+		require.Equal(t, &admin.ID, message.ActorID)
+		require.Nil(t, message.PublishedAt)
+		require.NotContains(t, string(message.Payload), "password\":")
+	}
+
+	created := &events.UserCreated{}
+	require.Equal(t, "events.UserCreated", messages[0].Type)
+	require.NoError(t, protojson.Unmarshal(messages[0].Payload, created))
+	require.Equal(t, int64(user.ID), created.GetUserId())
+	require.Equal(t, user.Email, created.GetEmail())
+
+	updated := &events.UserUpdated{}
+	require.NoError(t, protojson.Unmarshal(messages[1].Payload, updated))
+	require.Equal(t, user.Name, updated.GetName())
+	require.ElementsMatch(t, []string{"name", "password"}, updated.GetFields())
+	require.Equal(t, int64(2), updated.GetVersion())
+
+	deleted := &events.UserDeleted{}
+	require.NoError(t, protojson.Unmarshal(messages[2].Payload, deleted))
+	require.Equal(t, int64(user.ID), deleted.GetUserId())
+}
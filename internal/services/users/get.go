@@ -8,13 +8,14 @@ import (
 	"github.com/jackc/pgx/v5"
 
 	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
 )
 
 func (s *service) Get(ctx context.Context, id int) (*User, error) {
 	user, err := s.repo.Users().Get(ctx, id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errors_pkg.NewNotFoundError(fmt.Sprintf("Пользователь %d не найден", id))
+			return nil, errors_pkg.NewNotFoundError(i18n.T(ctx, i18n.KeyUserNotFound, id))
 		}
 		return nil, fmt.Errorf("get user: %w", err)
 	}
@@ -0,0 +1,26 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+)
+
+// GetAsOf возвращает пользователя в состоянии на указанный момент
+func (s *service) GetAsOf(ctx context.Context, id int, asOf time.Time) (*User, error) {
+	user, err := s.repo.UsersHistory().GetAsOf(ctx, id, asOf)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors_pkg.NewNotFoundError(i18n.T(ctx, i18n.KeyUserNotFoundAsOf, id, asOf.UTC().Format(time.RFC3339)))
+		}
+		return nil, fmt.Errorf("get user as of: %w", err)
+	}
+
+	return toUser(user), nil
+}
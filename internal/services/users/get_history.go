@@ -0,0 +1,93 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/repository"
+)
+
+// historyIgnoredFields служебные поля, которые меняются при каждом изменении
+// и не показываются в истории
+var historyIgnoredFields = map[string]struct{}{
+	repository.ColumnID:        {},
+	repository.ColumnVersion:   {},
+	repository.ColumnCreatedAt: {},
+	repository.ColumnUpdatedAt: {},
+}
+
+// historySecretFields поля, значения которых не раскрываются: в истории видно
+// только сам факт изменения
+var historySecretFields = map[string]struct{}{
+	repository.ColumnPassword: {},
+}
+
+// GetHistory возвращает историю изменений пользователя с изменениями полей
+// относительно предыдущего состояния
+func (s *service) GetHistory(ctx context.Context, id int) ([]*UserHistoryEntry, error) {
+	_, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.repo.UsersHistory().List(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("list users history: %w", err)
+	}
+
+	entries := make([]*UserHistoryEntry, 0, len(history))
+
+	var previous map[string]any
+	for _, snapshot := range history {
+		entries = append(entries, &UserHistoryEntry{
+			Version:   snapshot.Version,
+			Operation: model.UserHistoryOperation(snapshot.Operation),
+			ChangedBy: snapshot.ChangedBy,
+			ChangedAt: snapshot.ChangedAt,
+			Changes:   diffFields(previous, snapshot.Data),
+		})
+		previous = snapshot.Data
+	}
+
+	return entries, nil
+}
+
+// diffFields сравнивает два снимка пользователя и возвращает изменившиеся поля
+// в алфавитном порядке
+func diffFields(previous, current map[string]any) []*UserFieldChange {
+	fields := map[string]struct{}{}
+	for field := range previous {
+		fields[field] = struct{}{}
+	}
+	for field := range current {
+		fields[field] = struct{}{}
+	}
+
+	changes := []*UserFieldChange{}
+	for _, field := range slices.Sorted(maps.Keys(fields)) {
+		if _, ignored := historyIgnoredFields[field]; ignored {
+			continue
+		}
+
+		oldValue, newValue := previous[field], current[field]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+
+		if _, secret := historySecretFields[field]; secret {
+			oldValue, newValue = nil, nil
+		}
+
+		changes = append(changes, &UserFieldChange{
+			Field:    field,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+
+	return changes
+}
@@ -0,0 +1,73 @@
+package users_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+
+	"boilerplate/internal/model"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/metadata"
+	suite_factory "boilerplate/internal/pkg/suite/factory"
+	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
+	"boilerplate/internal/services/users"
+)
+
+func TestGetUserHistory(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	admin := suite_factory.NewUserFactory().WithAdmin().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), admin)
+	require.NoError(t, err)
+
+	user := suite_factory.NewUserFactory().WithPassword(gofakeit.Word()).Build()
+	createdUser, err := sp.GetUserService().Create(sp.Context(), &users.UserCreateRequest{
+		Name:     user.Name,
+		Email:    user.Email,
+		Password: user.Password,
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.WithUserID(sp.Context(), admin.ID)
+	newName := gofakeit.Name()
+	_, err = sp.GetUserService().Update(ctx, &users.UserUpdateRequest{
+		ID:       createdUser.ID,
+		Name:     &newName,
+		Password: utils.Ptr(gofakeit.Word()),
+	})
+	require.NoError(t, err)
+
+	history, err := sp.GetUserService().GetHistory(sp.Context(), createdUser.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	require.Equal(t, model.UserHistoryOperationCreate, history[0].Operation)
+	require.Nil(t, history[0].ChangedBy)
+
+	require.Equal(t, model.UserHistoryOperationUpdate, history[1].Operation)
+	require.Equal(t, 2, history[1].Version)
+	require.NotNil(t, history[1].ChangedBy)
+	require.Equal(t, admin.ID, *history[1].ChangedBy)
+	require.Equal(t, []*users.UserFieldChange{
+		{Field: "name", OldValue: user.Name, NewValue: newName},
+		{Field: "password"},
+	}, history[1].Changes)
+
+	// Состояние на момент создания
+	userAsOf, err := sp.GetUserService().GetAsOf(sp.Context(), createdUser.ID, history[0].ChangedAt)
+	require.NoError(t, err)
+	require.Equal(t, user.Name, userAsOf.Name)
+	require.Equal(t, createdUser.ETag, userAsOf.ETag)
+
+	_, err = sp.GetUserService().GetAsOf(sp.Context(), createdUser.ID, history[0].ChangedAt.Add(-time.Hour))
+	require.True(t, errors_pkg.IsErrNotFound(err))
+
+	_, err = sp.GetUserService().GetHistory(sp.Context(), -1)
+	require.True(t, errors_pkg.IsErrNotFound(err))
+}
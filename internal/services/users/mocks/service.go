@@ -3,10 +3,12 @@
 package mocks
 
 import (
-	users "boilerplate/internal/services/users"
 	context "context"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
+
+	users "boilerplate/internal/services/users"
 )
 
 // Service is an autogenerated mock type for the Service type
@@ -22,6 +24,124 @@ func (_m *Service) EXPECT() *Service_Expecter {
 	return &Service_Expecter{mock: &_m.Mock}
 }
 
+// ChangeEmail provides a mock function with given fields: ctx, req
+func (_m *Service) ChangeEmail(ctx context.Context, req *users.UserChangeEmailRequest) (*users.UserEmailChange, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeEmail")
+	}
+
+	var r0 *users.UserEmailChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *users.UserChangeEmailRequest) (*users.UserEmailChange, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *users.UserChangeEmailRequest) *users.UserEmailChange); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*users.UserEmailChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *users.UserChangeEmailRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Service_ChangeEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeEmail'
+type Service_ChangeEmail_Call struct {
+	*mock.Call
+}
+
+// ChangeEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *users.UserChangeEmailRequest
+func (_e *Service_Expecter) ChangeEmail(ctx interface{}, req interface{}) *Service_ChangeEmail_Call {
+	return &Service_ChangeEmail_Call{Call: _e.mock.On("ChangeEmail", ctx, req)}
+}
+
+func (_c *Service_ChangeEmail_Call) Run(run func(ctx context.Context, req *users.UserChangeEmailRequest)) *Service_ChangeEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*users.UserChangeEmailRequest))
+	})
+	return _c
+}
+
+func (_c *Service_ChangeEmail_Call) Return(_a0 *users.UserEmailChange, _a1 error) *Service_ChangeEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Service_ChangeEmail_Call) RunAndReturn(run func(context.Context, *users.UserChangeEmailRequest) (*users.UserEmailChange, error)) *Service_ChangeEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmEmailChange provides a mock function with given fields: ctx, token
+func (_m *Service) ConfirmEmailChange(ctx context.Context, token string) (*users.User, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmEmailChange")
+	}
+
+	var r0 *users.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*users.User, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *users.User); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*users.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Service_ConfirmEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmEmailChange'
+type Service_ConfirmEmailChange_Call struct {
+	*mock.Call
+}
+
+// ConfirmEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *Service_Expecter) ConfirmEmailChange(ctx interface{}, token interface{}) *Service_ConfirmEmailChange_Call {
+	return &Service_ConfirmEmailChange_Call{Call: _e.mock.On("ConfirmEmailChange", ctx, token)}
+}
+
+func (_c *Service_ConfirmEmailChange_Call) Run(run func(ctx context.Context, token string)) *Service_ConfirmEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Service_ConfirmEmailChange_Call) Return(_a0 *users.User, _a1 error) *Service_ConfirmEmailChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Service_ConfirmEmailChange_Call) RunAndReturn(run func(context.Context, string) (*users.User, error)) *Service_ConfirmEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, req
 func (_m *Service) Create(ctx context.Context, req *users.UserCreateRequest) (*users.User, error) {
 	ret := _m.Called(ctx, req)
@@ -81,17 +201,17 @@ func (_c *Service_Create_Call) RunAndReturn(run func(context.Context, *users.Use
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *Service) Delete(ctx context.Context, id int) error {
-	ret := _m.Called(ctx, id)
+// Delete provides a mock function with given fields: ctx, req
+func (_m *Service) Delete(ctx context.Context, req *users.UserDeleteRequest) error {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, *users.UserDeleteRequest) error); ok {
+		r0 = rf(ctx, req)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -106,14 +226,14 @@ type Service_Delete_Call struct {
 
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int
-func (_e *Service_Expecter) Delete(ctx interface{}, id interface{}) *Service_Delete_Call {
-	return &Service_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - req *users.UserDeleteRequest
+func (_e *Service_Expecter) Delete(ctx interface{}, req interface{}) *Service_Delete_Call {
+	return &Service_Delete_Call{Call: _e.mock.On("Delete", ctx, req)}
 }
 
-func (_c *Service_Delete_Call) Run(run func(ctx context.Context, id int)) *Service_Delete_Call {
+func (_c *Service_Delete_Call) Run(run func(ctx context.Context, req *users.UserDeleteRequest)) *Service_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(*users.UserDeleteRequest))
 	})
 	return _c
 }
@@ -123,7 +243,7 @@ func (_c *Service_Delete_Call) Return(_a0 error) *Service_Delete_Call {
 	return _c
 }
 
-func (_c *Service_Delete_Call) RunAndReturn(run func(context.Context, int) error) *Service_Delete_Call {
+func (_c *Service_Delete_Call) RunAndReturn(run func(context.Context, *users.UserDeleteRequest) error) *Service_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -187,6 +307,125 @@ func (_c *Service_Get_Call) RunAndReturn(run func(context.Context, int) (*users.
 	return _c
 }
 
+// GetAsOf provides a mock function with given fields: ctx, id, asOf
+func (_m *Service) GetAsOf(ctx context.Context, id int, asOf time.Time) (*users.User, error) {
+	ret := _m.Called(ctx, id, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAsOf")
+	}
+
+	var r0 *users.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) (*users.User, error)); ok {
+		return rf(ctx, id, asOf)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) *users.User); ok {
+		r0 = rf(ctx, id, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*users.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, id, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Service_GetAsOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAsOf'
+type Service_GetAsOf_Call struct {
+	*mock.Call
+}
+
+// GetAsOf is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - asOf time.Time
+func (_e *Service_Expecter) GetAsOf(ctx interface{}, id interface{}, asOf interface{}) *Service_GetAsOf_Call {
+	return &Service_GetAsOf_Call{Call: _e.mock.On("GetAsOf", ctx, id, asOf)}
+}
+
+func (_c *Service_GetAsOf_Call) Run(run func(ctx context.Context, id int, asOf time.Time)) *Service_GetAsOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Service_GetAsOf_Call) Return(_a0 *users.User, _a1 error) *Service_GetAsOf_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Service_GetAsOf_Call) RunAndReturn(run func(context.Context, int, time.Time) (*users.User, error)) *Service_GetAsOf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHistory provides a mock function with given fields: ctx, id
+func (_m *Service) GetHistory(ctx context.Context, id int) ([]*users.UserHistoryEntry, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 []*users.UserHistoryEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*users.UserHistoryEntry, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*users.UserHistoryEntry); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*users.UserHistoryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Service_GetHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistory'
+type Service_GetHistory_Call struct {
+	*mock.Call
+}
+
+// GetHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *Service_Expecter) GetHistory(ctx interface{}, id interface{}) *Service_GetHistory_Call {
+	return &Service_GetHistory_Call{Call: _e.mock.On("GetHistory", ctx, id)}
+}
+
+func (_c *Service_GetHistory_Call) Run(run func(ctx context.Context, id int)) *Service_GetHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Service_GetHistory_Call) Return(_a0 []*users.UserHistoryEntry, _a1 error) *Service_GetHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Service_GetHistory_Call) RunAndReturn(run func(context.Context, int) ([]*users.UserHistoryEntry, error)) *Service_GetHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Search provides a mock function with given fields: ctx, req
 func (_m *Service) Search(ctx context.Context, req *users.UserSearchRequest) (*users.UserSearchResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -246,6 +485,65 @@ func (_c *Service_Search_Call) RunAndReturn(run func(context.Context, *users.Use
 	return _c
 }
 
+// UndoEmailChange provides a mock function with given fields: ctx, token
+func (_m *Service) UndoEmailChange(ctx context.Context, token string) (*users.User, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UndoEmailChange")
+	}
+
+	var r0 *users.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*users.User, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *users.User); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*users.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Service_UndoEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndoEmailChange'
+type Service_UndoEmailChange_Call struct {
+	*mock.Call
+}
+
+// UndoEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *Service_Expecter) UndoEmailChange(ctx interface{}, token interface{}) *Service_UndoEmailChange_Call {
+	return &Service_UndoEmailChange_Call{Call: _e.mock.On("UndoEmailChange", ctx, token)}
+}
+
+func (_c *Service_UndoEmailChange_Call) Run(run func(ctx context.Context, token string)) *Service_UndoEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Service_UndoEmailChange_Call) Return(_a0 *users.User, _a1 error) *Service_UndoEmailChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Service_UndoEmailChange_Call) RunAndReturn(run func(context.Context, string) (*users.User, error)) *Service_UndoEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, req
 func (_m *Service) Update(ctx context.Context, req *users.UserUpdateRequest) (*users.User, error) {
 	ret := _m.Called(ctx, req)
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/etag"
 	"boilerplate/internal/repository"
 )
 
@@ -18,19 +19,78 @@ type User struct {
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt *time.Time     `json:"deleted_at,omitempty"`
+	ETag      string         `json:"etag"`
+	// Attributes произвольные атрибуты, соответствующие настроенной JSON Schema
+	Attributes map[string]any `json:"attributes"`
 }
 
 type UserCreateRequest struct {
-	Name     string `json:"name"`
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Name       string         `json:"name"`
+	Email      string         `json:"email"`
+	Password   string         `json:"password"`
+	Attributes map[string]any `json:"attributes"`
 }
 
 type UserUpdateRequest struct {
-	ID       int     `uri:"userid"`
-	Name     *string `json:"name"`
-	Email    *string `json:"email"`
-	Password *string `json:"password"`
+	ID         int            `uri:"userid"`
+	Name       *string        `json:"name"`
+	Password   *string        `json:"password"`
+	Attributes map[string]any `json:"attributes"`
+	ETag       *string        `json:"etag"`
+	UpdateMask []string       `json:"update_mask"`
+}
+
+// paths возвращает пути переданных полей для обновления без маски
+func (r *UserUpdateRequest) paths() []string {
+	paths := []string{}
+	if r.Name != nil {
+		paths = append(paths, pathName)
+	}
+	if r.Password != nil {
+		paths = append(paths, pathPassword)
+	}
+	if r.Attributes != nil {
+		paths = append(paths, pathAttributes)
+	}
+	return paths
+}
+
+type UserDeleteRequest struct {
+	ID   int     `uri:"userid"`
+	ETag *string `json:"etag"`
+}
+
+type UserChangeEmailRequest struct {
+	ID    int     `uri:"userid"`
+	Email string  `json:"email"`
+	ETag  *string `json:"etag"`
+}
+
+type UserEmailChange struct {
+	ID            int                         `json:"id"`
+	UserID        int                         `json:"user_id"`
+	NewEmail      string                      `json:"new_email"`
+	Status        model.UserEmailChangeStatus `json:"status"`
+	ExpiresAt     time.Time                   `json:"expires_at"`
+	UndoExpiresAt time.Time                   `json:"undo_expires_at"`
+	ConfirmedAt   *time.Time                  `json:"confirmed_at,omitempty"`
+	CreatedAt     time.Time                   `json:"created_at"`
+}
+
+type UserHistoryEntry struct {
+	Version   int                        `json:"version"`
+	Operation model.UserHistoryOperation `json:"operation"`
+	ChangedBy *int                       `json:"changed_by,omitempty"`
+	ChangedAt time.Time                  `json:"changed_at"`
+	Changes   []*UserFieldChange         `json:"changes"`
+}
+
+// UserFieldChange изменение поля пользователя. Значения в формате JSON,
+// значения пароля не раскрываются
+type UserFieldChange struct {
+	Field    string `json:"field"`
+	OldValue any    `json:"old_value"`
+	NewValue any    `json:"new_value"`
 }
 
 type UserSearchRequest struct {
@@ -45,6 +105,8 @@ type UserSearchRequestFilter struct {
 	Name        *string  `form:"name"`
 	Email       []string `form:"email"`
 	WithDeleted *bool    `form:"with_deleted"`
+	// Attributes отбирает пользователей, атрибуты которых содержат указанные
+	Attributes map[string]any `form:"-"`
 }
 
 type UserSearchResponse struct {
@@ -54,14 +116,29 @@ type UserSearchResponse struct {
 
 func toUser(user *repository.User) *User {
 	return &User{
-		ID:        user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		IsAdmin:   user.IsAdmin,
-		Password:  user.Password,
-		Deleted:   user.Deleted,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
-		DeletedAt: user.DeletedAt,
+		ID:         user.ID,
+		Name:       user.Name,
+		Email:      user.Email,
+		IsAdmin:    user.IsAdmin,
+		Password:   user.Password,
+		Deleted:    user.Deleted,
+		CreatedAt:  user.CreatedAt,
+		UpdatedAt:  user.UpdatedAt,
+		DeletedAt:  user.DeletedAt,
+		ETag:       etag.FromVersion(user.Version),
+		Attributes: user.Attributes,
+	}
+}
+
+func toUserEmailChange(change *repository.UserEmailChange) *UserEmailChange {
+	return &UserEmailChange{
+		ID:            change.ID,
+		UserID:        change.UserID,
+		NewEmail:      change.NewEmail,
+		Status:        model.UserEmailChangeStatus(change.Status),
+		ExpiresAt:     change.ExpiresAt,
+		UndoExpiresAt: change.UndoExpiresAt,
+		ConfirmedAt:   change.ConfirmedAt,
+		CreatedAt:     change.CreatedAt,
 	}
 }
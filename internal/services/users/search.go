@@ -12,6 +12,7 @@ func (s *service) Search(ctx context.Context, req *UserSearchRequest) (*UserSear
 		IDs:         req.Filter.ID,
 		Emails:      req.Filter.Email,
 		Name:        req.Filter.Name,
+		Attributes:  req.Filter.Attributes,
 		WithDeleted: req.Filter.WithDeleted,
 		Limit:       req.Limit,
 		Offset:      req.Offset,
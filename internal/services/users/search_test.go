@@ -3,6 +3,7 @@ package users_test
 import (
 	"testing"
 
+	"github.com/brianvoe/gofakeit/v7"
 	"github.com/stretchr/testify/require"
 
 	suite_factory "boilerplate/internal/pkg/suite/factory"
@@ -19,12 +20,13 @@ func TestSearchUsers(t *testing.T) {
 
 	users := suite_factory.NewUserFactory().Builds(4)
 	users[0].IsAdmin = true
+	users[1].Attributes = map[string]any{"team": "search-" + gofakeit.UUID(), "level": float64(2)}
 	for _, user := range users {
 		err := sp.GetRepo().Users().Create(sp.Context(), user)
 		require.NoError(t, err)
 	}
 
-	err := sp.GetRepo().Users().Delete(sp.Context(), users[3].ID)
+	err := sp.GetRepo().Users().Delete(sp.Context(), users[3].ID, users[3].Version)
 	require.NoError(t, err)
 
 	type testCase struct {
@@ -84,6 +86,24 @@ func TestSearchUsers(t *testing.T) {
 			},
 			Expected: 2,
 		},
+		{
+			Name: "wrong attributes",
+			Request: &users_service.UserSearchRequest{
+				Filter: users_service.UserSearchRequestFilter{
+					Attributes: map[string]any{"team": users[1].Attributes["team"], "level": float64(3)},
+				},
+			},
+			Expected: 0,
+		},
+		{
+			Name: "correct attributes",
+			Request: &users_service.UserSearchRequest{
+				Filter: users_service.UserSearchRequestFilter{
+					Attributes: map[string]any{"team": users[1].Attributes["team"]},
+				},
+			},
+			Expected: 1,
+		},
 		{
 			Name: "with deleted",
 			Request: &users_service.UserSearchRequest{
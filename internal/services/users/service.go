@@ -2,26 +2,44 @@ package users
 
 import (
 	"context"
+	"time"
 
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/mail"
+	"boilerplate/internal/pkg/schema"
 	"boilerplate/internal/repository"
 )
 
 type Service interface {
 	Create(ctx context.Context, req *UserCreateRequest) (*User, error)
 	Get(ctx context.Context, id int) (*User, error)
+	GetAsOf(ctx context.Context, id int, asOf time.Time) (*User, error)
+	GetHistory(ctx context.Context, id int) ([]*UserHistoryEntry, error)
 	Update(ctx context.Context, req *UserUpdateRequest) (*User, error)
-	Delete(ctx context.Context, id int) error
+	Delete(ctx context.Context, req *UserDeleteRequest) error
 	Search(ctx context.Context, req *UserSearchRequest) (*UserSearchResponse, error)
+	ChangeEmail(ctx context.Context, req *UserChangeEmailRequest) (*UserEmailChange, error)
+	ConfirmEmailChange(ctx context.Context, token string) (*User, error)
+	UndoEmailChange(ctx context.Context, token string) (*User, error)
 }
 
 type service struct {
-	repo repository.Repo
+	config              *model.ConfigUsers
+	repo                repository.Repo
+	attributesValidator schema.Validator
+	mailClient          mail.Client
 }
 
 func NewService(
+	config *model.ConfigUsers,
 	repo repository.Repo,
+	attributesValidator schema.Validator,
+	mailClient mail.Client,
 ) Service {
 	return &service{
-		repo: repo,
+		config:              config,
+		repo:                repo,
+		attributesValidator: attributesValidator,
+		mailClient:          mailClient,
 	}
 }
@@ -0,0 +1,74 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/clients/db"
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/i18n"
+	"boilerplate/internal/repository"
+)
+
+// UndoEmailChange отменяет смену email по токену из письма, отправленного на
+// прежний email. Неподтвержденная смена отменяется, подтвержденная -
+// откатывается к прежнему email
+func (s *service) UndoEmailChange(ctx context.Context, token string) (*User, error) {
+	change, err := s.repo.UserEmailChanges().GetByUndoTokenHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newErrEmailChangeNotFound(ctx)
+		}
+		return nil, fmt.Errorf("get email change: %w", err)
+	}
+
+	if isExpired(change.UndoExpiresAt) {
+		return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyEmailChangeUndoExpired))
+	}
+
+	var user *repository.User
+
+	err = s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+		var err error
+		user, err = s.getUser(ctx, change.UserID)
+		if err != nil {
+			return err
+		}
+
+		switch model.UserEmailChangeStatus(change.Status) {
+		case model.UserEmailChangeStatusPending:
+			change.Status = string(model.UserEmailChangeStatusCancelled)
+		case model.UserEmailChangeStatusConfirmed:
+			// Email мог быть изменен еще раз после подтверждения
+			if !strings.EqualFold(user.Email, change.NewEmail) {
+				return errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyEmailChangeUndoConflict))
+			}
+
+			err = s.setEmail(ctx, user, change.OldEmail)
+			if err != nil {
+				return err
+			}
+
+			change.Status = string(model.UserEmailChangeStatusUndone)
+		default:
+			return errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyEmailChangeUndone))
+		}
+
+		err = s.repo.UserEmailChanges().Update(ctx, change)
+		if err != nil {
+			return fmt.Errorf("update email change: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toUser(user), nil
+}
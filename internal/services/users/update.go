@@ -7,36 +7,95 @@ import (
 
 	"github.com/jackc/pgx/v5"
 
+	"boilerplate/internal/pkg/clients/db"
 	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/fieldmask"
+	"boilerplate/internal/pkg/i18n"
 	"boilerplate/internal/pkg/pwd"
+	"boilerplate/internal/repository"
 )
 
+const (
+	pathName       = "name"
+	pathPassword   = "password"
+	pathAttributes = "attributes"
+)
+
+// updateMask поля пользователя, которые можно обновить
+var updateMask = fieldmask.Mapping{
+	pathName:       repository.ColumnName,
+	pathPassword:   repository.ColumnPassword,
+	pathAttributes: repository.ColumnAttributes,
+}
+
 func (s *service) Update(ctx context.Context, req *UserUpdateRequest) (*User, error) {
+	// Без маски обновляются только переданные поля
+	paths := req.UpdateMask
+	if paths == nil {
+		paths = req.paths()
+	}
+
+	columns, err := updateMask.Columns(paths)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := s.repo.Users().Get(ctx, req.ID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errors_pkg.NewNotFoundError(fmt.Sprintf("Пользователь %d не найден", req.ID))
+			return nil, errors_pkg.NewNotFoundError(i18n.T(ctx, i18n.KeyUserNotFound, req.ID))
 		}
 		return nil, fmt.Errorf("get user: %w", err)
 	}
 
-	if req.Name != nil {
+	err = checkETag(ctx, user, req.ETag)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(columns) == 0 {
+		return toUser(user), nil
+	}
+
+	if fieldmask.Has(paths, pathName) {
+		if req.Name == nil {
+			return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserNameRequired))
+		}
 		user.Name = *req.Name
 	}
-	if req.Email != nil {
-		user.Email = *req.Email
+
+	if fieldmask.Has(paths, pathAttributes) {
+		user.Attributes = req.Attributes
+		if user.Attributes == nil {
+			user.Attributes = map[string]any{}
+		}
+
+		if err := s.validateAttributes(ctx, user.Attributes); err != nil {
+			return nil, err
+		}
 	}
 
-	if req.Password != nil {
-		var err error
+	if fieldmask.Has(paths, pathPassword) {
+		if req.Password == nil {
+			return nil, errors_pkg.NewBadRequestError(i18n.T(ctx, i18n.KeyUserNewPasswordRequired))
+		}
+
 		user.Password, err = pwd.HashPassword(*req.Password)
 		if err != nil {
 			return nil, fmt.Errorf("hash password: %w", err)
 		}
 	}
 
-	err = s.repo.Users().Update(ctx, user)
+	err = s.repo.Transaction(ctx, func(ctx context.Context, _ db.Executor) error {
+		if err := s.repo.Users().Update(ctx, user, columns...); err != nil {
+			return err
+		}
+		return s.addUserUpdatedEvent(ctx, user, columns)
+	})
 	if err != nil {
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return nil, newErrModified(ctx, user.ID)
+		}
 		return nil, fmt.Errorf("update user: %w", err)
 	}
 
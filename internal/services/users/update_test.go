@@ -6,9 +6,12 @@ import (
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/stretchr/testify/require"
 
+	errors_pkg "boilerplate/internal/pkg/errors"
+	"boilerplate/internal/pkg/etag"
 	"boilerplate/internal/pkg/pwd"
 	suite_factory "boilerplate/internal/pkg/suite/factory"
 	suite_provider "boilerplate/internal/pkg/suite/provider"
+	"boilerplate/internal/pkg/utils"
 	"boilerplate/internal/services/users"
 )
 
@@ -22,21 +25,123 @@ func TestUpdateUser(t *testing.T) {
 	err := sp.GetRepo().Users().Create(sp.Context(), user)
 	require.NoError(t, err)
 
+	email := user.Email
 	user = suite_factory.NewUserFactory().WithID(user.ID).WithPassword(gofakeit.Word()).Build()
 
 	updatedUser, err := sp.GetUserService().Update(sp.Context(), &users.UserUpdateRequest{
 		ID:       user.ID,
 		Name:     &user.Name,
-		Email:    &user.Email,
 		Password: &user.Password,
 	})
 	require.NoError(t, err)
 	require.NotNil(t, updatedUser)
 	require.Equal(t, user.Name, updatedUser.Name)
-	require.Equal(t, user.Email, updatedUser.Email)
+	require.Equal(t, email, updatedUser.Email)
 	require.True(t, pwd.CheckPasswordHash(user.Password, updatedUser.Password))
 	require.False(t, updatedUser.IsAdmin)
 	require.False(t, updatedUser.Deleted)
 	require.NotEmpty(t, updatedUser.CreatedAt)
 	require.NotEmpty(t, updatedUser.UpdatedAt)
+	require.Equal(t, etag.FromVersion(2), updatedUser.ETag)
+}
+
+func TestUpdateUserETag(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	gotUser, err := sp.GetUserService().Get(sp.Context(), user.ID)
+	require.NoError(t, err)
+
+	// Первый администратор сохраняет изменения
+	updatedUser, err := sp.GetUserService().Update(sp.Context(), &users.UserUpdateRequest{
+		ID:   user.ID,
+		Name: utils.Ptr(gofakeit.Name()),
+		ETag: &gotUser.ETag,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, gotUser.ETag, updatedUser.ETag)
+
+	// Второй администратор редактирует устаревшую версию
+	_, err = sp.GetUserService().Update(sp.Context(), &users.UserUpdateRequest{
+		ID:   user.ID,
+		Name: utils.Ptr(gofakeit.Name()),
+		ETag: &gotUser.ETag,
+	})
+	require.Error(t, err)
+	require.True(t, errors_pkg.IsErrPreconditionFailed(err))
+
+	actualUser, err := sp.GetUserService().Get(sp.Context(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, updatedUser.Name, actualUser.Name)
+	require.Equal(t, updatedUser.ETag, actualUser.ETag)
+}
+
+func TestUpdateUserWithMask(t *testing.T) {
+	t.Parallel()
+
+	sp, cleanup := suite_provider.NewProvider()
+	defer cleanup()
+
+	user := suite_factory.NewUserFactory().Build()
+	err := sp.GetRepo().Users().Create(sp.Context(), user)
+	require.NoError(t, err)
+
+	// Пароль передан, но не указан в маске, поэтому не меняется
+	name := gofakeit.Name()
+	updatedUser, err := sp.GetUserService().Update(sp.Context(), &users.UserUpdateRequest{
+		ID:         user.ID,
+		Name:       &name,
+		Password:   utils.Ptr(gofakeit.Password(true, true, true, false, false, 12)),
+		UpdateMask: []string{"name"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, name, updatedUser.Name)
+	require.Equal(t, user.Email, updatedUser.Email)
+	require.Equal(t, user.Password, updatedUser.Password)
+
+	_, err = sp.GetUserService().Update(sp.Context(), &users.UserUpdateRequest{
+		ID:         user.ID,
+		UpdateMask: []string{"is_admin"},
+	})
+	require.Error(t, err)
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+
+	// Email меняется только через подтверждение (ChangeEmail)
+	_, err = sp.GetUserService().Update(sp.Context(), &users.UserUpdateRequest{
+		ID:         user.ID,
+		UpdateMask: []string{"email"},
+	})
+	require.Error(t, err)
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+
+	_, err = sp.GetUserService().Update(sp.Context(), &users.UserUpdateRequest{
+		ID:         user.ID,
+		UpdateMask: []string{"password"},
+	})
+	require.Error(t, err)
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+
+	// Поле в маске без значения не очищает имя
+	_, err = sp.GetUserService().Update(sp.Context(), &users.UserUpdateRequest{
+		ID:         user.ID,
+		UpdateMask: []string{"name"},
+	})
+	require.Error(t, err)
+	require.True(t, errors_pkg.IsErrBadRequest(err))
+
+	// Пустая маска ничего не меняет
+	sameUser, err := sp.GetUserService().Update(sp.Context(), &users.UserUpdateRequest{
+		ID:         user.ID,
+		Name:       utils.Ptr(gofakeit.Name()),
+		UpdateMask: []string{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, name, sameUser.Name)
+	require.Equal(t, updatedUser.ETag, sameUser.ETag)
 }
@@ -0,0 +1,75 @@
+package topics
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"boilerplate/internal/pkg/schema_registry"
+	"boilerplate/pkg/pb/events"
+)
+
+// jsonSchemas JSON Schema событий топиков в файлах schemas/<топик>.json
+//
+//go:embed schemas/*.json
+var jsonSchemas embed.FS
+
+// protoSchemas типы protobuf-событий топиков
+var protoSchemas = map[string]proto.Message{
+	TopicUserCreated: &events.UserCreated{},
+	TopicUserUpdated: &events.UserUpdated{},
+	TopicUserDeleted: &events.UserDeleted{},
+}
+
+// Schemas возвращает схемы событий топиков приложения
+func Schemas() ([]*schema_registry.Schema, error) {
+	schemas := []*schema_registry.Schema{}
+
+	files, err := jsonSchemas.ReadDir("schemas")
+	if err != nil {
+		return nil, fmt.Errorf("read schemas: %w", err)
+	}
+
+	for _, file := range files {
+		definition, err := jsonSchemas.ReadFile("schemas/" + file.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read schema %s: %w", file.Name(), err)
+		}
+
+		schema, err := schema_registry.NewJSONSchema(strings.TrimSuffix(file.Name(), ".json"), definition)
+		if err != nil {
+			return nil, fmt.Errorf("create schema %s: %w", file.Name(), err)
+		}
+		schemas = append(schemas, schema)
+	}
+
+	for topic, message := range protoSchemas {
+		schema, err := schema_registry.NewProtoSchema(topic, message)
+		if err != nil {
+			return nil, fmt.Errorf("create schema %s: %w", topic, err)
+		}
+		schemas = append(schemas, schema)
+	}
+
+	return schemas, nil
+}
+
+// RegisterSchemas регистрирует схемы событий топиков. Измененная схема
+// становится новой версией, несовместимая с последней версией - ошибкой
+func RegisterSchemas(ctx context.Context, registry schema_registry.Registry) error {
+	schemas, err := Schemas()
+	if err != nil {
+		return err
+	}
+
+	for _, schema := range schemas {
+		if _, err = registry.Register(ctx, schema); err != nil {
+			return fmt.Errorf("register schema %s: %w", schema.Topic, err)
+		}
+	}
+
+	return nil
+}
@@ -11,17 +11,33 @@ import (
 const (
 	TopicUserCreated    = "user-created"
 	TopicUserCreatedDLQ = "user-created-dlq"
+
+	TopicUserUpdated = "user-updated"
+	TopicUserDeleted = "user-deleted"
+
+	TopicUserImportCreated    = "user-import-created"
+	TopicUserImportCreatedDLQ = "user-import-created-dlq"
+
+	TopicUserExportCreated    = "user-export-created"
+	TopicUserExportCreatedDLQ = "user-export-created-dlq"
+
+	TopicGroupMembersChanged = "group-members-changed"
 )
 
 var Topics = map[string]model.BrokerTopic{
 	TopicUserCreated: {
-		Name:         TopicUserCreated,
-		Description:  "Main topic for user created events",
-		Partitions:   3,
-		MaxAge:       30 * 24 * time.Hour, // 30 days
-		MaxBytes:     1024 * 1024 * 1024,  // 1 GB
-		Retries:      3,
-		RetriesDelay: time.Duration(5 * time.Second),
+		Name:        TopicUserCreated,
+		Description: "Main topic for user created events",
+		Partitions:  3,
+		MaxAge:      30 * 24 * time.Hour, // 30 days
+		MaxBytes:    1024 * 1024 * 1024,  // 1 GB
+		Retry: model.RetryPolicy{
+			MaxAttempts:  3,
+			InitialDelay: 5 * time.Second,
+			Multiplier:   2,
+			MaxDelay:     time.Minute,
+			Jitter:       0.2,
+		},
 		DLQTopicName: TopicUserCreatedDLQ,
 	},
 	TopicUserCreatedDLQ: {
@@ -30,6 +46,66 @@ var Topics = map[string]model.BrokerTopic{
 		MaxAge:      365 * 24 * time.Hour, // 365 days
 		MaxBytes:    1024 * 1024 * 1024,   // 1 GB
 	},
+	TopicUserUpdated: {
+		Name:        TopicUserUpdated,
+		Description: "Main topic for user updated events",
+		Partitions:  3,
+		MaxAge:      30 * 24 * time.Hour, // 30 days
+		MaxBytes:    1024 * 1024 * 1024,  // 1 GB
+	},
+	TopicUserDeleted: {
+		Name:        TopicUserDeleted,
+		Description: "Main topic for user deleted events",
+		Partitions:  3,
+		MaxAge:      30 * 24 * time.Hour, // 30 days
+		MaxBytes:    1024 * 1024 * 1024,  // 1 GB
+	},
+	TopicUserImportCreated: {
+		Name:        TopicUserImportCreated,
+		Description: "Main topic for user import created events",
+		MaxAge:      7 * 24 * time.Hour, // 7 days
+		MaxBytes:    100 * 1024 * 1024,  // 100 MB
+		Retry: model.RetryPolicy{
+			MaxAttempts:  3,
+			InitialDelay: 30 * time.Second,
+			Multiplier:   2,
+			MaxDelay:     5 * time.Minute,
+			Jitter:       0.2,
+		},
+		DLQTopicName: TopicUserImportCreatedDLQ,
+	},
+	TopicUserImportCreatedDLQ: {
+		Name:        TopicUserImportCreatedDLQ,
+		Description: "DLQ topic for user import created events",
+		MaxAge:      30 * 24 * time.Hour, // 30 days
+		MaxBytes:    100 * 1024 * 1024,   // 100 MB
+	},
+	TopicUserExportCreated: {
+		Name:        TopicUserExportCreated,
+		Description: "Main topic for user export created events",
+		MaxAge:      7 * 24 * time.Hour, // 7 days
+		MaxBytes:    100 * 1024 * 1024,  // 100 MB
+		Retry: model.RetryPolicy{
+			MaxAttempts:  3,
+			InitialDelay: 30 * time.Second,
+			Multiplier:   2,
+			MaxDelay:     5 * time.Minute,
+			Jitter:       0.2,
+		},
+		DLQTopicName: TopicUserExportCreatedDLQ,
+	},
+	TopicUserExportCreatedDLQ: {
+		Name:        TopicUserExportCreatedDLQ,
+		Description: "DLQ topic for user export created events",
+		MaxAge:      30 * 24 * time.Hour, // 30 days
+		MaxBytes:    100 * 1024 * 1024,   // 100 MB
+	},
+	TopicGroupMembersChanged: {
+		Name:        TopicGroupMembersChanged,
+		Description: "Main topic for group membership changes",
+		MaxAge:      30 * 24 * time.Hour, // 30 days
+		MaxBytes:    1024 * 1024 * 1024,  // 1 GB
+	},
 }
 
 func CreateOrUpdateTopics(ctx context.Context, client model.BrokerClient) error {
@@ -40,3 +116,19 @@ func CreateOrUpdateTopics(ctx context.Context, client model.BrokerClient) error
 	}
 	return nil
 }
+
+// Partition возвращает партицию топика, в которую клиент брокера направит
+// сообщение с ключом key. Для топика без партиций возвращает nil
+func Partition(name, key string) *int {
+	return model.BrokerPartition(key, Topics[name].Partitions)
+}
+
+// IsDLQ проверяет, что топик служит DLQ для какого-либо топика
+func IsDLQ(name string) bool {
+	for _, topic := range Topics {
+		if topic.DLQTopicName != "" && topic.DLQTopicName == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package inbox_cleanup
+
+import (
+	"context"
+	"fmt"
+
+	logger_pkg "boilerplate/internal/pkg/logger"
+	"boilerplate/internal/services/inbox"
+	"boilerplate/internal/services/scheduler"
+)
+
+const Name = "inbox-cleanup"
+
+// NewJob создает задачу планировщика, которая удаляет отметки inbox старше
+// срока хранения
+func NewJob(logger logger_pkg.Logger, inboxService inbox.Service) scheduler.Job {
+	return scheduler.Job{
+		Name:     Name,
+		Schedule: "@hourly",
+		CatchUp:  scheduler.CatchUpOnce,
+		Run: func(ctx context.Context) error {
+			deleted, err := inboxService.Cleanup(ctx)
+			if err != nil {
+				return fmt.Errorf("cleanup inbox messages: %w", err)
+			}
+
+			logger.DebugKV(ctx, "processed inbox messages deleted", "deleted", deleted)
+			return nil
+		},
+	}
+}
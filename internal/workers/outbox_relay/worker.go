@@ -0,0 +1,86 @@
+package outbox_relay
+
+import (
+	"context"
+	"time"
+
+	"boilerplate/internal/model"
+	logger_pkg "boilerplate/internal/pkg/logger"
+	"boilerplate/internal/services/outbox"
+)
+
+const (
+	Name = "outbox-relay-worker"
+
+	cleanupInterval = time.Hour
+)
+
+type worker struct {
+	logger        logger_pkg.Logger
+	pollInterval  time.Duration
+	outboxService outbox.Service
+}
+
+func NewWorker(logger logger_pkg.Logger, config *model.ConfigOutbox, outboxService outbox.Service) model.Worker {
+	return &worker{
+		logger:        logger,
+		pollInterval:  time.Duration(config.PollInterval) * time.Millisecond,
+		outboxService: outboxService,
+	}
+}
+
+func (w *worker) Name() string {
+	return Name
+}
+
+func (w *worker) Run(ctx context.Context) {
+	pollTicker := time.NewTicker(w.pollInterval)
+	defer pollTicker.Stop()
+
+	cleanupTicker := time.NewTicker(cleanupInterval)
+	defer cleanupTicker.Stop()
+
+	for {
+		w.relay(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-pollTicker.C:
+		case <-cleanupTicker.C:
+			w.cleanup(ctx)
+		}
+	}
+}
+
+// relay публикует пачки, пока они не пусты: из агрегата за пачку публикуется
+// одно сообщение, следующие ожидают публикации предыдущего
+func (w *worker) relay(ctx context.Context) {
+	for ctx.Err() == nil {
+		result, err := w.outboxService.Relay(ctx)
+		if err != nil {
+			w.logger.ErrorKV(ctx, "relay outbox messages error", "error", err.Error())
+			return
+		}
+
+		if result.Retried > 0 || result.Failed > 0 {
+			w.logger.WarnKV(ctx, "outbox messages not published", "retried", result.Retried, "failed", result.Failed)
+		}
+
+		if result.Total() == 0 {
+			return
+		}
+
+		w.logger.DebugKV(ctx, "outbox messages relayed", "published", result.Published)
+	}
+}
+
+func (w *worker) cleanup(ctx context.Context) {
+	deleted, err := w.outboxService.Cleanup(ctx)
+	if err != nil {
+		w.logger.ErrorKV(ctx, "cleanup outbox messages error", "error", err.Error())
+		return
+	}
+
+	w.logger.DebugKV(ctx, "published outbox messages deleted", "deleted", deleted)
+}
@@ -0,0 +1,28 @@
+package scheduler
+
+import (
+	"context"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/services/scheduler"
+)
+
+const Name = "scheduler-worker"
+
+type worker struct {
+	schedulerService scheduler.Service
+}
+
+func NewWorker(schedulerService scheduler.Service) model.Worker {
+	return &worker{
+		schedulerService: schedulerService,
+	}
+}
+
+func (w *worker) Name() string {
+	return Name
+}
+
+func (w *worker) Run(ctx context.Context) {
+	w.schedulerService.Run(ctx)
+}
@@ -0,0 +1,92 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"boilerplate/internal/model"
+	"boilerplate/internal/pkg/lock"
+	logger_pkg "boilerplate/internal/pkg/logger"
+	"boilerplate/internal/service_provider"
+	"boilerplate/internal/workers/inbox_cleanup"
+	"boilerplate/internal/workers/outbox_relay"
+	"boilerplate/internal/workers/scheduler"
+)
+
+type workers struct {
+	logger  logger_pkg.Logger
+	workers []model.Worker
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func NewWorkers(logger logger_pkg.Logger, config *model.Config, sp *service_provider.Provider) (*workers, error) {
+	w := &workers{
+		logger: logger,
+	}
+
+	// Воркеры выполняются только на одном экземпляре приложения
+	elector := sp.GetLeaderElector()
+
+	w.workers = []model.Worker{
+		newLeaderWorker(elector, outbox_relay.NewWorker(
+			logger.With("worker", "outbox_relay"),
+			&config.Outbox,
+			sp.GetOutboxService())),
+		newLeaderWorker(elector, scheduler.NewWorker(
+			sp.GetSchedulerService())),
+	}
+
+	// Периодические задачи
+	err := sp.GetSchedulerService().Register(
+		inbox_cleanup.NewJob(
+			logger.With("job", inbox_cleanup.Name),
+			sp.GetInboxService()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("register scheduled jobs: %w", err)
+	}
+
+	return w, nil
+}
+
+// Start запускает воркеры в фоне до вызова Stop
+func (w *workers) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+
+	for _, worker := range w.workers {
+		w.wg.Go(func() {
+			w.logger.InfoKV(ctx, "worker started", "worker", worker.Name())
+			worker.Run(ctx)
+			w.logger.InfoKV(ctx, "worker stopped", "worker", worker.Name())
+		})
+	}
+}
+
+// Stop останавливает воркеры и ждет завершения текущей работы
+func (w *workers) Stop() error {
+	if w.cancel != nil {
+		w.cancel()
+	}
+	w.wg.Wait()
+	return nil
+}
+
+// leaderWorker выполняет воркер, пока экземпляр приложения является лидером
+// для него
+type leaderWorker struct {
+	model.Worker
+	elector lock.LeaderElector
+}
+
+func newLeaderWorker(elector lock.LeaderElector, worker model.Worker) model.Worker {
+	return &leaderWorker{
+		Worker:  worker,
+		elector: elector,
+	}
+}
+
+func (w *leaderWorker) Run(ctx context.Context) {
+	w.elector.Run(ctx, "workers."+w.Name(), w.Worker.Run)
+}
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+create table user_imports (
+    id bigserial primary key,
+    status text not null,
+    file_path text not null,
+    dry_run boolean not null default false,
+    total bigint not null default 0,
+    processed bigint not null default 0,
+    created bigint not null default 0,
+    failed bigint not null default 0,
+    report_path text,
+    error text,
+    created_by bigint references users (id),
+    created_at timestamp,
+    updated_at timestamp,
+    finished_at timestamp
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists user_imports;
+-- +goose StatementEnd
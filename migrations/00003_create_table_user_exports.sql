@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+create table user_exports (
+    id bigserial primary key,
+    status text not null,
+    format text not null,
+    filter jsonb not null default '{}',
+    total bigint not null default 0,
+    file_path text,
+    error text,
+    created_by bigint references users (id),
+    created_at timestamp,
+    updated_at timestamp,
+    finished_at timestamp
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists user_exports;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table users add column version bigint not null default 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table users drop column if exists version;
+-- +goose StatementEnd
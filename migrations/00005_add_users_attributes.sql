@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table users add column attributes jsonb not null default '{}';
+create index users_attributes_idx on users using gin (attributes jsonb_path_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists users_attributes_idx;
+alter table users drop column if exists attributes;
+-- +goose StatementEnd
@@ -0,0 +1,60 @@
+-- +goose Up
+-- +goose StatementBegin
+create table user_email_duplicates (
+    id bigserial primary key,
+    user_id bigint not null references users (id),
+    email text not null,
+    kept_user_id bigint not null references users (id),
+    created_at timestamp not null default now()
+);
+
+-- Среди пользователей с одинаковым без учета регистра email он остается у
+-- активного (а из них - у самого раннего), остальным он заменяется на
+-- уникальный. Исходные email сохраняются в user_email_duplicates
+with ranked as (
+    select
+        id,
+        email,
+        first_value(id) over w as kept_user_id,
+        row_number() over w as position
+    from users
+    where email is not null
+    window w as (partition by lower(email) order by deleted, id)
+)
+insert into user_email_duplicates (user_id, email, kept_user_id)
+select id, email, kept_user_id
+from ranked
+where position > 1;
+
+update users
+set
+    email = 'duplicate-' || users.id || '-' || users.email,
+    version = users.version + 1,
+    updated_at = now()
+from user_email_duplicates
+where user_email_duplicates.user_id = users.id;
+
+do $$
+declare
+    duplicates bigint;
+begin
+    select count(*) into duplicates from user_email_duplicates;
+    if duplicates > 0 then
+        raise warning 'users: % duplicate emails were renamed, see table user_email_duplicates', duplicates;
+    end if;
+end $$;
+
+create unique index users_email_lower_idx on users (lower(email));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists users_email_lower_idx;
+
+update users
+set email = user_email_duplicates.email
+from user_email_duplicates
+where user_email_duplicates.user_id = users.id;
+
+drop table if exists user_email_duplicates;
+-- +goose StatementEnd
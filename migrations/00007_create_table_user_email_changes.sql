@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+create table user_email_changes (
+    id bigserial primary key,
+    user_id bigint not null references users (id),
+    old_email text not null,
+    new_email text not null,
+    status text not null,
+    confirm_token_hash text not null unique,
+    undo_token_hash text not null unique,
+    expires_at timestamp not null,
+    undo_expires_at timestamp not null,
+    confirmed_at timestamp,
+    created_at timestamp,
+    updated_at timestamp
+);
+
+create index user_email_changes_user_id_idx on user_email_changes (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists user_email_changes;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+create table users_history (
+    id bigserial primary key,
+    user_id bigint not null references users (id),
+    version bigint not null,
+    operation text not null,
+    data jsonb not null,
+    changed_by bigint,
+    changed_at timestamp not null
+);
+
+create index users_history_user_id_changed_at_idx on users_history (user_id, changed_at);
+
+-- История существующих пользователей начинается с их текущего состояния
+insert into users_history (user_id, version, operation, data, changed_at)
+select
+    id,
+    version,
+    case when version = 1 then 'create' else 'update' end,
+    to_jsonb(users) || jsonb_build_object('password', md5(coalesce(password, ''))),
+    coalesce(updated_at, created_at, now())
+from users;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists users_history;
+-- +goose StatementEnd
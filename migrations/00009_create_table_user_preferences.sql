@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+create table user_preferences (
+    user_id bigint not null references users (id),
+    key text not null,
+    value jsonb not null,
+    updated_at timestamp not null default now(),
+    primary key (user_id, key)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists user_preferences;
+-- +goose StatementEnd
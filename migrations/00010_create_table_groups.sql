@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+create table groups (
+    id bigserial primary key,
+    name text not null,
+    description text not null default '',
+    created_by bigint references users (id),
+    created_at timestamp,
+    updated_at timestamp
+);
+
+create unique index groups_name_lower_idx on groups (lower(name));
+
+create table group_members (
+    group_id bigint not null references groups (id) on delete cascade,
+    user_id bigint not null references users (id),
+    added_by bigint references users (id),
+    created_at timestamp not null default now(),
+    primary key (group_id, user_id)
+);
+
+create index group_members_user_id_idx on group_members (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists group_members;
+drop table if exists groups;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+create table outbox (
+    id bigserial primary key,
+    topic text not null,
+    aggregate text not null,
+    key text not null,
+    payload jsonb not null,
+    request_id text,
+    actor_id bigint,
+    ip text,
+    attempts int not null default 0,
+    next_attempt_at timestamp not null default now(),
+    last_error text,
+    created_at timestamp not null default now(),
+    published_at timestamp,
+    failed_at timestamp
+);
+
+-- Ожидающие публикации сообщения в порядке добавления внутри агрегата
+create index outbox_pending_idx on outbox (aggregate, key, id) where published_at is null and failed_at is null;
+
+create index outbox_published_at_idx on outbox (published_at) where published_at is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists outbox;
+-- +goose StatementEnd
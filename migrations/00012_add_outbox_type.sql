@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Полное имя proto-сообщения, payload которого хранится в формате protojson.
+-- Пустое для сообщений, публикуемых как JSON
+alter table outbox add column type text not null default '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table outbox drop column if exists type;
+-- +goose StatementEnd
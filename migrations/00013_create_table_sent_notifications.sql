@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Отправленные уведомления. Запись создается в одной транзакции с отправкой,
+-- поэтому повторная доставка события не отправляет уведомление еще раз
+create table sent_notifications (
+    kind text not null,
+    key text not null,
+    sent_at timestamp not null default now(),
+    primary key (kind, key)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists sent_notifications;
+-- +goose StatementEnd
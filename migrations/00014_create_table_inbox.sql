@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Сообщения брокера, обработанные консьюмерами. Запись создается в одной
+-- транзакции с обработкой, поэтому повторно доставленное сообщение пропускается
+create table inbox (
+    consumer text not null,
+    message_id text not null,
+    processed_at timestamp not null default now(),
+    primary key (consumer, message_id)
+);
+
+create index inbox_processed_at_idx on inbox (processed_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists inbox;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Задачи планировщика и результат их последнего запуска. Строка создается
+-- лидером при запуске планировщика для каждой зарегистрированной задачи
+create table scheduled_jobs (
+    name text primary key,
+    schedule text not null,
+    timezone text not null,
+    catch_up text not null,
+    paused boolean not null default false,
+    -- Моменты времени хранятся с часовым поясом, чтобы не зависеть от
+    -- часового пояса сессии, в которой вызывается now()
+    next_run_at timestamptz not null,
+    -- Запуск вручную, который выполнит лидер при следующей проверке
+    triggered_at timestamptz,
+    last_run_at timestamptz,
+    last_status text,
+    last_error text,
+    last_duration_ms bigint,
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists scheduled_jobs;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Заголовки W3C traceparent, tracestate и baggage запроса, в котором создано
+-- сообщение. Восстанавливаются при публикации, чтобы консьюмер продолжил трассу
+alter table outbox add column trace_context jsonb;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table outbox drop column if exists trace_context;
+-- +goose StatementEnd
@@ -233,6 +233,7 @@ func (x *AuthRefreshResponse) GetRefreshToken() string {
 type AuthMeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Preferences   *Preferences           `protobuf:"bytes,2,opt,name=preferences,proto3" json:"preferences,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -274,12 +275,19 @@ func (x *AuthMeResponse) GetUser() *User {
 	return nil
 }
 
+func (x *AuthMeResponse) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
 var File_auth_proto protoreflect.FileDescriptor
 
 const file_auth_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"auth.proto\x12\x04auth\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\vusers.proto\"D\n" +
+	"auth.proto\x12\x04auth\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\vusers.proto\x1a\x11preferences.proto\"D\n" +
 	"\x10AuthLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"]\n" +
@@ -290,9 +298,10 @@ const file_auth_proto_rawDesc = "" +
 	"\rrefresh_token\x18\x01 \x01(\tR\rrefresh_token\"_\n" +
 	"\x13AuthRefreshResponse\x12\"\n" +
 	"\faccess_token\x18\x01 \x01(\tR\faccess_token\x12$\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\rrefresh_token\"1\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\rrefresh_token\"m\n" +
 	"\x0eAuthMeResponse\x12\x1f\n" +
-	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user2\xd8\x02\n" +
+	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\x12:\n" +
+	"\vpreferences\x18\x02 \x01(\v2\x18.preferences.PreferencesR\vpreferences2\xd8\x02\n" +
 	"\aAuthAPI\x12U\n" +
 	"\x05Login\x12\x16.auth.AuthLoginRequest\x1a\x17.auth.AuthLoginResponse\"\x1b\x92A\x02b\x00\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/auth/login\x12Q\n" +
 	"\x06Logout\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/auth/logout\x12]\n" +
@@ -327,23 +336,25 @@ var file_auth_proto_goTypes = []any{
 	(*AuthRefreshResponse)(nil), // 3: auth.AuthRefreshResponse
 	(*AuthMeResponse)(nil),      // 4: auth.AuthMeResponse
 	(*User)(nil),                // 5: users.User
-	(*emptypb.Empty)(nil),       // 6: google.protobuf.Empty
+	(*Preferences)(nil),         // 6: preferences.Preferences
+	(*emptypb.Empty)(nil),       // 7: google.protobuf.Empty
 }
 var file_auth_proto_depIdxs = []int32{
 	5, // 0: auth.AuthMeResponse.user:type_name -> users.User
-	0, // 1: auth.AuthAPI.Login:input_type -> auth.AuthLoginRequest
-	6, // 2: auth.AuthAPI.Logout:input_type -> google.protobuf.Empty
-	2, // 3: auth.AuthAPI.Refresh:input_type -> auth.AuthRefreshRequest
-	6, // 4: auth.AuthAPI.Me:input_type -> google.protobuf.Empty
-	1, // 5: auth.AuthAPI.Login:output_type -> auth.AuthLoginResponse
-	6, // 6: auth.AuthAPI.Logout:output_type -> google.protobuf.Empty
-	3, // 7: auth.AuthAPI.Refresh:output_type -> auth.AuthRefreshResponse
-	4, // 8: auth.AuthAPI.Me:output_type -> auth.AuthMeResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6, // 1: auth.AuthMeResponse.preferences:type_name -> preferences.Preferences
+	0, // 2: auth.AuthAPI.Login:input_type -> auth.AuthLoginRequest
+	7, // 3: auth.AuthAPI.Logout:input_type -> google.protobuf.Empty
+	2, // 4: auth.AuthAPI.Refresh:input_type -> auth.AuthRefreshRequest
+	7, // 5: auth.AuthAPI.Me:input_type -> google.protobuf.Empty
+	1, // 6: auth.AuthAPI.Login:output_type -> auth.AuthLoginResponse
+	7, // 7: auth.AuthAPI.Logout:output_type -> google.protobuf.Empty
+	3, // 8: auth.AuthAPI.Refresh:output_type -> auth.AuthRefreshResponse
+	4, // 9: auth.AuthAPI.Me:output_type -> auth.AuthMeResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_auth_proto_init() }
@@ -352,6 +363,7 @@ func file_auth_proto_init() {
 		return
 	}
 	file_users_proto_init()
+	file_preferences_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -0,0 +1,1494 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: broker.proto
+
+package pb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DLQMessage
+type DLQMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Topic string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Исходный subject сообщения
+	Subject       string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Consumer      string                 `protobuf:"bytes,4,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Attempts      int64                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	FailedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=failed_at,proto3" json:"failed_at,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,8,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Data          []byte                 `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQMessage) Reset() {
+	*x = DLQMessage{}
+	mi := &file_broker_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQMessage) ProtoMessage() {}
+
+func (x *DLQMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQMessage.ProtoReflect.Descriptor instead.
+func (*DLQMessage) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DLQMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DLQMessage) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *DLQMessage) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *DLQMessage) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *DLQMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DLQMessage) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DLQMessage) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+func (x *DLQMessage) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *DLQMessage) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DLQFilter
+type DLQFilter struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Subject  *string                `protobuf:"bytes,1,opt,name=subject,proto3,oneof" json:"subject,omitempty"`
+	Consumer *string                `protobuf:"bytes,2,opt,name=consumer,proto3,oneof" json:"consumer,omitempty"`
+	// Подстрока текста ошибки
+	Error         *string `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQFilter) Reset() {
+	*x = DLQFilter{}
+	mi := &file_broker_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQFilter) ProtoMessage() {}
+
+func (x *DLQFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQFilter.ProtoReflect.Descriptor instead.
+func (*DLQFilter) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DLQFilter) GetSubject() string {
+	if x != nil && x.Subject != nil {
+		return *x.Subject
+	}
+	return ""
+}
+
+func (x *DLQFilter) GetConsumer() string {
+	if x != nil && x.Consumer != nil {
+		return *x.Consumer
+	}
+	return ""
+}
+
+func (x *DLQFilter) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+// DLQListRequest
+type DLQListRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Topic  string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Filter *DLQFilter             `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Сообщения с номерами больше указанного
+	AfterId       *uint64 `protobuf:"varint,3,opt,name=after_id,proto3,oneof" json:"after_id,omitempty"`
+	Limit         *int64  `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQListRequest) Reset() {
+	*x = DLQListRequest{}
+	mi := &file_broker_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQListRequest) ProtoMessage() {}
+
+func (x *DLQListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQListRequest.ProtoReflect.Descriptor instead.
+func (*DLQListRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DLQListRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *DLQListRequest) GetFilter() *DLQFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *DLQListRequest) GetAfterId() uint64 {
+	if x != nil && x.AfterId != nil {
+		return *x.AfterId
+	}
+	return 0
+}
+
+func (x *DLQListRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+// DLQListResponse
+type DLQListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*DLQMessage          `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQListResponse) Reset() {
+	*x = DLQListResponse{}
+	mi := &file_broker_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQListResponse) ProtoMessage() {}
+
+func (x *DLQListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQListResponse.ProtoReflect.Descriptor instead.
+func (*DLQListResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DLQListResponse) GetMessages() []*DLQMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// DLQGetRequest
+type DLQGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQGetRequest) Reset() {
+	*x = DLQGetRequest{}
+	mi := &file_broker_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQGetRequest) ProtoMessage() {}
+
+func (x *DLQGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQGetRequest.ProtoReflect.Descriptor instead.
+func (*DLQGetRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DLQGetRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *DLQGetRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DLQGetResponse
+type DLQGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *DLQMessage            `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQGetResponse) Reset() {
+	*x = DLQGetResponse{}
+	mi := &file_broker_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQGetResponse) ProtoMessage() {}
+
+func (x *DLQGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQGetResponse.ProtoReflect.Descriptor instead.
+func (*DLQGetResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DLQGetResponse) GetMessage() *DLQMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// DLQReplayRequest выбирает сообщения по номерам, по фильтру или все
+type DLQReplayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Ids           []uint64               `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Filter        *DLQFilter             `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	All           bool                   `protobuf:"varint,4,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQReplayRequest) Reset() {
+	*x = DLQReplayRequest{}
+	mi := &file_broker_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQReplayRequest) ProtoMessage() {}
+
+func (x *DLQReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQReplayRequest.ProtoReflect.Descriptor instead.
+func (*DLQReplayRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DLQReplayRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *DLQReplayRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DLQReplayRequest) GetFilter() *DLQFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *DLQReplayRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+// DLQReplayResponse
+type DLQReplayResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ReplayedIds []uint64               `protobuf:"varint,1,rep,packed,name=replayed_ids,proto3" json:"replayed_ids,omitempty"`
+	// Сообщения без исходного subject, оставшиеся в DLQ
+	SkippedIds    []uint64 `protobuf:"varint,2,rep,packed,name=skipped_ids,proto3" json:"skipped_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQReplayResponse) Reset() {
+	*x = DLQReplayResponse{}
+	mi := &file_broker_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQReplayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQReplayResponse) ProtoMessage() {}
+
+func (x *DLQReplayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQReplayResponse.ProtoReflect.Descriptor instead.
+func (*DLQReplayResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DLQReplayResponse) GetReplayedIds() []uint64 {
+	if x != nil {
+		return x.ReplayedIds
+	}
+	return nil
+}
+
+func (x *DLQReplayResponse) GetSkippedIds() []uint64 {
+	if x != nil {
+		return x.SkippedIds
+	}
+	return nil
+}
+
+// DLQPurgeRequest
+type DLQPurgeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQPurgeRequest) Reset() {
+	*x = DLQPurgeRequest{}
+	mi := &file_broker_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQPurgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQPurgeRequest) ProtoMessage() {}
+
+func (x *DLQPurgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQPurgeRequest.ProtoReflect.Descriptor instead.
+func (*DLQPurgeRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DLQPurgeRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+// DLQPurgeResponse
+type DLQPurgeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purged        int64                  `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQPurgeResponse) Reset() {
+	*x = DLQPurgeResponse{}
+	mi := &file_broker_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQPurgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQPurgeResponse) ProtoMessage() {}
+
+func (x *DLQPurgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQPurgeResponse.ProtoReflect.Descriptor instead.
+func (*DLQPurgeResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DLQPurgeResponse) GetPurged() int64 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+// StreamStats
+type StreamStats struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Topic    string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Messages uint64                 `protobuf:"varint,2,opt,name=messages,proto3" json:"messages,omitempty"`
+	Bytes    uint64                 `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	FirstId  uint64                 `protobuf:"varint,4,opt,name=first_id,proto3" json:"first_id,omitempty"`
+	LastId   uint64                 `protobuf:"varint,5,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	// Время последнего сообщения, отсутствует для пустого топика
+	LastTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_time,proto3" json:"last_time,omitempty"`
+	Consumers     int64                  `protobuf:"varint,7,opt,name=consumers,proto3" json:"consumers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamStats) Reset() {
+	*x = StreamStats{}
+	mi := &file_broker_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStats) ProtoMessage() {}
+
+func (x *StreamStats) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStats.ProtoReflect.Descriptor instead.
+func (*StreamStats) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StreamStats) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *StreamStats) GetMessages() uint64 {
+	if x != nil {
+		return x.Messages
+	}
+	return 0
+}
+
+func (x *StreamStats) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *StreamStats) GetFirstId() uint64 {
+	if x != nil {
+		return x.FirstId
+	}
+	return 0
+}
+
+func (x *StreamStats) GetLastId() uint64 {
+	if x != nil {
+		return x.LastId
+	}
+	return 0
+}
+
+func (x *StreamStats) GetLastTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTime
+	}
+	return nil
+}
+
+func (x *StreamStats) GetConsumers() int64 {
+	if x != nil {
+		return x.Consumers
+	}
+	return 0
+}
+
+// StreamStatsRequest
+type StreamStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamStatsRequest) Reset() {
+	*x = StreamStatsRequest{}
+	mi := &file_broker_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStatsRequest) ProtoMessage() {}
+
+func (x *StreamStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStatsRequest.ProtoReflect.Descriptor instead.
+func (*StreamStatsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{11}
+}
+
+// StreamStatsResponse
+type StreamStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Streams       []*StreamStats         `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamStatsResponse) Reset() {
+	*x = StreamStatsResponse{}
+	mi := &file_broker_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStatsResponse) ProtoMessage() {}
+
+func (x *StreamStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStatsResponse.ProtoReflect.Descriptor instead.
+func (*StreamStatsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StreamStatsResponse) GetStreams() []*StreamStats {
+	if x != nil {
+		return x.Streams
+	}
+	return nil
+}
+
+// ConsumerStats
+type ConsumerStats struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Topic    string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Consumer string                 `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	// Имя консьюмера партиции на сервере
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Subject string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Сообщения, которые еще не доставлены консьюмеру
+	Pending uint64 `protobuf:"varint,5,opt,name=pending,proto3" json:"pending,omitempty"`
+	// Доставленные сообщения, обработка которых не подтверждена
+	AckPending  int64 `protobuf:"varint,6,opt,name=ack_pending,proto3" json:"ack_pending,omitempty"`
+	Redelivered int64 `protobuf:"varint,7,opt,name=redelivered,proto3" json:"redelivered,omitempty"`
+	// Время последней доставки, отсутствует, если доставок не было
+	LastActive    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_active,proto3" json:"last_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumerStats) Reset() {
+	*x = ConsumerStats{}
+	mi := &file_broker_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerStats) ProtoMessage() {}
+
+func (x *ConsumerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerStats.ProtoReflect.Descriptor instead.
+func (*ConsumerStats) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConsumerStats) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ConsumerStats) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *ConsumerStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConsumerStats) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ConsumerStats) GetPending() uint64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *ConsumerStats) GetAckPending() int64 {
+	if x != nil {
+		return x.AckPending
+	}
+	return 0
+}
+
+func (x *ConsumerStats) GetRedelivered() int64 {
+	if x != nil {
+		return x.Redelivered
+	}
+	return 0
+}
+
+func (x *ConsumerStats) GetLastActive() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActive
+	}
+	return nil
+}
+
+// ConsumerStatsRequest
+type ConsumerStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumerStatsRequest) Reset() {
+	*x = ConsumerStatsRequest{}
+	mi := &file_broker_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerStatsRequest) ProtoMessage() {}
+
+func (x *ConsumerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerStatsRequest.ProtoReflect.Descriptor instead.
+func (*ConsumerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{14}
+}
+
+// ConsumerStatsResponse
+type ConsumerStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consumers     []*ConsumerStats       `protobuf:"bytes,1,rep,name=consumers,proto3" json:"consumers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumerStatsResponse) Reset() {
+	*x = ConsumerStatsResponse{}
+	mi := &file_broker_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerStatsResponse) ProtoMessage() {}
+
+func (x *ConsumerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerStatsResponse.ProtoReflect.Descriptor instead.
+func (*ConsumerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ConsumerStatsResponse) GetConsumers() []*ConsumerStats {
+	if x != nil {
+		return x.Consumers
+	}
+	return nil
+}
+
+// Schema версия схемы событий топика
+type Schema struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Topic   string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Version int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Формат событий: json или protobuf
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// Полное имя proto-сообщения события
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// JSON Schema событий формата json
+	JsonSchema *structpb.Struct `protobuf:"bytes,5,opt,name=json_schema,proto3" json:"json_schema,omitempty"`
+	// FileDescriptorSet с сообщением события формата protobuf
+	DescriptorSet []byte                 `protobuf:"bytes,6,opt,name=descriptor_set,proto3" json:"descriptor_set,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schema) Reset() {
+	*x = Schema{}
+	mi := &file_broker_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schema) ProtoMessage() {}
+
+func (x *Schema) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schema.ProtoReflect.Descriptor instead.
+func (*Schema) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Schema) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *Schema) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Schema) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Schema) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Schema) GetJsonSchema() *structpb.Struct {
+	if x != nil {
+		return x.JsonSchema
+	}
+	return nil
+}
+
+func (x *Schema) GetDescriptorSet() []byte {
+	if x != nil {
+		return x.DescriptorSet
+	}
+	return nil
+}
+
+func (x *Schema) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// SchemaListRequest
+type SchemaListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Схемы одного топика, по умолчанию схемы всех топиков
+	Topic         *string `protobuf:"bytes,1,opt,name=topic,proto3,oneof" json:"topic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaListRequest) Reset() {
+	*x = SchemaListRequest{}
+	mi := &file_broker_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaListRequest) ProtoMessage() {}
+
+func (x *SchemaListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaListRequest.ProtoReflect.Descriptor instead.
+func (*SchemaListRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SchemaListRequest) GetTopic() string {
+	if x != nil && x.Topic != nil {
+		return *x.Topic
+	}
+	return ""
+}
+
+// SchemaListResponse
+type SchemaListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schemas       []*Schema              `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaListResponse) Reset() {
+	*x = SchemaListResponse{}
+	mi := &file_broker_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaListResponse) ProtoMessage() {}
+
+func (x *SchemaListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaListResponse.ProtoReflect.Descriptor instead.
+func (*SchemaListResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SchemaListResponse) GetSchemas() []*Schema {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
+}
+
+// SchemaGetRequest
+type SchemaGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaGetRequest) Reset() {
+	*x = SchemaGetRequest{}
+	mi := &file_broker_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaGetRequest) ProtoMessage() {}
+
+func (x *SchemaGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaGetRequest.ProtoReflect.Descriptor instead.
+func (*SchemaGetRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SchemaGetRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *SchemaGetRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// SchemaGetResponse
+type SchemaGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schema        *Schema                `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaGetResponse) Reset() {
+	*x = SchemaGetResponse{}
+	mi := &file_broker_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaGetResponse) ProtoMessage() {}
+
+func (x *SchemaGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaGetResponse.ProtoReflect.Descriptor instead.
+func (*SchemaGetResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SchemaGetResponse) GetSchema() *Schema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+var File_broker_proto protoreflect.FileDescriptor
+
+const file_broker_proto_rawDesc = "" +
+	"\n" +
+	"\fbroker.proto\x12\x06broker\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xdf\x02\n" +
+	"\n" +
+	"DLQMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topic\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x1a\n" +
+	"\bconsumer\x18\x04 \x01(\tR\bconsumer\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x03R\battempts\x128\n" +
+	"\tfailed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tfailed_at\x129\n" +
+	"\aheaders\x18\b \x03(\v2\x1f.broker.DLQMessage.HeadersEntryR\aheaders\x12\x12\n" +
+	"\x04data\x18\t \x01(\fR\x04data\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x89\x01\n" +
+	"\tDLQFilter\x12\x1d\n" +
+	"\asubject\x18\x01 \x01(\tH\x00R\asubject\x88\x01\x01\x12\x1f\n" +
+	"\bconsumer\x18\x02 \x01(\tH\x01R\bconsumer\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\x03 \x01(\tH\x02R\x05error\x88\x01\x01B\n" +
+	"\n" +
+	"\b_subjectB\v\n" +
+	"\t_consumerB\b\n" +
+	"\x06_error\"\xb0\x01\n" +
+	"\x0eDLQListRequest\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12)\n" +
+	"\x06filter\x18\x02 \x01(\v2\x11.broker.DLQFilterR\x06filter\x12\x1f\n" +
+	"\bafter_id\x18\x03 \x01(\x04H\x00R\bafter_id\x88\x01\x01\x12%\n" +
+	"\x05limit\x18\x04 \x01(\x03B\n" +
+	"\xfaB\a\"\x05\x18\xe8\a \x00H\x01R\x05limit\x88\x01\x01B\v\n" +
+	"\t_after_idB\b\n" +
+	"\x06_limit\"A\n" +
+	"\x0fDLQListResponse\x12.\n" +
+	"\bmessages\x18\x01 \x03(\v2\x12.broker.DLQMessageR\bmessages\"5\n" +
+	"\rDLQGetRequest\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\">\n" +
+	"\x0eDLQGetResponse\x12,\n" +
+	"\amessage\x18\x01 \x01(\v2\x12.broker.DLQMessageR\amessage\"\x82\x01\n" +
+	"\x10DLQReplayRequest\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x1b\n" +
+	"\x03ids\x18\x02 \x03(\x04B\t\xfaB\x06\x92\x01\x03\x10\xe8\aR\x03ids\x12)\n" +
+	"\x06filter\x18\x03 \x01(\v2\x11.broker.DLQFilterR\x06filter\x12\x10\n" +
+	"\x03all\x18\x04 \x01(\bR\x03all\"Y\n" +
+	"\x11DLQReplayResponse\x12\"\n" +
+	"\freplayed_ids\x18\x01 \x03(\x04R\freplayed_ids\x12 \n" +
+	"\vskipped_ids\x18\x02 \x03(\x04R\vskipped_ids\"'\n" +
+	"\x0fDLQPurgeRequest\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\"*\n" +
+	"\x10DLQPurgeResponse\x12\x16\n" +
+	"\x06purged\x18\x01 \x01(\x03R\x06purged\"\xe3\x01\n" +
+	"\vStreamStats\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x1a\n" +
+	"\bmessages\x18\x02 \x01(\x04R\bmessages\x12\x14\n" +
+	"\x05bytes\x18\x03 \x01(\x04R\x05bytes\x12\x1a\n" +
+	"\bfirst_id\x18\x04 \x01(\x04R\bfirst_id\x12\x18\n" +
+	"\alast_id\x18\x05 \x01(\x04R\alast_id\x128\n" +
+	"\tlast_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tlast_time\x12\x1c\n" +
+	"\tconsumers\x18\a \x01(\x03R\tconsumers\"\x14\n" +
+	"\x12StreamStatsRequest\"D\n" +
+	"\x13StreamStatsResponse\x12-\n" +
+	"\astreams\x18\x01 \x03(\v2\x13.broker.StreamStatsR\astreams\"\x8b\x02\n" +
+	"\rConsumerStats\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x1a\n" +
+	"\bconsumer\x18\x02 \x01(\tR\bconsumer\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x18\n" +
+	"\apending\x18\x05 \x01(\x04R\apending\x12 \n" +
+	"\vack_pending\x18\x06 \x01(\x03R\vack_pending\x12 \n" +
+	"\vredelivered\x18\a \x01(\x03R\vredelivered\x12<\n" +
+	"\vlast_active\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vlast_active\"\x16\n" +
+	"\x14ConsumerStatsRequest\"L\n" +
+	"\x15ConsumerStatsResponse\x123\n" +
+	"\tconsumers\x18\x01 \x03(\v2\x15.broker.ConsumerStatsR\tconsumers\"\x89\x02\n" +
+	"\x06Schema\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x129\n" +
+	"\vjson_schema\x18\x05 \x01(\v2\x17.google.protobuf.StructR\vjson_schema\x12&\n" +
+	"\x0edescriptor_set\x18\x06 \x01(\fR\x0edescriptor_set\x12:\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\"8\n" +
+	"\x11SchemaListRequest\x12\x19\n" +
+	"\x05topic\x18\x01 \x01(\tH\x00R\x05topic\x88\x01\x01B\b\n" +
+	"\x06_topic\">\n" +
+	"\x12SchemaListResponse\x12(\n" +
+	"\aschemas\x18\x01 \x03(\v2\x0e.broker.SchemaR\aschemas\"K\n" +
+	"\x10SchemaGetRequest\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12!\n" +
+	"\aversion\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aversion\";\n" +
+	"\x11SchemaGetResponse\x12&\n" +
+	"\x06schema\x18\x01 \x01(\v2\x0e.broker.SchemaR\x06schema2\xd2\x06\n" +
+	"\tBrokerAPI\x12h\n" +
+	"\x0fListDLQMessages\x12\x16.broker.DLQListRequest\x1a\x17.broker.DLQListResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/broker/dlq/{topic}/messages\x12i\n" +
+	"\rGetDLQMessage\x12\x15.broker.DLQGetRequest\x1a\x16.broker.DLQGetResponse\")\x82\xd3\xe4\x93\x02#\x12!/broker/dlq/{topic}/messages/{id}\x12o\n" +
+	"\x11ReplayDLQMessages\x12\x18.broker.DLQReplayRequest\x1a\x19.broker.DLQReplayResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/broker/dlq/{topic}/replay\x12c\n" +
+	"\bPurgeDLQ\x12\x17.broker.DLQPurgeRequest\x1a\x18.broker.DLQPurgeResponse\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/broker/dlq/{topic}/messages\x12b\n" +
+	"\x0eGetStreamStats\x12\x1a.broker.StreamStatsRequest\x1a\x1b.broker.StreamStatsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/broker/streams\x12j\n" +
+	"\x10GetConsumerStats\x12\x1c.broker.ConsumerStatsRequest\x1a\x1d.broker.ConsumerStatsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/broker/consumers\x12]\n" +
+	"\vListSchemas\x12\x19.broker.SchemaListRequest\x1a\x1a.broker.SchemaListResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/broker/schemas\x12k\n" +
+	"\tGetSchema\x12\x18.broker.SchemaGetRequest\x1a\x19.broker.SchemaGetResponse\")\x82\xd3\xe4\x93\x02#\x12!/broker/schemas/{topic}/{version}B\xd3\x01\x92An\x12\x13\n" +
+	"\n" +
+	"Broker API2\x051.0.0\"\x04/api2\x10application/json:\x10application/jsonZ\x1f\n" +
+	"\x1d\n" +
+	"\x06x-auth\x12\x13\b\x02\x1a\rauthorization \x02b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06x-auth\x12\x00\n" +
+	"\n" +
+	"com.brokerB\vBrokerProtoP\x01Z\x0fgreenaid/pkg/pb\xa2\x02\x03BXX\xaa\x02\x06Broker\xca\x02\x06Broker\xe2\x02\x12Broker\\GPBMetadata\xea\x02\x06Brokerb\x06proto3"
+
+var (
+	file_broker_proto_rawDescOnce sync.Once
+	file_broker_proto_rawDescData []byte
+)
+
+func file_broker_proto_rawDescGZIP() []byte {
+	file_broker_proto_rawDescOnce.Do(func() {
+		file_broker_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_broker_proto_rawDesc), len(file_broker_proto_rawDesc)))
+	})
+	return file_broker_proto_rawDescData
+}
+
+var file_broker_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_broker_proto_goTypes = []any{
+	(*DLQMessage)(nil),            // 0: broker.DLQMessage
+	(*DLQFilter)(nil),             // 1: broker.DLQFilter
+	(*DLQListRequest)(nil),        // 2: broker.DLQListRequest
+	(*DLQListResponse)(nil),       // 3: broker.DLQListResponse
+	(*DLQGetRequest)(nil),         // 4: broker.DLQGetRequest
+	(*DLQGetResponse)(nil),        // 5: broker.DLQGetResponse
+	(*DLQReplayRequest)(nil),      // 6: broker.DLQReplayRequest
+	(*DLQReplayResponse)(nil),     // 7: broker.DLQReplayResponse
+	(*DLQPurgeRequest)(nil),       // 8: broker.DLQPurgeRequest
+	(*DLQPurgeResponse)(nil),      // 9: broker.DLQPurgeResponse
+	(*StreamStats)(nil),           // 10: broker.StreamStats
+	(*StreamStatsRequest)(nil),    // 11: broker.StreamStatsRequest
+	(*StreamStatsResponse)(nil),   // 12: broker.StreamStatsResponse
+	(*ConsumerStats)(nil),         // 13: broker.ConsumerStats
+	(*ConsumerStatsRequest)(nil),  // 14: broker.ConsumerStatsRequest
+	(*ConsumerStatsResponse)(nil), // 15: broker.ConsumerStatsResponse
+	(*Schema)(nil),                // 16: broker.Schema
+	(*SchemaListRequest)(nil),     // 17: broker.SchemaListRequest
+	(*SchemaListResponse)(nil),    // 18: broker.SchemaListResponse
+	(*SchemaGetRequest)(nil),      // 19: broker.SchemaGetRequest
+	(*SchemaGetResponse)(nil),     // 20: broker.SchemaGetResponse
+	nil,                           // 21: broker.DLQMessage.HeadersEntry
+	(*timestamppb.Timestamp)(nil), // 22: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 23: google.protobuf.Struct
+}
+var file_broker_proto_depIdxs = []int32{
+	22, // 0: broker.DLQMessage.failed_at:type_name -> google.protobuf.Timestamp
+	21, // 1: broker.DLQMessage.headers:type_name -> broker.DLQMessage.HeadersEntry
+	1,  // 2: broker.DLQListRequest.filter:type_name -> broker.DLQFilter
+	0,  // 3: broker.DLQListResponse.messages:type_name -> broker.DLQMessage
+	0,  // 4: broker.DLQGetResponse.message:type_name -> broker.DLQMessage
+	1,  // 5: broker.DLQReplayRequest.filter:type_name -> broker.DLQFilter
+	22, // 6: broker.StreamStats.last_time:type_name -> google.protobuf.Timestamp
+	10, // 7: broker.StreamStatsResponse.streams:type_name -> broker.StreamStats
+	22, // 8: broker.ConsumerStats.last_active:type_name -> google.protobuf.Timestamp
+	13, // 9: broker.ConsumerStatsResponse.consumers:type_name -> broker.ConsumerStats
+	23, // 10: broker.Schema.json_schema:type_name -> google.protobuf.Struct
+	22, // 11: broker.Schema.created_at:type_name -> google.protobuf.Timestamp
+	16, // 12: broker.SchemaListResponse.schemas:type_name -> broker.Schema
+	16, // 13: broker.SchemaGetResponse.schema:type_name -> broker.Schema
+	2,  // 14: broker.BrokerAPI.ListDLQMessages:input_type -> broker.DLQListRequest
+	4,  // 15: broker.BrokerAPI.GetDLQMessage:input_type -> broker.DLQGetRequest
+	6,  // 16: broker.BrokerAPI.ReplayDLQMessages:input_type -> broker.DLQReplayRequest
+	8,  // 17: broker.BrokerAPI.PurgeDLQ:input_type -> broker.DLQPurgeRequest
+	11, // 18: broker.BrokerAPI.GetStreamStats:input_type -> broker.StreamStatsRequest
+	14, // 19: broker.BrokerAPI.GetConsumerStats:input_type -> broker.ConsumerStatsRequest
+	17, // 20: broker.BrokerAPI.ListSchemas:input_type -> broker.SchemaListRequest
+	19, // 21: broker.BrokerAPI.GetSchema:input_type -> broker.SchemaGetRequest
+	3,  // 22: broker.BrokerAPI.ListDLQMessages:output_type -> broker.DLQListResponse
+	5,  // 23: broker.BrokerAPI.GetDLQMessage:output_type -> broker.DLQGetResponse
+	7,  // 24: broker.BrokerAPI.ReplayDLQMessages:output_type -> broker.DLQReplayResponse
+	9,  // 25: broker.BrokerAPI.PurgeDLQ:output_type -> broker.DLQPurgeResponse
+	12, // 26: broker.BrokerAPI.GetStreamStats:output_type -> broker.StreamStatsResponse
+	15, // 27: broker.BrokerAPI.GetConsumerStats:output_type -> broker.ConsumerStatsResponse
+	18, // 28: broker.BrokerAPI.ListSchemas:output_type -> broker.SchemaListResponse
+	20, // 29: broker.BrokerAPI.GetSchema:output_type -> broker.SchemaGetResponse
+	22, // [22:30] is the sub-list for method output_type
+	14, // [14:22] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_broker_proto_init() }
+func file_broker_proto_init() {
+	if File_broker_proto != nil {
+		return
+	}
+	file_broker_proto_msgTypes[1].OneofWrappers = []any{}
+	file_broker_proto_msgTypes[2].OneofWrappers = []any{}
+	file_broker_proto_msgTypes[17].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_broker_proto_rawDesc), len(file_broker_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_broker_proto_goTypes,
+		DependencyIndexes: file_broker_proto_depIdxs,
+		MessageInfos:      file_broker_proto_msgTypes,
+	}.Build()
+	File_broker_proto = out.File
+	file_broker_proto_goTypes = nil
+	file_broker_proto_depIdxs = nil
+}
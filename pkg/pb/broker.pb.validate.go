@@ -0,0 +1,2669 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: broker.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on DLQMessage with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DLQMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DLQMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DLQMessageMultiError, or
+// nil if none found.
+func (m *DLQMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DLQMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Topic
+
+	// no validation rules for Subject
+
+	// no validation rules for Consumer
+
+	// no validation rules for Error
+
+	// no validation rules for Attempts
+
+	if all {
+		switch v := interface{}(m.GetFailedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DLQMessageValidationError{
+					field:  "FailedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DLQMessageValidationError{
+					field:  "FailedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFailedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DLQMessageValidationError{
+				field:  "FailedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Headers
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return DLQMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// DLQMessageMultiError is an error wrapping multiple validation errors
+// returned by DLQMessage.ValidateAll() if the designated constraints aren't met.
+type DLQMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DLQMessageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DLQMessageMultiError) AllErrors() []error { return m }
+
+// DLQMessageValidationError is the validation error returned by
+// DLQMessage.Validate if the designated constraints aren't met.
+type DLQMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DLQMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DLQMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DLQMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DLQMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DLQMessageValidationError) ErrorName() string { return "DLQMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DLQMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDLQMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DLQMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DLQMessageValidationError{}
+
+// Validate checks the field values on DLQFilter with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DLQFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DLQFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DLQFilterMultiError, or nil
+// if none found.
+func (m *DLQFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DLQFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Subject != nil {
+		// no validation rules for Subject
+	}
+
+	if m.Consumer != nil {
+		// no validation rules for Consumer
+	}
+
+	if m.Error != nil {
+		// no validation rules for Error
+	}
+
+	if len(errors) > 0 {
+		return DLQFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// DLQFilterMultiError is an error wrapping multiple validation errors returned
+// by DLQFilter.ValidateAll() if the designated constraints aren't met.
+type DLQFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DLQFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DLQFilterMultiError) AllErrors() []error { return m }
+
+// DLQFilterValidationError is the validation error returned by
+// DLQFilter.Validate if the designated constraints aren't met.
+type DLQFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DLQFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DLQFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DLQFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DLQFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DLQFilterValidationError) ErrorName() string { return "DLQFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DLQFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDLQFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DLQFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DLQFilterValidationError{}
+
+// Validate checks the field values on DLQListRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DLQListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DLQListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DLQListRequestMultiError,
+// or nil if none found.
+func (m *DLQListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DLQListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Topic
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DLQListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DLQListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DLQListRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.AfterId != nil {
+		// no validation rules for AfterId
+	}
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val <= 0 || val > 1000 {
+			err := DLQListRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range (0, 1000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DLQListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DLQListRequestMultiError is an error wrapping multiple validation errors
+// returned by DLQListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DLQListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DLQListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DLQListRequestMultiError) AllErrors() []error { return m }
+
+// DLQListRequestValidationError is the validation error returned by
+// DLQListRequest.Validate if the designated constraints aren't met.
+type DLQListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DLQListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DLQListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DLQListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DLQListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DLQListRequestValidationError) ErrorName() string { return "DLQListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DLQListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDLQListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DLQListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DLQListRequestValidationError{}
+
+// Validate checks the field values on DLQListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DLQListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DLQListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DLQListResponseMultiError, or nil if none found.
+func (m *DLQListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DLQListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DLQListResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DLQListResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DLQListResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DLQListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DLQListResponseMultiError is an error wrapping multiple validation errors
+// returned by DLQListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DLQListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DLQListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DLQListResponseMultiError) AllErrors() []error { return m }
+
+// DLQListResponseValidationError is the validation error returned by
+// DLQListResponse.Validate if the designated constraints aren't met.
+type DLQListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DLQListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DLQListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DLQListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DLQListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DLQListResponseValidationError) ErrorName() string { return "DLQListResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DLQListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDLQListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DLQListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DLQListResponseValidationError{}
+
+// Validate checks the field values on DLQGetRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DLQGetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DLQGetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DLQGetRequestMultiError, or
+// nil if none found.
+func (m *DLQGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DLQGetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Topic
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DLQGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DLQGetRequestMultiError is an error wrapping multiple validation errors
+// returned by DLQGetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DLQGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DLQGetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DLQGetRequestMultiError) AllErrors() []error { return m }
+
+// DLQGetRequestValidationError is the validation error returned by
+// DLQGetRequest.Validate if the designated constraints aren't met.
+type DLQGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DLQGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DLQGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DLQGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DLQGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DLQGetRequestValidationError) ErrorName() string { return "DLQGetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DLQGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDLQGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DLQGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DLQGetRequestValidationError{}
+
+// Validate checks the field values on DLQGetResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DLQGetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DLQGetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DLQGetResponseMultiError,
+// or nil if none found.
+func (m *DLQGetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DLQGetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DLQGetResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DLQGetResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DLQGetResponseValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DLQGetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DLQGetResponseMultiError is an error wrapping multiple validation errors
+// returned by DLQGetResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DLQGetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DLQGetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DLQGetResponseMultiError) AllErrors() []error { return m }
+
+// DLQGetResponseValidationError is the validation error returned by
+// DLQGetResponse.Validate if the designated constraints aren't met.
+type DLQGetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DLQGetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DLQGetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DLQGetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DLQGetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DLQGetResponseValidationError) ErrorName() string { return "DLQGetResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DLQGetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDLQGetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DLQGetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DLQGetResponseValidationError{}
+
+// Validate checks the field values on DLQReplayRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DLQReplayRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DLQReplayRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DLQReplayRequestMultiError, or nil if none found.
+func (m *DLQReplayRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DLQReplayRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Topic
+
+	if len(m.GetIds()) > 1000 {
+		err := DLQReplayRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DLQReplayRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DLQReplayRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DLQReplayRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for All
+
+	if len(errors) > 0 {
+		return DLQReplayRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DLQReplayRequestMultiError is an error wrapping multiple validation errors
+// returned by DLQReplayRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DLQReplayRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DLQReplayRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DLQReplayRequestMultiError) AllErrors() []error { return m }
+
+// DLQReplayRequestValidationError is the validation error returned by
+// DLQReplayRequest.Validate if the designated constraints aren't met.
+type DLQReplayRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DLQReplayRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DLQReplayRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DLQReplayRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DLQReplayRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DLQReplayRequestValidationError) ErrorName() string { return "DLQReplayRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DLQReplayRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDLQReplayRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DLQReplayRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DLQReplayRequestValidationError{}
+
+// Validate checks the field values on DLQReplayResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DLQReplayResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DLQReplayResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DLQReplayResponseMultiError, or nil if none found.
+func (m *DLQReplayResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DLQReplayResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DLQReplayResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DLQReplayResponseMultiError is an error wrapping multiple validation errors
+// returned by DLQReplayResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DLQReplayResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DLQReplayResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DLQReplayResponseMultiError) AllErrors() []error { return m }
+
+// DLQReplayResponseValidationError is the validation error returned by
+// DLQReplayResponse.Validate if the designated constraints aren't met.
+type DLQReplayResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DLQReplayResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DLQReplayResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DLQReplayResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DLQReplayResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DLQReplayResponseValidationError) ErrorName() string {
+	return "DLQReplayResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DLQReplayResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDLQReplayResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DLQReplayResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DLQReplayResponseValidationError{}
+
+// Validate checks the field values on DLQPurgeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DLQPurgeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DLQPurgeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DLQPurgeRequestMultiError, or nil if none found.
+func (m *DLQPurgeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DLQPurgeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Topic
+
+	if len(errors) > 0 {
+		return DLQPurgeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DLQPurgeRequestMultiError is an error wrapping multiple validation errors
+// returned by DLQPurgeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DLQPurgeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DLQPurgeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DLQPurgeRequestMultiError) AllErrors() []error { return m }
+
+// DLQPurgeRequestValidationError is the validation error returned by
+// DLQPurgeRequest.Validate if the designated constraints aren't met.
+type DLQPurgeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DLQPurgeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DLQPurgeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DLQPurgeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DLQPurgeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DLQPurgeRequestValidationError) ErrorName() string { return "DLQPurgeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DLQPurgeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDLQPurgeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DLQPurgeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DLQPurgeRequestValidationError{}
+
+// Validate checks the field values on DLQPurgeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DLQPurgeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DLQPurgeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DLQPurgeResponseMultiError, or nil if none found.
+func (m *DLQPurgeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DLQPurgeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Purged
+
+	if len(errors) > 0 {
+		return DLQPurgeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DLQPurgeResponseMultiError is an error wrapping multiple validation errors
+// returned by DLQPurgeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DLQPurgeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DLQPurgeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DLQPurgeResponseMultiError) AllErrors() []error { return m }
+
+// DLQPurgeResponseValidationError is the validation error returned by
+// DLQPurgeResponse.Validate if the designated constraints aren't met.
+type DLQPurgeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DLQPurgeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DLQPurgeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DLQPurgeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DLQPurgeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DLQPurgeResponseValidationError) ErrorName() string { return "DLQPurgeResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DLQPurgeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDLQPurgeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DLQPurgeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DLQPurgeResponseValidationError{}
+
+// Validate checks the field values on StreamStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StreamStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StreamStatsMultiError, or
+// nil if none found.
+func (m *StreamStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Topic
+
+	// no validation rules for Messages
+
+	// no validation rules for Bytes
+
+	// no validation rules for FirstId
+
+	// no validation rules for LastId
+
+	if all {
+		switch v := interface{}(m.GetLastTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamStatsValidationError{
+					field:  "LastTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreamStatsValidationError{
+					field:  "LastTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamStatsValidationError{
+				field:  "LastTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Consumers
+
+	if len(errors) > 0 {
+		return StreamStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamStatsMultiError is an error wrapping multiple validation errors
+// returned by StreamStats.ValidateAll() if the designated constraints aren't met.
+type StreamStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamStatsMultiError) AllErrors() []error { return m }
+
+// StreamStatsValidationError is the validation error returned by
+// StreamStats.Validate if the designated constraints aren't met.
+type StreamStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamStatsValidationError) ErrorName() string { return "StreamStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StreamStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamStatsValidationError{}
+
+// Validate checks the field values on StreamStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamStatsRequestMultiError, or nil if none found.
+func (m *StreamStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StreamStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamStatsRequestMultiError is an error wrapping multiple validation errors
+// returned by StreamStatsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StreamStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamStatsRequestMultiError) AllErrors() []error { return m }
+
+// StreamStatsRequestValidationError is the validation error returned by
+// StreamStatsRequest.Validate if the designated constraints aren't met.
+type StreamStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamStatsRequestValidationError) ErrorName() string {
+	return "StreamStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamStatsRequestValidationError{}
+
+// Validate checks the field values on StreamStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamStatsResponseMultiError, or nil if none found.
+func (m *StreamStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetStreams() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StreamStatsResponseValidationError{
+						field:  fmt.Sprintf("Streams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StreamStatsResponseValidationError{
+						field:  fmt.Sprintf("Streams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamStatsResponseValidationError{
+					field:  fmt.Sprintf("Streams[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StreamStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by StreamStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StreamStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamStatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamStatsResponseMultiError) AllErrors() []error { return m }
+
+// StreamStatsResponseValidationError is the validation error returned by
+// StreamStatsResponse.Validate if the designated constraints aren't met.
+type StreamStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamStatsResponseValidationError) ErrorName() string {
+	return "StreamStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamStatsResponseValidationError{}
+
+// Validate checks the field values on ConsumerStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConsumerStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsumerStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConsumerStatsMultiError, or
+// nil if none found.
+func (m *ConsumerStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsumerStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Topic
+
+	// no validation rules for Consumer
+
+	// no validation rules for Name
+
+	// no validation rules for Subject
+
+	// no validation rules for Pending
+
+	// no validation rules for AckPending
+
+	// no validation rules for Redelivered
+
+	if all {
+		switch v := interface{}(m.GetLastActive()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConsumerStatsValidationError{
+					field:  "LastActive",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConsumerStatsValidationError{
+					field:  "LastActive",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastActive()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConsumerStatsValidationError{
+				field:  "LastActive",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ConsumerStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsumerStatsMultiError is an error wrapping multiple validation errors
+// returned by ConsumerStats.ValidateAll() if the designated constraints
+// aren't met.
+type ConsumerStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsumerStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsumerStatsMultiError) AllErrors() []error { return m }
+
+// ConsumerStatsValidationError is the validation error returned by
+// ConsumerStats.Validate if the designated constraints aren't met.
+type ConsumerStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsumerStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsumerStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsumerStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsumerStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsumerStatsValidationError) ErrorName() string { return "ConsumerStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConsumerStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsumerStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsumerStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsumerStatsValidationError{}
+
+// Validate checks the field values on ConsumerStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConsumerStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsumerStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsumerStatsRequestMultiError, or nil if none found.
+func (m *ConsumerStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsumerStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ConsumerStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsumerStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by ConsumerStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ConsumerStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsumerStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsumerStatsRequestMultiError) AllErrors() []error { return m }
+
+// ConsumerStatsRequestValidationError is the validation error returned by
+// ConsumerStatsRequest.Validate if the designated constraints aren't met.
+type ConsumerStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsumerStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsumerStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsumerStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsumerStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsumerStatsRequestValidationError) ErrorName() string {
+	return "ConsumerStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsumerStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsumerStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsumerStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsumerStatsRequestValidationError{}
+
+// Validate checks the field values on ConsumerStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConsumerStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsumerStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsumerStatsResponseMultiError, or nil if none found.
+func (m *ConsumerStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsumerStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConsumers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConsumerStatsResponseValidationError{
+						field:  fmt.Sprintf("Consumers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConsumerStatsResponseValidationError{
+						field:  fmt.Sprintf("Consumers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConsumerStatsResponseValidationError{
+					field:  fmt.Sprintf("Consumers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ConsumerStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsumerStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by ConsumerStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ConsumerStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsumerStatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsumerStatsResponseMultiError) AllErrors() []error { return m }
+
+// ConsumerStatsResponseValidationError is the validation error returned by
+// ConsumerStatsResponse.Validate if the designated constraints aren't met.
+type ConsumerStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsumerStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsumerStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsumerStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsumerStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsumerStatsResponseValidationError) ErrorName() string {
+	return "ConsumerStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsumerStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsumerStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsumerStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsumerStatsResponseValidationError{}
+
+// Validate checks the field values on Schema with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Schema) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Schema with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SchemaMultiError, or nil if none found.
+func (m *Schema) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Schema) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Topic
+
+	// no validation rules for Version
+
+	// no validation rules for Format
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetJsonSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaValidationError{
+					field:  "JsonSchema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaValidationError{
+					field:  "JsonSchema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJsonSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaValidationError{
+				field:  "JsonSchema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DescriptorSet
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SchemaMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaMultiError is an error wrapping multiple validation errors returned by
+// Schema.ValidateAll() if the designated constraints aren't met.
+type SchemaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaMultiError) AllErrors() []error { return m }
+
+// SchemaValidationError is the validation error returned by Schema.Validate if
+// the designated constraints aren't met.
+type SchemaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaValidationError) ErrorName() string { return "SchemaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchema.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaValidationError{}
+
+// Validate checks the field values on SchemaListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaListRequestMultiError, or nil if none found.
+func (m *SchemaListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Topic != nil {
+		// no validation rules for Topic
+	}
+
+	if len(errors) > 0 {
+		return SchemaListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaListRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaListRequestMultiError) AllErrors() []error { return m }
+
+// SchemaListRequestValidationError is the validation error returned by
+// SchemaListRequest.Validate if the designated constraints aren't met.
+type SchemaListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaListRequestValidationError) ErrorName() string {
+	return "SchemaListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaListRequestValidationError{}
+
+// Validate checks the field values on SchemaListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaListResponseMultiError, or nil if none found.
+func (m *SchemaListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaListResponseValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaListResponseValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaListResponseValidationError{
+					field:  fmt.Sprintf("Schemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SchemaListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaListResponseMultiError is an error wrapping multiple validation errors
+// returned by SchemaListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaListResponseMultiError) AllErrors() []error { return m }
+
+// SchemaListResponseValidationError is the validation error returned by
+// SchemaListResponse.Validate if the designated constraints aren't met.
+type SchemaListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaListResponseValidationError) ErrorName() string {
+	return "SchemaListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaListResponseValidationError{}
+
+// Validate checks the field values on SchemaGetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaGetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaGetRequestMultiError, or nil if none found.
+func (m *SchemaGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaGetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Topic
+
+	if m.GetVersion() <= 0 {
+		err := SchemaGetRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaGetRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaGetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaGetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaGetRequestMultiError) AllErrors() []error { return m }
+
+// SchemaGetRequestValidationError is the validation error returned by
+// SchemaGetRequest.Validate if the designated constraints aren't met.
+type SchemaGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaGetRequestValidationError) ErrorName() string { return "SchemaGetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaGetRequestValidationError{}
+
+// Validate checks the field values on SchemaGetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaGetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaGetResponseMultiError, or nil if none found.
+func (m *SchemaGetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaGetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaGetResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaGetResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaGetResponseValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SchemaGetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaGetResponseMultiError is an error wrapping multiple validation errors
+// returned by SchemaGetResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaGetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaGetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaGetResponseMultiError) AllErrors() []error { return m }
+
+// SchemaGetResponseValidationError is the validation error returned by
+// SchemaGetResponse.Validate if the designated constraints aren't met.
+type SchemaGetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaGetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaGetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaGetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaGetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaGetResponseValidationError) ErrorName() string {
+	return "SchemaGetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaGetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaGetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaGetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaGetResponseValidationError{}
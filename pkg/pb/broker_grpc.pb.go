@@ -0,0 +1,407 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: broker.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BrokerAPI_ListDLQMessages_FullMethodName   = "/broker.BrokerAPI/ListDLQMessages"
+	BrokerAPI_GetDLQMessage_FullMethodName     = "/broker.BrokerAPI/GetDLQMessage"
+	BrokerAPI_ReplayDLQMessages_FullMethodName = "/broker.BrokerAPI/ReplayDLQMessages"
+	BrokerAPI_PurgeDLQ_FullMethodName          = "/broker.BrokerAPI/PurgeDLQ"
+	BrokerAPI_GetStreamStats_FullMethodName    = "/broker.BrokerAPI/GetStreamStats"
+	BrokerAPI_GetConsumerStats_FullMethodName  = "/broker.BrokerAPI/GetConsumerStats"
+	BrokerAPI_ListSchemas_FullMethodName       = "/broker.BrokerAPI/ListSchemas"
+	BrokerAPI_GetSchema_FullMethodName         = "/broker.BrokerAPI/GetSchema"
+)
+
+// BrokerAPIClient is the client API for BrokerAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BrokerAPI администрирование брокера, доступно только администраторам
+type BrokerAPIClient interface {
+	// ListDLQMessages возвращает сообщения DLQ-топика по возрастанию номера
+	ListDLQMessages(ctx context.Context, in *DLQListRequest, opts ...grpc.CallOption) (*DLQListResponse, error)
+	// GetDLQMessage
+	GetDLQMessage(ctx context.Context, in *DLQGetRequest, opts ...grpc.CallOption) (*DLQGetResponse, error)
+	// ReplayDLQMessages возвращает сообщения в исходный топик и удаляет их из DLQ
+	ReplayDLQMessages(ctx context.Context, in *DLQReplayRequest, opts ...grpc.CallOption) (*DLQReplayResponse, error)
+	// PurgeDLQ удаляет все сообщения DLQ-топика
+	PurgeDLQ(ctx context.Context, in *DLQPurgeRequest, opts ...grpc.CallOption) (*DLQPurgeResponse, error)
+	// GetStreamStats возвращает состояние топиков приложения
+	GetStreamStats(ctx context.Context, in *StreamStatsRequest, opts ...grpc.CallOption) (*StreamStatsResponse, error)
+	// GetConsumerStats возвращает состояние консьюмеров приложения по партициям
+	GetConsumerStats(ctx context.Context, in *ConsumerStatsRequest, opts ...grpc.CallOption) (*ConsumerStatsResponse, error)
+	// ListSchemas возвращает версии схем событий по топику и версии
+	ListSchemas(ctx context.Context, in *SchemaListRequest, opts ...grpc.CallOption) (*SchemaListResponse, error)
+	// GetSchema
+	GetSchema(ctx context.Context, in *SchemaGetRequest, opts ...grpc.CallOption) (*SchemaGetResponse, error)
+}
+
+type brokerAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBrokerAPIClient(cc grpc.ClientConnInterface) BrokerAPIClient {
+	return &brokerAPIClient{cc}
+}
+
+func (c *brokerAPIClient) ListDLQMessages(ctx context.Context, in *DLQListRequest, opts ...grpc.CallOption) (*DLQListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DLQListResponse)
+	err := c.cc.Invoke(ctx, BrokerAPI_ListDLQMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerAPIClient) GetDLQMessage(ctx context.Context, in *DLQGetRequest, opts ...grpc.CallOption) (*DLQGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DLQGetResponse)
+	err := c.cc.Invoke(ctx, BrokerAPI_GetDLQMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerAPIClient) ReplayDLQMessages(ctx context.Context, in *DLQReplayRequest, opts ...grpc.CallOption) (*DLQReplayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DLQReplayResponse)
+	err := c.cc.Invoke(ctx, BrokerAPI_ReplayDLQMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerAPIClient) PurgeDLQ(ctx context.Context, in *DLQPurgeRequest, opts ...grpc.CallOption) (*DLQPurgeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DLQPurgeResponse)
+	err := c.cc.Invoke(ctx, BrokerAPI_PurgeDLQ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerAPIClient) GetStreamStats(ctx context.Context, in *StreamStatsRequest, opts ...grpc.CallOption) (*StreamStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StreamStatsResponse)
+	err := c.cc.Invoke(ctx, BrokerAPI_GetStreamStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerAPIClient) GetConsumerStats(ctx context.Context, in *ConsumerStatsRequest, opts ...grpc.CallOption) (*ConsumerStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsumerStatsResponse)
+	err := c.cc.Invoke(ctx, BrokerAPI_GetConsumerStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerAPIClient) ListSchemas(ctx context.Context, in *SchemaListRequest, opts ...grpc.CallOption) (*SchemaListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchemaListResponse)
+	err := c.cc.Invoke(ctx, BrokerAPI_ListSchemas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerAPIClient) GetSchema(ctx context.Context, in *SchemaGetRequest, opts ...grpc.CallOption) (*SchemaGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchemaGetResponse)
+	err := c.cc.Invoke(ctx, BrokerAPI_GetSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BrokerAPIServer is the server API for BrokerAPI service.
+// All implementations must embed UnimplementedBrokerAPIServer
+// for forward compatibility.
+//
+// BrokerAPI администрирование брокера, доступно только администраторам
+type BrokerAPIServer interface {
+	// ListDLQMessages возвращает сообщения DLQ-топика по возрастанию номера
+	ListDLQMessages(context.Context, *DLQListRequest) (*DLQListResponse, error)
+	// GetDLQMessage
+	GetDLQMessage(context.Context, *DLQGetRequest) (*DLQGetResponse, error)
+	// ReplayDLQMessages возвращает сообщения в исходный топик и удаляет их из DLQ
+	ReplayDLQMessages(context.Context, *DLQReplayRequest) (*DLQReplayResponse, error)
+	// PurgeDLQ удаляет все сообщения DLQ-топика
+	PurgeDLQ(context.Context, *DLQPurgeRequest) (*DLQPurgeResponse, error)
+	// GetStreamStats возвращает состояние топиков приложения
+	GetStreamStats(context.Context, *StreamStatsRequest) (*StreamStatsResponse, error)
+	// GetConsumerStats возвращает состояние консьюмеров приложения по партициям
+	GetConsumerStats(context.Context, *ConsumerStatsRequest) (*ConsumerStatsResponse, error)
+	// ListSchemas возвращает версии схем событий по топику и версии
+	ListSchemas(context.Context, *SchemaListRequest) (*SchemaListResponse, error)
+	// GetSchema
+	GetSchema(context.Context, *SchemaGetRequest) (*SchemaGetResponse, error)
+	mustEmbedUnimplementedBrokerAPIServer()
+}
+
+// UnimplementedBrokerAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBrokerAPIServer struct{}
+
+func (UnimplementedBrokerAPIServer) ListDLQMessages(context.Context, *DLQListRequest) (*DLQListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDLQMessages not implemented")
+}
+func (UnimplementedBrokerAPIServer) GetDLQMessage(context.Context, *DLQGetRequest) (*DLQGetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDLQMessage not implemented")
+}
+func (UnimplementedBrokerAPIServer) ReplayDLQMessages(context.Context, *DLQReplayRequest) (*DLQReplayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayDLQMessages not implemented")
+}
+func (UnimplementedBrokerAPIServer) PurgeDLQ(context.Context, *DLQPurgeRequest) (*DLQPurgeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeDLQ not implemented")
+}
+func (UnimplementedBrokerAPIServer) GetStreamStats(context.Context, *StreamStatsRequest) (*StreamStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStreamStats not implemented")
+}
+func (UnimplementedBrokerAPIServer) GetConsumerStats(context.Context, *ConsumerStatsRequest) (*ConsumerStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetConsumerStats not implemented")
+}
+func (UnimplementedBrokerAPIServer) ListSchemas(context.Context, *SchemaListRequest) (*SchemaListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSchemas not implemented")
+}
+func (UnimplementedBrokerAPIServer) GetSchema(context.Context, *SchemaGetRequest) (*SchemaGetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSchema not implemented")
+}
+func (UnimplementedBrokerAPIServer) mustEmbedUnimplementedBrokerAPIServer() {}
+func (UnimplementedBrokerAPIServer) testEmbeddedByValue()                   {}
+
+// UnsafeBrokerAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BrokerAPIServer will
+// result in compilation errors.
+type UnsafeBrokerAPIServer interface {
+	mustEmbedUnimplementedBrokerAPIServer()
+}
+
+func RegisterBrokerAPIServer(s grpc.ServiceRegistrar, srv BrokerAPIServer) {
+	// If the following call panics, it indicates UnimplementedBrokerAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BrokerAPI_ServiceDesc, srv)
+}
+
+func _BrokerAPI_ListDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DLQListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerAPIServer).ListDLQMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerAPI_ListDLQMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerAPIServer).ListDLQMessages(ctx, req.(*DLQListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerAPI_GetDLQMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DLQGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerAPIServer).GetDLQMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerAPI_GetDLQMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerAPIServer).GetDLQMessage(ctx, req.(*DLQGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerAPI_ReplayDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DLQReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerAPIServer).ReplayDLQMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerAPI_ReplayDLQMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerAPIServer).ReplayDLQMessages(ctx, req.(*DLQReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerAPI_PurgeDLQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DLQPurgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerAPIServer).PurgeDLQ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerAPI_PurgeDLQ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerAPIServer).PurgeDLQ(ctx, req.(*DLQPurgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerAPI_GetStreamStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerAPIServer).GetStreamStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerAPI_GetStreamStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerAPIServer).GetStreamStats(ctx, req.(*StreamStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerAPI_GetConsumerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerAPIServer).GetConsumerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerAPI_GetConsumerStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerAPIServer).GetConsumerStats(ctx, req.(*ConsumerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerAPI_ListSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerAPIServer).ListSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerAPI_ListSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerAPIServer).ListSchemas(ctx, req.(*SchemaListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerAPI_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerAPIServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerAPI_GetSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerAPIServer).GetSchema(ctx, req.(*SchemaGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BrokerAPI_ServiceDesc is the grpc.ServiceDesc for BrokerAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BrokerAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "broker.BrokerAPI",
+	HandlerType: (*BrokerAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDLQMessages",
+			Handler:    _BrokerAPI_ListDLQMessages_Handler,
+		},
+		{
+			MethodName: "GetDLQMessage",
+			Handler:    _BrokerAPI_GetDLQMessage_Handler,
+		},
+		{
+			MethodName: "ReplayDLQMessages",
+			Handler:    _BrokerAPI_ReplayDLQMessages_Handler,
+		},
+		{
+			MethodName: "PurgeDLQ",
+			Handler:    _BrokerAPI_PurgeDLQ_Handler,
+		},
+		{
+			MethodName: "GetStreamStats",
+			Handler:    _BrokerAPI_GetStreamStats_Handler,
+		},
+		{
+			MethodName: "GetConsumerStats",
+			Handler:    _BrokerAPI_GetConsumerStats_Handler,
+		},
+		{
+			MethodName: "ListSchemas",
+			Handler:    _BrokerAPI_ListSchemas_Handler,
+		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _BrokerAPI_GetSchema_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "broker.proto",
+}
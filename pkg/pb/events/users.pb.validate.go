@@ -0,0 +1,494 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: events/users.proto
+
+package events
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on UserCreated with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserCreated) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserCreated with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserCreatedMultiError, or
+// nil if none found.
+func (m *UserCreated) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserCreated) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	// no validation rules for Email
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserCreatedValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserCreatedValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserCreatedValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserCreatedValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserCreatedValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserCreatedValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserCreatedMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserCreatedMultiError is an error wrapping multiple validation errors
+// returned by UserCreated.ValidateAll() if the designated constraints aren't met.
+type UserCreatedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserCreatedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserCreatedMultiError) AllErrors() []error { return m }
+
+// UserCreatedValidationError is the validation error returned by
+// UserCreated.Validate if the designated constraints aren't met.
+type UserCreatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserCreatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserCreatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserCreatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserCreatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserCreatedValidationError) ErrorName() string { return "UserCreatedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserCreatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserCreated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserCreatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserCreatedValidationError{}
+
+// Validate checks the field values on UserUpdated with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserUpdated) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserUpdated with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserUpdatedMultiError, or
+// nil if none found.
+func (m *UserUpdated) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserUpdated) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	// no validation rules for Email
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserUpdatedValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserUpdatedValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserUpdatedValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserUpdatedValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserUpdatedValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserUpdatedValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserUpdatedMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserUpdatedMultiError is an error wrapping multiple validation errors
+// returned by UserUpdated.ValidateAll() if the designated constraints aren't met.
+type UserUpdatedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserUpdatedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserUpdatedMultiError) AllErrors() []error { return m }
+
+// UserUpdatedValidationError is the validation error returned by
+// UserUpdated.Validate if the designated constraints aren't met.
+type UserUpdatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserUpdatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserUpdatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserUpdatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserUpdatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserUpdatedValidationError) ErrorName() string { return "UserUpdatedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserUpdatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserUpdated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserUpdatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserUpdatedValidationError{}
+
+// Validate checks the field values on UserDeleted with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserDeleted) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDeleted with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserDeletedMultiError, or
+// nil if none found.
+func (m *UserDeleted) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDeleted) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserDeletedValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserDeletedValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserDeletedValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserDeletedMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDeletedMultiError is an error wrapping multiple validation errors
+// returned by UserDeleted.ValidateAll() if the designated constraints aren't met.
+type UserDeletedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDeletedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDeletedMultiError) AllErrors() []error { return m }
+
+// UserDeletedValidationError is the validation error returned by
+// UserDeleted.Validate if the designated constraints aren't met.
+type UserDeletedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDeletedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDeletedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDeletedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDeletedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDeletedValidationError) ErrorName() string { return "UserDeletedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserDeletedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDeleted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDeletedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDeletedValidationError{}
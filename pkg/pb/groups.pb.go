@@ -0,0 +1,1158 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: groups.proto
+
+package pb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Group
+type Group struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedBy     *int64                 `protobuf:"varint,4,opt,name=created_by,proto3,oneof" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_groups_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Group) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Group) GetCreatedBy() int64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Group) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Group) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GroupMember
+type GroupMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	AddedBy       *int64                 `protobuf:"varint,4,opt,name=added_by,proto3,oneof" json:"added_by,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=added_at,proto3" json:"added_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMember) Reset() {
+	*x = GroupMember{}
+	mi := &file_groups_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMember) ProtoMessage() {}
+
+func (x *GroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
+func (*GroupMember) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GroupMember) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GroupMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *GroupMember) GetAddedBy() int64 {
+	if x != nil && x.AddedBy != nil {
+		return *x.AddedBy
+	}
+	return 0
+}
+
+func (x *GroupMember) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+// GroupCreateRequest
+type GroupCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupCreateRequest) Reset() {
+	*x = GroupCreateRequest{}
+	mi := &file_groups_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupCreateRequest) ProtoMessage() {}
+
+func (x *GroupCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupCreateRequest.ProtoReflect.Descriptor instead.
+func (*GroupCreateRequest) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GroupCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupCreateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// GroupCreateResponse
+type GroupCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupCreateResponse) Reset() {
+	*x = GroupCreateResponse{}
+	mi := &file_groups_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupCreateResponse) ProtoMessage() {}
+
+func (x *GroupCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupCreateResponse.ProtoReflect.Descriptor instead.
+func (*GroupCreateResponse) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GroupCreateResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// GroupGetRequest
+type GroupGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupGetRequest) Reset() {
+	*x = GroupGetRequest{}
+	mi := &file_groups_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupGetRequest) ProtoMessage() {}
+
+func (x *GroupGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupGetRequest.ProtoReflect.Descriptor instead.
+func (*GroupGetRequest) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GroupGetRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+// GroupGetResponse
+type GroupGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupGetResponse) Reset() {
+	*x = GroupGetResponse{}
+	mi := &file_groups_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupGetResponse) ProtoMessage() {}
+
+func (x *GroupGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupGetResponse.ProtoReflect.Descriptor instead.
+func (*GroupGetResponse) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GroupGetResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// GroupUpdateRequest
+type GroupUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupUpdateRequest) Reset() {
+	*x = GroupUpdateRequest{}
+	mi := &file_groups_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupUpdateRequest) ProtoMessage() {}
+
+func (x *GroupUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupUpdateRequest.ProtoReflect.Descriptor instead.
+func (*GroupUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GroupUpdateRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupUpdateRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *GroupUpdateRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GroupUpdateResponse
+type GroupUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupUpdateResponse) Reset() {
+	*x = GroupUpdateResponse{}
+	mi := &file_groups_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupUpdateResponse) ProtoMessage() {}
+
+func (x *GroupUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupUpdateResponse.ProtoReflect.Descriptor instead.
+func (*GroupUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GroupUpdateResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// GroupDeleteRequest
+type GroupDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupDeleteRequest) Reset() {
+	*x = GroupDeleteRequest{}
+	mi := &file_groups_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupDeleteRequest) ProtoMessage() {}
+
+func (x *GroupDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupDeleteRequest.ProtoReflect.Descriptor instead.
+func (*GroupDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GroupDeleteRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+// GroupListRequest
+type GroupListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Группы, в которых состоит пользователь
+	MemberId      *int64  `protobuf:"varint,1,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
+	Name          *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Limit         *int64  `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset        *int64  `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupListRequest) Reset() {
+	*x = GroupListRequest{}
+	mi := &file_groups_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupListRequest) ProtoMessage() {}
+
+func (x *GroupListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupListRequest.ProtoReflect.Descriptor instead.
+func (*GroupListRequest) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GroupListRequest) GetMemberId() int64 {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return 0
+}
+
+func (x *GroupListRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *GroupListRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GroupListRequest) GetOffset() int64 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+// GroupListResponse
+type GroupListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupListResponse) Reset() {
+	*x = GroupListResponse{}
+	mi := &file_groups_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupListResponse) ProtoMessage() {}
+
+func (x *GroupListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupListResponse.ProtoReflect.Descriptor instead.
+func (*GroupListResponse) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GroupListResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GroupListResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GroupAddMembersRequest
+type GroupAddMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	UserIds       []int64                `protobuf:"varint,2,rep,packed,name=user_ids,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupAddMembersRequest) Reset() {
+	*x = GroupAddMembersRequest{}
+	mi := &file_groups_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupAddMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAddMembersRequest) ProtoMessage() {}
+
+func (x *GroupAddMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupAddMembersRequest.ProtoReflect.Descriptor instead.
+func (*GroupAddMembersRequest) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GroupAddMembersRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupAddMembersRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// GroupAddMembersResponse
+type GroupAddMembersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Пользователи, которых в группе еще не было
+	AddedUserIds  []int64 `protobuf:"varint,1,rep,packed,name=added_user_ids,proto3" json:"added_user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupAddMembersResponse) Reset() {
+	*x = GroupAddMembersResponse{}
+	mi := &file_groups_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupAddMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAddMembersResponse) ProtoMessage() {}
+
+func (x *GroupAddMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupAddMembersResponse.ProtoReflect.Descriptor instead.
+func (*GroupAddMembersResponse) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GroupAddMembersResponse) GetAddedUserIds() []int64 {
+	if x != nil {
+		return x.AddedUserIds
+	}
+	return nil
+}
+
+// GroupRemoveMembersRequest
+type GroupRemoveMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	UserIds       []int64                `protobuf:"varint,2,rep,packed,name=user_ids,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupRemoveMembersRequest) Reset() {
+	*x = GroupRemoveMembersRequest{}
+	mi := &file_groups_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupRemoveMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRemoveMembersRequest) ProtoMessage() {}
+
+func (x *GroupRemoveMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRemoveMembersRequest.ProtoReflect.Descriptor instead.
+func (*GroupRemoveMembersRequest) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GroupRemoveMembersRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupRemoveMembersRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// GroupRemoveMembersResponse
+type GroupRemoveMembersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Пользователи, которые состояли в группе
+	RemovedUserIds []int64 `protobuf:"varint,1,rep,packed,name=removed_user_ids,proto3" json:"removed_user_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GroupRemoveMembersResponse) Reset() {
+	*x = GroupRemoveMembersResponse{}
+	mi := &file_groups_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupRemoveMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRemoveMembersResponse) ProtoMessage() {}
+
+func (x *GroupRemoveMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRemoveMembersResponse.ProtoReflect.Descriptor instead.
+func (*GroupRemoveMembersResponse) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GroupRemoveMembersResponse) GetRemovedUserIds() []int64 {
+	if x != nil {
+		return x.RemovedUserIds
+	}
+	return nil
+}
+
+// GroupListMembersRequest
+type GroupListMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	Limit         *int64                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset        *int64                 `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupListMembersRequest) Reset() {
+	*x = GroupListMembersRequest{}
+	mi := &file_groups_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupListMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupListMembersRequest) ProtoMessage() {}
+
+func (x *GroupListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupListMembersRequest.ProtoReflect.Descriptor instead.
+func (*GroupListMembersRequest) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GroupListMembersRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupListMembersRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GroupListMembersRequest) GetOffset() int64 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+// GroupListMembersResponse
+type GroupListMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*GroupMember         `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupListMembersResponse) Reset() {
+	*x = GroupListMembersResponse{}
+	mi := &file_groups_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupListMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupListMembersResponse) ProtoMessage() {}
+
+func (x *GroupListMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupListMembersResponse.ProtoReflect.Descriptor instead.
+func (*GroupListMembersResponse) Descriptor() ([]byte, []int) {
+	return file_groups_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GroupListMembersResponse) GetMembers() []*GroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *GroupListMembersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_groups_proto protoreflect.FileDescriptor
+
+const file_groups_proto_rawDesc = "" +
+	"\n" +
+	"\fgroups.proto\x12\x06groups\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xf9\x01\n" +
+	"\x05Group\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\x03H\x00R\n" +
+	"created_by\x88\x01\x01\x12:\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_atB\r\n" +
+	"\v_created_by\"\xb7\x01\n" +
+	"\vGroupMember\x12\x18\n" +
+	"\auser_id\x18\x01 \x01(\x03R\auser_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1f\n" +
+	"\badded_by\x18\x04 \x01(\x03H\x00R\badded_by\x88\x01\x01\x126\n" +
+	"\badded_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\badded_atB\v\n" +
+	"\t_added_by\"T\n" +
+	"\x12GroupCreateRequest\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\":\n" +
+	"\x13GroupCreateResponse\x12#\n" +
+	"\x05group\x18\x01 \x01(\v2\r.groups.GroupR\x05group\"-\n" +
+	"\x0fGroupGetRequest\x12\x1a\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\bgroup_id\"7\n" +
+	"\x10GroupGetResponse\x12#\n" +
+	"\x05group\x18\x01 \x01(\v2\r.groups.GroupR\x05group\"\x93\x01\n" +
+	"\x12GroupUpdateRequest\x12\x1a\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\bgroup_id\x12!\n" +
+	"\x04name\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_description\":\n" +
+	"\x13GroupUpdateResponse\x12#\n" +
+	"\x05group\x18\x01 \x01(\v2\r.groups.GroupR\x05group\"0\n" +
+	"\x12GroupDeleteRequest\x12\x1a\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\bgroup_id\"\xc7\x01\n" +
+	"\x10GroupListRequest\x12!\n" +
+	"\tmember_id\x18\x01 \x01(\x03H\x00R\tmember_id\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12%\n" +
+	"\x05limit\x18\x03 \x01(\x03B\n" +
+	"\xfaB\a\"\x05\x18\xe8\a \x00H\x02R\x05limit\x88\x01\x01\x12$\n" +
+	"\x06offset\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00H\x03R\x06offset\x88\x01\x01B\f\n" +
+	"\n" +
+	"_member_idB\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"P\n" +
+	"\x11GroupListResponse\x12%\n" +
+	"\x06groups\x18\x01 \x03(\v2\r.groups.GroupR\x06groups\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"]\n" +
+	"\x16GroupAddMembersRequest\x12\x1a\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\bgroup_id\x12'\n" +
+	"\buser_ids\x18\x02 \x03(\x03B\v\xfaB\b\x92\x01\x05\b\x01\x10\xe8\aR\buser_ids\"A\n" +
+	"\x17GroupAddMembersResponse\x12&\n" +
+	"\x0eadded_user_ids\x18\x01 \x03(\x03R\x0eadded_user_ids\"`\n" +
+	"\x19GroupRemoveMembersRequest\x12\x1a\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\bgroup_id\x12'\n" +
+	"\buser_ids\x18\x02 \x03(\x03B\v\xfaB\b\x92\x01\x05\b\x01\x10\xe8\aR\buser_ids\"H\n" +
+	"\x1aGroupRemoveMembersResponse\x12*\n" +
+	"\x10removed_user_ids\x18\x01 \x03(\x03R\x10removed_user_ids\"\x97\x01\n" +
+	"\x17GroupListMembersRequest\x12\x1a\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\bgroup_id\x12%\n" +
+	"\x05limit\x18\x02 \x01(\x03B\n" +
+	"\xfaB\a\"\x05\x18\xe8\a \x00H\x00R\x05limit\x88\x01\x01\x12$\n" +
+	"\x06offset\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00H\x01R\x06offset\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"_\n" +
+	"\x18GroupListMembersResponse\x12-\n" +
+	"\amembers\x18\x01 \x03(\v2\x13.groups.GroupMemberR\amembers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total2\xb0\x06\n" +
+	"\tGroupsAPI\x12U\n" +
+	"\x06Create\x12\x1a.groups.GroupCreateRequest\x1a\x1b.groups.GroupCreateResponse\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/groups\x12T\n" +
+	"\x03Get\x12\x17.groups.GroupGetRequest\x1a\x18.groups.GroupGetResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/groups/{group_id}\x12`\n" +
+	"\x06Update\x12\x1a.groups.GroupUpdateRequest\x1a\x1b.groups.GroupUpdateResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*2\x12/groups/{group_id}\x12X\n" +
+	"\x06Delete\x12\x1a.groups.GroupDeleteRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/groups/{group_id}\x12R\n" +
+	"\n" +
+	"ListGroups\x12\x18.groups.GroupListRequest\x1a\x19.groups.GroupListResponse\"\x0f\x82\xd3\xe4\x93\x02\t\x12\a/groups\x12t\n" +
+	"\n" +
+	"AddMembers\x12\x1e.groups.GroupAddMembersRequest\x1a\x1f.groups.GroupAddMembersResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/groups/{group_id}/members\x12z\n" +
+	"\rRemoveMembers\x12!.groups.GroupRemoveMembersRequest\x1a\".groups.GroupRemoveMembersResponse\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/groups/{group_id}/members\x12t\n" +
+	"\vListMembers\x12\x1f.groups.GroupListMembersRequest\x1a .groups.GroupListMembersResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/groups/{group_id}/membersB\xd3\x01\x92An\x12\x13\n" +
+	"\n" +
+	"Groups API2\x051.0.0\"\x04/api2\x10application/json:\x10application/jsonZ\x1f\n" +
+	"\x1d\n" +
+	"\x06x-auth\x12\x13\b\x02\x1a\rauthorization \x02b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06x-auth\x12\x00\n" +
+	"\n" +
+	"com.groupsB\vGroupsProtoP\x01Z\x0fgreenaid/pkg/pb\xa2\x02\x03GXX\xaa\x02\x06Groups\xca\x02\x06Groups\xe2\x02\x12Groups\\GPBMetadata\xea\x02\x06Groupsb\x06proto3"
+
+var (
+	file_groups_proto_rawDescOnce sync.Once
+	file_groups_proto_rawDescData []byte
+)
+
+func file_groups_proto_rawDescGZIP() []byte {
+	file_groups_proto_rawDescOnce.Do(func() {
+		file_groups_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_groups_proto_rawDesc), len(file_groups_proto_rawDesc)))
+	})
+	return file_groups_proto_rawDescData
+}
+
+var file_groups_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_groups_proto_goTypes = []any{
+	(*Group)(nil),                      // 0: groups.Group
+	(*GroupMember)(nil),                // 1: groups.GroupMember
+	(*GroupCreateRequest)(nil),         // 2: groups.GroupCreateRequest
+	(*GroupCreateResponse)(nil),        // 3: groups.GroupCreateResponse
+	(*GroupGetRequest)(nil),            // 4: groups.GroupGetRequest
+	(*GroupGetResponse)(nil),           // 5: groups.GroupGetResponse
+	(*GroupUpdateRequest)(nil),         // 6: groups.GroupUpdateRequest
+	(*GroupUpdateResponse)(nil),        // 7: groups.GroupUpdateResponse
+	(*GroupDeleteRequest)(nil),         // 8: groups.GroupDeleteRequest
+	(*GroupListRequest)(nil),           // 9: groups.GroupListRequest
+	(*GroupListResponse)(nil),          // 10: groups.GroupListResponse
+	(*GroupAddMembersRequest)(nil),     // 11: groups.GroupAddMembersRequest
+	(*GroupAddMembersResponse)(nil),    // 12: groups.GroupAddMembersResponse
+	(*GroupRemoveMembersRequest)(nil),  // 13: groups.GroupRemoveMembersRequest
+	(*GroupRemoveMembersResponse)(nil), // 14: groups.GroupRemoveMembersResponse
+	(*GroupListMembersRequest)(nil),    // 15: groups.GroupListMembersRequest
+	(*GroupListMembersResponse)(nil),   // 16: groups.GroupListMembersResponse
+	(*timestamppb.Timestamp)(nil),      // 17: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 18: google.protobuf.Empty
+}
+var file_groups_proto_depIdxs = []int32{
+	17, // 0: groups.Group.created_at:type_name -> google.protobuf.Timestamp
+	17, // 1: groups.Group.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 2: groups.GroupMember.added_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: groups.GroupCreateResponse.group:type_name -> groups.Group
+	0,  // 4: groups.GroupGetResponse.group:type_name -> groups.Group
+	0,  // 5: groups.GroupUpdateResponse.group:type_name -> groups.Group
+	0,  // 6: groups.GroupListResponse.groups:type_name -> groups.Group
+	1,  // 7: groups.GroupListMembersResponse.members:type_name -> groups.GroupMember
+	2,  // 8: groups.GroupsAPI.Create:input_type -> groups.GroupCreateRequest
+	4,  // 9: groups.GroupsAPI.Get:input_type -> groups.GroupGetRequest
+	6,  // 10: groups.GroupsAPI.Update:input_type -> groups.GroupUpdateRequest
+	8,  // 11: groups.GroupsAPI.Delete:input_type -> groups.GroupDeleteRequest
+	9,  // 12: groups.GroupsAPI.ListGroups:input_type -> groups.GroupListRequest
+	11, // 13: groups.GroupsAPI.AddMembers:input_type -> groups.GroupAddMembersRequest
+	13, // 14: groups.GroupsAPI.RemoveMembers:input_type -> groups.GroupRemoveMembersRequest
+	15, // 15: groups.GroupsAPI.ListMembers:input_type -> groups.GroupListMembersRequest
+	3,  // 16: groups.GroupsAPI.Create:output_type -> groups.GroupCreateResponse
+	5,  // 17: groups.GroupsAPI.Get:output_type -> groups.GroupGetResponse
+	7,  // 18: groups.GroupsAPI.Update:output_type -> groups.GroupUpdateResponse
+	18, // 19: groups.GroupsAPI.Delete:output_type -> google.protobuf.Empty
+	10, // 20: groups.GroupsAPI.ListGroups:output_type -> groups.GroupListResponse
+	12, // 21: groups.GroupsAPI.AddMembers:output_type -> groups.GroupAddMembersResponse
+	14, // 22: groups.GroupsAPI.RemoveMembers:output_type -> groups.GroupRemoveMembersResponse
+	16, // 23: groups.GroupsAPI.ListMembers:output_type -> groups.GroupListMembersResponse
+	16, // [16:24] is the sub-list for method output_type
+	8,  // [8:16] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_groups_proto_init() }
+func file_groups_proto_init() {
+	if File_groups_proto != nil {
+		return
+	}
+	file_groups_proto_msgTypes[0].OneofWrappers = []any{}
+	file_groups_proto_msgTypes[1].OneofWrappers = []any{}
+	file_groups_proto_msgTypes[6].OneofWrappers = []any{}
+	file_groups_proto_msgTypes[9].OneofWrappers = []any{}
+	file_groups_proto_msgTypes[15].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_groups_proto_rawDesc), len(file_groups_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_groups_proto_goTypes,
+		DependencyIndexes: file_groups_proto_depIdxs,
+		MessageInfos:      file_groups_proto_msgTypes,
+	}.Build()
+	File_groups_proto = out.File
+	file_groups_proto_goTypes = nil
+	file_groups_proto_depIdxs = nil
+}
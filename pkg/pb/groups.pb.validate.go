@@ -0,0 +1,2157 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: groups.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Group with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Group) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Group with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GroupMultiError, or nil if none found.
+func (m *Group) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Group) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GroupValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GroupValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GroupValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GroupValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GroupValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GroupValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if len(errors) > 0 {
+		return GroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupMultiError is an error wrapping multiple validation errors returned by
+// Group.ValidateAll() if the designated constraints aren't met.
+type GroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupMultiError) AllErrors() []error { return m }
+
+// GroupValidationError is the validation error returned by Group.Validate if
+// the designated constraints aren't met.
+type GroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupValidationError) ErrorName() string { return "GroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupValidationError{}
+
+// Validate checks the field values on GroupMember with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GroupMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupMember with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GroupMemberMultiError, or
+// nil if none found.
+func (m *GroupMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	// no validation rules for Email
+
+	if all {
+		switch v := interface{}(m.GetAddedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GroupMemberValidationError{
+					field:  "AddedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GroupMemberValidationError{
+					field:  "AddedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GroupMemberValidationError{
+				field:  "AddedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.AddedBy != nil {
+		// no validation rules for AddedBy
+	}
+
+	if len(errors) > 0 {
+		return GroupMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupMemberMultiError is an error wrapping multiple validation errors
+// returned by GroupMember.ValidateAll() if the designated constraints aren't met.
+type GroupMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupMemberMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupMemberMultiError) AllErrors() []error { return m }
+
+// GroupMemberValidationError is the validation error returned by
+// GroupMember.Validate if the designated constraints aren't met.
+type GroupMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupMemberValidationError) ErrorName() string { return "GroupMemberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupMemberValidationError{}
+
+// Validate checks the field values on GroupCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupCreateRequestMultiError, or nil if none found.
+func (m *GroupCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 255 {
+		err := GroupCreateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return GroupCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupCreateRequestMultiError is an error wrapping multiple validation errors
+// returned by GroupCreateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GroupCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupCreateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupCreateRequestMultiError) AllErrors() []error { return m }
+
+// GroupCreateRequestValidationError is the validation error returned by
+// GroupCreateRequest.Validate if the designated constraints aren't met.
+type GroupCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupCreateRequestValidationError) ErrorName() string {
+	return "GroupCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupCreateRequestValidationError{}
+
+// Validate checks the field values on GroupCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupCreateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupCreateResponseMultiError, or nil if none found.
+func (m *GroupCreateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupCreateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GroupCreateResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GroupCreateResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GroupCreateResponseValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GroupCreateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupCreateResponseMultiError is an error wrapping multiple validation
+// errors returned by GroupCreateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GroupCreateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupCreateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupCreateResponseMultiError) AllErrors() []error { return m }
+
+// GroupCreateResponseValidationError is the validation error returned by
+// GroupCreateResponse.Validate if the designated constraints aren't met.
+type GroupCreateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupCreateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupCreateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupCreateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupCreateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupCreateResponseValidationError) ErrorName() string {
+	return "GroupCreateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupCreateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupCreateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupCreateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupCreateResponseValidationError{}
+
+// Validate checks the field values on GroupGetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GroupGetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupGetRequestMultiError, or nil if none found.
+func (m *GroupGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupGetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	if len(errors) > 0 {
+		return GroupGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupGetRequestMultiError is an error wrapping multiple validation errors
+// returned by GroupGetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GroupGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupGetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupGetRequestMultiError) AllErrors() []error { return m }
+
+// GroupGetRequestValidationError is the validation error returned by
+// GroupGetRequest.Validate if the designated constraints aren't met.
+type GroupGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupGetRequestValidationError) ErrorName() string { return "GroupGetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupGetRequestValidationError{}
+
+// Validate checks the field values on GroupGetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GroupGetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupGetResponseMultiError, or nil if none found.
+func (m *GroupGetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupGetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GroupGetResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GroupGetResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GroupGetResponseValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GroupGetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupGetResponseMultiError is an error wrapping multiple validation errors
+// returned by GroupGetResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GroupGetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupGetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupGetResponseMultiError) AllErrors() []error { return m }
+
+// GroupGetResponseValidationError is the validation error returned by
+// GroupGetResponse.Validate if the designated constraints aren't met.
+type GroupGetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupGetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupGetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupGetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupGetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupGetResponseValidationError) ErrorName() string { return "GroupGetResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupGetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupGetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupGetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupGetResponseValidationError{}
+
+// Validate checks the field values on GroupUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupUpdateRequestMultiError, or nil if none found.
+func (m *GroupUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	if m.Name != nil {
+
+		if utf8.RuneCountInString(m.GetName()) > 255 {
+			err := GroupUpdateRequestValidationError{
+				field:  "Name",
+				reason: "value length must be at most 255 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if len(errors) > 0 {
+		return GroupUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupUpdateRequestMultiError is an error wrapping multiple validation errors
+// returned by GroupUpdateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GroupUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupUpdateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupUpdateRequestMultiError) AllErrors() []error { return m }
+
+// GroupUpdateRequestValidationError is the validation error returned by
+// GroupUpdateRequest.Validate if the designated constraints aren't met.
+type GroupUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupUpdateRequestValidationError) ErrorName() string {
+	return "GroupUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupUpdateRequestValidationError{}
+
+// Validate checks the field values on GroupUpdateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupUpdateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupUpdateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupUpdateResponseMultiError, or nil if none found.
+func (m *GroupUpdateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupUpdateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GroupUpdateResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GroupUpdateResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GroupUpdateResponseValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GroupUpdateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupUpdateResponseMultiError is an error wrapping multiple validation
+// errors returned by GroupUpdateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GroupUpdateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupUpdateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupUpdateResponseMultiError) AllErrors() []error { return m }
+
+// GroupUpdateResponseValidationError is the validation error returned by
+// GroupUpdateResponse.Validate if the designated constraints aren't met.
+type GroupUpdateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupUpdateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupUpdateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupUpdateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupUpdateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupUpdateResponseValidationError) ErrorName() string {
+	return "GroupUpdateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupUpdateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupUpdateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupUpdateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupUpdateResponseValidationError{}
+
+// Validate checks the field values on GroupDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupDeleteRequestMultiError, or nil if none found.
+func (m *GroupDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	if len(errors) > 0 {
+		return GroupDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupDeleteRequestMultiError is an error wrapping multiple validation errors
+// returned by GroupDeleteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GroupDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupDeleteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupDeleteRequestMultiError) AllErrors() []error { return m }
+
+// GroupDeleteRequestValidationError is the validation error returned by
+// GroupDeleteRequest.Validate if the designated constraints aren't met.
+type GroupDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupDeleteRequestValidationError) ErrorName() string {
+	return "GroupDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupDeleteRequestValidationError{}
+
+// Validate checks the field values on GroupListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GroupListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupListRequestMultiError, or nil if none found.
+func (m *GroupListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.MemberId != nil {
+		// no validation rules for MemberId
+	}
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val <= 0 || val > 1000 {
+			err := GroupListRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range (0, 1000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Offset != nil {
+
+		if m.GetOffset() < 0 {
+			err := GroupListRequestValidationError{
+				field:  "Offset",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GroupListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupListRequestMultiError is an error wrapping multiple validation errors
+// returned by GroupListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GroupListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupListRequestMultiError) AllErrors() []error { return m }
+
+// GroupListRequestValidationError is the validation error returned by
+// GroupListRequest.Validate if the designated constraints aren't met.
+type GroupListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupListRequestValidationError) ErrorName() string { return "GroupListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupListRequestValidationError{}
+
+// Validate checks the field values on GroupListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GroupListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupListResponseMultiError, or nil if none found.
+func (m *GroupListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupListResponseValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupListResponseValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupListResponseValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return GroupListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupListResponseMultiError is an error wrapping multiple validation errors
+// returned by GroupListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GroupListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupListResponseMultiError) AllErrors() []error { return m }
+
+// GroupListResponseValidationError is the validation error returned by
+// GroupListResponse.Validate if the designated constraints aren't met.
+type GroupListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupListResponseValidationError) ErrorName() string {
+	return "GroupListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupListResponseValidationError{}
+
+// Validate checks the field values on GroupAddMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupAddMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupAddMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupAddMembersRequestMultiError, or nil if none found.
+func (m *GroupAddMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupAddMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	if l := len(m.GetUserIds()); l < 1 || l > 1000 {
+		err := GroupAddMembersRequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GroupAddMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupAddMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by GroupAddMembersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GroupAddMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupAddMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupAddMembersRequestMultiError) AllErrors() []error { return m }
+
+// GroupAddMembersRequestValidationError is the validation error returned by
+// GroupAddMembersRequest.Validate if the designated constraints aren't met.
+type GroupAddMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupAddMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupAddMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupAddMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupAddMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupAddMembersRequestValidationError) ErrorName() string {
+	return "GroupAddMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupAddMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupAddMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupAddMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupAddMembersRequestValidationError{}
+
+// Validate checks the field values on GroupAddMembersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupAddMembersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupAddMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupAddMembersResponseMultiError, or nil if none found.
+func (m *GroupAddMembersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupAddMembersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GroupAddMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupAddMembersResponseMultiError is an error wrapping multiple validation
+// errors returned by GroupAddMembersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GroupAddMembersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupAddMembersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupAddMembersResponseMultiError) AllErrors() []error { return m }
+
+// GroupAddMembersResponseValidationError is the validation error returned by
+// GroupAddMembersResponse.Validate if the designated constraints aren't met.
+type GroupAddMembersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupAddMembersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupAddMembersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupAddMembersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupAddMembersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupAddMembersResponseValidationError) ErrorName() string {
+	return "GroupAddMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupAddMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupAddMembersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupAddMembersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupAddMembersResponseValidationError{}
+
+// Validate checks the field values on GroupRemoveMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupRemoveMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupRemoveMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupRemoveMembersRequestMultiError, or nil if none found.
+func (m *GroupRemoveMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupRemoveMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	if l := len(m.GetUserIds()); l < 1 || l > 1000 {
+		err := GroupRemoveMembersRequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GroupRemoveMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupRemoveMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by GroupRemoveMembersRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GroupRemoveMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupRemoveMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupRemoveMembersRequestMultiError) AllErrors() []error { return m }
+
+// GroupRemoveMembersRequestValidationError is the validation error returned by
+// GroupRemoveMembersRequest.Validate if the designated constraints aren't met.
+type GroupRemoveMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupRemoveMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupRemoveMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupRemoveMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupRemoveMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupRemoveMembersRequestValidationError) ErrorName() string {
+	return "GroupRemoveMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupRemoveMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupRemoveMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupRemoveMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupRemoveMembersRequestValidationError{}
+
+// Validate checks the field values on GroupRemoveMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupRemoveMembersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupRemoveMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupRemoveMembersResponseMultiError, or nil if none found.
+func (m *GroupRemoveMembersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupRemoveMembersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GroupRemoveMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupRemoveMembersResponseMultiError is an error wrapping multiple
+// validation errors returned by GroupRemoveMembersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GroupRemoveMembersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupRemoveMembersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupRemoveMembersResponseMultiError) AllErrors() []error { return m }
+
+// GroupRemoveMembersResponseValidationError is the validation error returned
+// by GroupRemoveMembersResponse.Validate if the designated constraints aren't met.
+type GroupRemoveMembersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupRemoveMembersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupRemoveMembersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupRemoveMembersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupRemoveMembersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupRemoveMembersResponseValidationError) ErrorName() string {
+	return "GroupRemoveMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupRemoveMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupRemoveMembersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupRemoveMembersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupRemoveMembersResponseValidationError{}
+
+// Validate checks the field values on GroupListMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupListMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupListMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupListMembersRequestMultiError, or nil if none found.
+func (m *GroupListMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupListMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val <= 0 || val > 1000 {
+			err := GroupListMembersRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range (0, 1000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Offset != nil {
+
+		if m.GetOffset() < 0 {
+			err := GroupListMembersRequestValidationError{
+				field:  "Offset",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GroupListMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupListMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by GroupListMembersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GroupListMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupListMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupListMembersRequestMultiError) AllErrors() []error { return m }
+
+// GroupListMembersRequestValidationError is the validation error returned by
+// GroupListMembersRequest.Validate if the designated constraints aren't met.
+type GroupListMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupListMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupListMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupListMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupListMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupListMembersRequestValidationError) ErrorName() string {
+	return "GroupListMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupListMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupListMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupListMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupListMembersRequestValidationError{}
+
+// Validate checks the field values on GroupListMembersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupListMembersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupListMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupListMembersResponseMultiError, or nil if none found.
+func (m *GroupListMembersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupListMembersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupListMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupListMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupListMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return GroupListMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupListMembersResponseMultiError is an error wrapping multiple validation
+// errors returned by GroupListMembersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GroupListMembersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupListMembersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupListMembersResponseMultiError) AllErrors() []error { return m }
+
+// GroupListMembersResponseValidationError is the validation error returned by
+// GroupListMembersResponse.Validate if the designated constraints aren't met.
+type GroupListMembersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupListMembersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupListMembersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupListMembersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupListMembersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupListMembersResponseValidationError) ErrorName() string {
+	return "GroupListMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupListMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupListMembersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupListMembersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupListMembersResponseValidationError{}
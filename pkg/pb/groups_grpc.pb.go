@@ -0,0 +1,408 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: groups.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GroupsAPI_Create_FullMethodName        = "/groups.GroupsAPI/Create"
+	GroupsAPI_Get_FullMethodName           = "/groups.GroupsAPI/Get"
+	GroupsAPI_Update_FullMethodName        = "/groups.GroupsAPI/Update"
+	GroupsAPI_Delete_FullMethodName        = "/groups.GroupsAPI/Delete"
+	GroupsAPI_ListGroups_FullMethodName    = "/groups.GroupsAPI/ListGroups"
+	GroupsAPI_AddMembers_FullMethodName    = "/groups.GroupsAPI/AddMembers"
+	GroupsAPI_RemoveMembers_FullMethodName = "/groups.GroupsAPI/RemoveMembers"
+	GroupsAPI_ListMembers_FullMethodName   = "/groups.GroupsAPI/ListMembers"
+)
+
+// GroupsAPIClient is the client API for GroupsAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// GroupsAPI
+type GroupsAPIClient interface {
+	// Create
+	Create(ctx context.Context, in *GroupCreateRequest, opts ...grpc.CallOption) (*GroupCreateResponse, error)
+	// Get
+	Get(ctx context.Context, in *GroupGetRequest, opts ...grpc.CallOption) (*GroupGetResponse, error)
+	// Update
+	Update(ctx context.Context, in *GroupUpdateRequest, opts ...grpc.CallOption) (*GroupUpdateResponse, error)
+	// Delete удаляет группу и исключает всех ее участников
+	Delete(ctx context.Context, in *GroupDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListGroups возвращает группы, в том числе группы пользователя
+	ListGroups(ctx context.Context, in *GroupListRequest, opts ...grpc.CallOption) (*GroupListResponse, error)
+	// AddMembers добавляет пользователей в группу
+	AddMembers(ctx context.Context, in *GroupAddMembersRequest, opts ...grpc.CallOption) (*GroupAddMembersResponse, error)
+	// RemoveMembers исключает пользователей из группы
+	RemoveMembers(ctx context.Context, in *GroupRemoveMembersRequest, opts ...grpc.CallOption) (*GroupRemoveMembersResponse, error)
+	// ListMembers возвращает участников группы в порядке добавления
+	ListMembers(ctx context.Context, in *GroupListMembersRequest, opts ...grpc.CallOption) (*GroupListMembersResponse, error)
+}
+
+type groupsAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupsAPIClient(cc grpc.ClientConnInterface) GroupsAPIClient {
+	return &groupsAPIClient{cc}
+}
+
+func (c *groupsAPIClient) Create(ctx context.Context, in *GroupCreateRequest, opts ...grpc.CallOption) (*GroupCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupCreateResponse)
+	err := c.cc.Invoke(ctx, GroupsAPI_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsAPIClient) Get(ctx context.Context, in *GroupGetRequest, opts ...grpc.CallOption) (*GroupGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupGetResponse)
+	err := c.cc.Invoke(ctx, GroupsAPI_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsAPIClient) Update(ctx context.Context, in *GroupUpdateRequest, opts ...grpc.CallOption) (*GroupUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupUpdateResponse)
+	err := c.cc.Invoke(ctx, GroupsAPI_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsAPIClient) Delete(ctx context.Context, in *GroupDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GroupsAPI_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsAPIClient) ListGroups(ctx context.Context, in *GroupListRequest, opts ...grpc.CallOption) (*GroupListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupListResponse)
+	err := c.cc.Invoke(ctx, GroupsAPI_ListGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsAPIClient) AddMembers(ctx context.Context, in *GroupAddMembersRequest, opts ...grpc.CallOption) (*GroupAddMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupAddMembersResponse)
+	err := c.cc.Invoke(ctx, GroupsAPI_AddMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsAPIClient) RemoveMembers(ctx context.Context, in *GroupRemoveMembersRequest, opts ...grpc.CallOption) (*GroupRemoveMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupRemoveMembersResponse)
+	err := c.cc.Invoke(ctx, GroupsAPI_RemoveMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsAPIClient) ListMembers(ctx context.Context, in *GroupListMembersRequest, opts ...grpc.CallOption) (*GroupListMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupListMembersResponse)
+	err := c.cc.Invoke(ctx, GroupsAPI_ListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupsAPIServer is the server API for GroupsAPI service.
+// All implementations must embed UnimplementedGroupsAPIServer
+// for forward compatibility.
+//
+// GroupsAPI
+type GroupsAPIServer interface {
+	// Create
+	Create(context.Context, *GroupCreateRequest) (*GroupCreateResponse, error)
+	// Get
+	Get(context.Context, *GroupGetRequest) (*GroupGetResponse, error)
+	// Update
+	Update(context.Context, *GroupUpdateRequest) (*GroupUpdateResponse, error)
+	// Delete удаляет группу и исключает всех ее участников
+	Delete(context.Context, *GroupDeleteRequest) (*emptypb.Empty, error)
+	// ListGroups возвращает группы, в том числе группы пользователя
+	ListGroups(context.Context, *GroupListRequest) (*GroupListResponse, error)
+	// AddMembers добавляет пользователей в группу
+	AddMembers(context.Context, *GroupAddMembersRequest) (*GroupAddMembersResponse, error)
+	// RemoveMembers исключает пользователей из группы
+	RemoveMembers(context.Context, *GroupRemoveMembersRequest) (*GroupRemoveMembersResponse, error)
+	// ListMembers возвращает участников группы в порядке добавления
+	ListMembers(context.Context, *GroupListMembersRequest) (*GroupListMembersResponse, error)
+	mustEmbedUnimplementedGroupsAPIServer()
+}
+
+// UnimplementedGroupsAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGroupsAPIServer struct{}
+
+func (UnimplementedGroupsAPIServer) Create(context.Context, *GroupCreateRequest) (*GroupCreateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedGroupsAPIServer) Get(context.Context, *GroupGetRequest) (*GroupGetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedGroupsAPIServer) Update(context.Context, *GroupUpdateRequest) (*GroupUpdateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedGroupsAPIServer) Delete(context.Context, *GroupDeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedGroupsAPIServer) ListGroups(context.Context, *GroupListRequest) (*GroupListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGroups not implemented")
+}
+func (UnimplementedGroupsAPIServer) AddMembers(context.Context, *GroupAddMembersRequest) (*GroupAddMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddMembers not implemented")
+}
+func (UnimplementedGroupsAPIServer) RemoveMembers(context.Context, *GroupRemoveMembersRequest) (*GroupRemoveMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveMembers not implemented")
+}
+func (UnimplementedGroupsAPIServer) ListMembers(context.Context, *GroupListMembersRequest) (*GroupListMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedGroupsAPIServer) mustEmbedUnimplementedGroupsAPIServer() {}
+func (UnimplementedGroupsAPIServer) testEmbeddedByValue()                   {}
+
+// UnsafeGroupsAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupsAPIServer will
+// result in compilation errors.
+type UnsafeGroupsAPIServer interface {
+	mustEmbedUnimplementedGroupsAPIServer()
+}
+
+func RegisterGroupsAPIServer(s grpc.ServiceRegistrar, srv GroupsAPIServer) {
+	// If the following call panics, it indicates UnimplementedGroupsAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GroupsAPI_ServiceDesc, srv)
+}
+
+func _GroupsAPI_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsAPIServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsAPI_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsAPIServer).Create(ctx, req.(*GroupCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsAPI_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsAPIServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsAPI_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsAPIServer).Get(ctx, req.(*GroupGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsAPI_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsAPIServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsAPI_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsAPIServer).Update(ctx, req.(*GroupUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsAPI_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsAPIServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsAPI_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsAPIServer).Delete(ctx, req.(*GroupDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsAPI_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsAPIServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsAPI_ListGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsAPIServer).ListGroups(ctx, req.(*GroupListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsAPI_AddMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupAddMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsAPIServer).AddMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsAPI_AddMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsAPIServer).AddMembers(ctx, req.(*GroupAddMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsAPI_RemoveMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRemoveMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsAPIServer).RemoveMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsAPI_RemoveMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsAPIServer).RemoveMembers(ctx, req.(*GroupRemoveMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsAPI_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupListMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsAPIServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsAPI_ListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsAPIServer).ListMembers(ctx, req.(*GroupListMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GroupsAPI_ServiceDesc is the grpc.ServiceDesc for GroupsAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GroupsAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "groups.GroupsAPI",
+	HandlerType: (*GroupsAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _GroupsAPI_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _GroupsAPI_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _GroupsAPI_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GroupsAPI_Delete_Handler,
+		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _GroupsAPI_ListGroups_Handler,
+		},
+		{
+			MethodName: "AddMembers",
+			Handler:    _GroupsAPI_AddMembers_Handler,
+		},
+		{
+			MethodName: "RemoveMembers",
+			Handler:    _GroupsAPI_RemoveMembers_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _GroupsAPI_ListMembers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "groups.proto",
+}
@@ -0,0 +1,446 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: preferences.proto
+
+package pb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Preferences
+type Preferences struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Locale        string                    `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	Timezone      string                    `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Notifications *PreferencesNotifications `protobuf:"bytes,3,opt,name=notifications,proto3" json:"notifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Preferences) Reset() {
+	*x = Preferences{}
+	mi := &file_preferences_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Preferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preferences) ProtoMessage() {}
+
+func (x *Preferences) ProtoReflect() protoreflect.Message {
+	mi := &file_preferences_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preferences.ProtoReflect.Descriptor instead.
+func (*Preferences) Descriptor() ([]byte, []int) {
+	return file_preferences_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Preferences) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Preferences) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Preferences) GetNotifications() *PreferencesNotifications {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+// PreferencesNotifications
+type PreferencesNotifications struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         bool                   `protobuf:"varint,1,opt,name=email,proto3" json:"email,omitempty"`
+	Security      bool                   `protobuf:"varint,2,opt,name=security,proto3" json:"security,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreferencesNotifications) Reset() {
+	*x = PreferencesNotifications{}
+	mi := &file_preferences_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreferencesNotifications) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesNotifications) ProtoMessage() {}
+
+func (x *PreferencesNotifications) ProtoReflect() protoreflect.Message {
+	mi := &file_preferences_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesNotifications.ProtoReflect.Descriptor instead.
+func (*PreferencesNotifications) Descriptor() ([]byte, []int) {
+	return file_preferences_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PreferencesNotifications) GetEmail() bool {
+	if x != nil {
+		return x.Email
+	}
+	return false
+}
+
+func (x *PreferencesNotifications) GetSecurity() bool {
+	if x != nil {
+		return x.Security
+	}
+	return false
+}
+
+// PreferencesGetResponse
+type PreferencesGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *Preferences           `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreferencesGetResponse) Reset() {
+	*x = PreferencesGetResponse{}
+	mi := &file_preferences_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreferencesGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesGetResponse) ProtoMessage() {}
+
+func (x *PreferencesGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_preferences_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesGetResponse.ProtoReflect.Descriptor instead.
+func (*PreferencesGetResponse) Descriptor() ([]byte, []int) {
+	return file_preferences_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PreferencesGetResponse) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// PreferencesUpdateRequest
+type PreferencesUpdateRequest struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Locale        *string                                `protobuf:"bytes,1,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	Timezone      *string                                `protobuf:"bytes,2,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	Notifications *PreferencesNotificationsUpdateRequest `protobuf:"bytes,3,opt,name=notifications,proto3" json:"notifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreferencesUpdateRequest) Reset() {
+	*x = PreferencesUpdateRequest{}
+	mi := &file_preferences_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreferencesUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesUpdateRequest) ProtoMessage() {}
+
+func (x *PreferencesUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_preferences_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesUpdateRequest.ProtoReflect.Descriptor instead.
+func (*PreferencesUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_preferences_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PreferencesUpdateRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *PreferencesUpdateRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *PreferencesUpdateRequest) GetNotifications() *PreferencesNotificationsUpdateRequest {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+// PreferencesNotificationsUpdateRequest
+type PreferencesNotificationsUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         *bool                  `protobuf:"varint,1,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Security      *bool                  `protobuf:"varint,2,opt,name=security,proto3,oneof" json:"security,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreferencesNotificationsUpdateRequest) Reset() {
+	*x = PreferencesNotificationsUpdateRequest{}
+	mi := &file_preferences_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreferencesNotificationsUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesNotificationsUpdateRequest) ProtoMessage() {}
+
+func (x *PreferencesNotificationsUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_preferences_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesNotificationsUpdateRequest.ProtoReflect.Descriptor instead.
+func (*PreferencesNotificationsUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_preferences_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PreferencesNotificationsUpdateRequest) GetEmail() bool {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return false
+}
+
+func (x *PreferencesNotificationsUpdateRequest) GetSecurity() bool {
+	if x != nil && x.Security != nil {
+		return *x.Security
+	}
+	return false
+}
+
+// PreferencesUpdateResponse
+type PreferencesUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *Preferences           `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreferencesUpdateResponse) Reset() {
+	*x = PreferencesUpdateResponse{}
+	mi := &file_preferences_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreferencesUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesUpdateResponse) ProtoMessage() {}
+
+func (x *PreferencesUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_preferences_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesUpdateResponse.ProtoReflect.Descriptor instead.
+func (*PreferencesUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_preferences_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PreferencesUpdateResponse) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+var File_preferences_proto protoreflect.FileDescriptor
+
+const file_preferences_proto_rawDesc = "" +
+	"\n" +
+	"\x11preferences.proto\x12\vpreferences\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x8e\x01\n" +
+	"\vPreferences\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12K\n" +
+	"\rnotifications\x18\x03 \x01(\v2%.preferences.PreferencesNotificationsR\rnotifications\"L\n" +
+	"\x18PreferencesNotifications\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\bR\x05email\x12\x1a\n" +
+	"\bsecurity\x18\x02 \x01(\bR\bsecurity\"T\n" +
+	"\x16PreferencesGetResponse\x12:\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x18.preferences.PreferencesR\vpreferences\"\xdc\x01\n" +
+	"\x18PreferencesUpdateRequest\x12$\n" +
+	"\x06locale\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18\x10H\x00R\x06locale\x88\x01\x01\x12(\n" +
+	"\btimezone\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18@H\x01R\btimezone\x88\x01\x01\x12X\n" +
+	"\rnotifications\x18\x03 \x01(\v22.preferences.PreferencesNotificationsUpdateRequestR\rnotificationsB\t\n" +
+	"\a_localeB\v\n" +
+	"\t_timezone\"z\n" +
+	"%PreferencesNotificationsUpdateRequest\x12\x19\n" +
+	"\x05email\x18\x01 \x01(\bH\x00R\x05email\x88\x01\x01\x12\x1f\n" +
+	"\bsecurity\x18\x02 \x01(\bH\x01R\bsecurity\x88\x01\x01B\b\n" +
+	"\x06_emailB\v\n" +
+	"\t_security\"W\n" +
+	"\x19PreferencesUpdateResponse\x12:\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x18.preferences.PreferencesR\vpreferences2\xdc\x01\n" +
+	"\x0ePreferencesAPI\x12X\n" +
+	"\x03Get\x12\x16.google.protobuf.Empty\x1a#.preferences.PreferencesGetResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/preferences\x12p\n" +
+	"\x06Update\x12%.preferences.PreferencesUpdateRequest\x1a&.preferences.PreferencesUpdateResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*2\f/preferencesB\xf6\x01\x92As\x12\x18\n" +
+	"\x0fPreferences API2\x051.0.0\"\x04/api2\x10application/json:\x10application/jsonZ\x1f\n" +
+	"\x1d\n" +
+	"\x06x-auth\x12\x13\b\x02\x1a\rauthorization \x02b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06x-auth\x12\x00\n" +
+	"\x0fcom.preferencesB\x10PreferencesProtoP\x01Z\x0fgreenaid/pkg/pb\xa2\x02\x03PXX\xaa\x02\vPreferences\xca\x02\vPreferences\xe2\x02\x17Preferences\\GPBMetadata\xea\x02\vPreferencesb\x06proto3"
+
+var (
+	file_preferences_proto_rawDescOnce sync.Once
+	file_preferences_proto_rawDescData []byte
+)
+
+func file_preferences_proto_rawDescGZIP() []byte {
+	file_preferences_proto_rawDescOnce.Do(func() {
+		file_preferences_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_preferences_proto_rawDesc), len(file_preferences_proto_rawDesc)))
+	})
+	return file_preferences_proto_rawDescData
+}
+
+var file_preferences_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_preferences_proto_goTypes = []any{
+	(*Preferences)(nil),                           // 0: preferences.Preferences
+	(*PreferencesNotifications)(nil),              // 1: preferences.PreferencesNotifications
+	(*PreferencesGetResponse)(nil),                // 2: preferences.PreferencesGetResponse
+	(*PreferencesUpdateRequest)(nil),              // 3: preferences.PreferencesUpdateRequest
+	(*PreferencesNotificationsUpdateRequest)(nil), // 4: preferences.PreferencesNotificationsUpdateRequest
+	(*PreferencesUpdateResponse)(nil),             // 5: preferences.PreferencesUpdateResponse
+	(*emptypb.Empty)(nil),                         // 6: google.protobuf.Empty
+}
+var file_preferences_proto_depIdxs = []int32{
+	1, // 0: preferences.Preferences.notifications:type_name -> preferences.PreferencesNotifications
+	0, // 1: preferences.PreferencesGetResponse.preferences:type_name -> preferences.Preferences
+	4, // 2: preferences.PreferencesUpdateRequest.notifications:type_name -> preferences.PreferencesNotificationsUpdateRequest
+	0, // 3: preferences.PreferencesUpdateResponse.preferences:type_name -> preferences.Preferences
+	6, // 4: preferences.PreferencesAPI.Get:input_type -> google.protobuf.Empty
+	3, // 5: preferences.PreferencesAPI.Update:input_type -> preferences.PreferencesUpdateRequest
+	2, // 6: preferences.PreferencesAPI.Get:output_type -> preferences.PreferencesGetResponse
+	5, // 7: preferences.PreferencesAPI.Update:output_type -> preferences.PreferencesUpdateResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_preferences_proto_init() }
+func file_preferences_proto_init() {
+	if File_preferences_proto != nil {
+		return
+	}
+	file_preferences_proto_msgTypes[3].OneofWrappers = []any{}
+	file_preferences_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_preferences_proto_rawDesc), len(file_preferences_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_preferences_proto_goTypes,
+		DependencyIndexes: file_preferences_proto_depIdxs,
+		MessageInfos:      file_preferences_proto_msgTypes,
+	}.Build()
+	File_preferences_proto = out.File
+	file_preferences_proto_goTypes = nil
+	file_preferences_proto_depIdxs = nil
+}
@@ -0,0 +1,810 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: preferences.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Preferences with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Preferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Preferences with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PreferencesMultiError, or
+// nil if none found.
+func (m *Preferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Preferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Locale
+
+	// no validation rules for Timezone
+
+	if all {
+		switch v := interface{}(m.GetNotifications()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreferencesValidationError{
+					field:  "Notifications",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreferencesValidationError{
+					field:  "Notifications",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotifications()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreferencesValidationError{
+				field:  "Notifications",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreferencesMultiError is an error wrapping multiple validation errors
+// returned by Preferences.ValidateAll() if the designated constraints aren't met.
+type PreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreferencesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreferencesMultiError) AllErrors() []error { return m }
+
+// PreferencesValidationError is the validation error returned by
+// Preferences.Validate if the designated constraints aren't met.
+type PreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreferencesValidationError) ErrorName() string { return "PreferencesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreferencesValidationError{}
+
+// Validate checks the field values on PreferencesNotifications with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreferencesNotifications) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreferencesNotifications with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreferencesNotificationsMultiError, or nil if none found.
+func (m *PreferencesNotifications) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreferencesNotifications) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	// no validation rules for Security
+
+	if len(errors) > 0 {
+		return PreferencesNotificationsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreferencesNotificationsMultiError is an error wrapping multiple validation
+// errors returned by PreferencesNotifications.ValidateAll() if the designated
+// constraints aren't met.
+type PreferencesNotificationsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreferencesNotificationsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreferencesNotificationsMultiError) AllErrors() []error { return m }
+
+// PreferencesNotificationsValidationError is the validation error returned by
+// PreferencesNotifications.Validate if the designated constraints aren't met.
+type PreferencesNotificationsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreferencesNotificationsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreferencesNotificationsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreferencesNotificationsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreferencesNotificationsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreferencesNotificationsValidationError) ErrorName() string {
+	return "PreferencesNotificationsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreferencesNotificationsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreferencesNotifications.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreferencesNotificationsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreferencesNotificationsValidationError{}
+
+// Validate checks the field values on PreferencesGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreferencesGetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreferencesGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreferencesGetResponseMultiError, or nil if none found.
+func (m *PreferencesGetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreferencesGetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreferencesGetResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreferencesGetResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreferencesGetResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PreferencesGetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreferencesGetResponseMultiError is an error wrapping multiple validation
+// errors returned by PreferencesGetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PreferencesGetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreferencesGetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreferencesGetResponseMultiError) AllErrors() []error { return m }
+
+// PreferencesGetResponseValidationError is the validation error returned by
+// PreferencesGetResponse.Validate if the designated constraints aren't met.
+type PreferencesGetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreferencesGetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreferencesGetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreferencesGetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreferencesGetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreferencesGetResponseValidationError) ErrorName() string {
+	return "PreferencesGetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreferencesGetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreferencesGetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreferencesGetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreferencesGetResponseValidationError{}
+
+// Validate checks the field values on PreferencesUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreferencesUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreferencesUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreferencesUpdateRequestMultiError, or nil if none found.
+func (m *PreferencesUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreferencesUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNotifications()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreferencesUpdateRequestValidationError{
+					field:  "Notifications",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreferencesUpdateRequestValidationError{
+					field:  "Notifications",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotifications()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreferencesUpdateRequestValidationError{
+				field:  "Notifications",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Locale != nil {
+
+		if utf8.RuneCountInString(m.GetLocale()) > 16 {
+			err := PreferencesUpdateRequestValidationError{
+				field:  "Locale",
+				reason: "value length must be at most 16 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Timezone != nil {
+
+		if utf8.RuneCountInString(m.GetTimezone()) > 64 {
+			err := PreferencesUpdateRequestValidationError{
+				field:  "Timezone",
+				reason: "value length must be at most 64 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PreferencesUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreferencesUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by PreferencesUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PreferencesUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreferencesUpdateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreferencesUpdateRequestMultiError) AllErrors() []error { return m }
+
+// PreferencesUpdateRequestValidationError is the validation error returned by
+// PreferencesUpdateRequest.Validate if the designated constraints aren't met.
+type PreferencesUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreferencesUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreferencesUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreferencesUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreferencesUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreferencesUpdateRequestValidationError) ErrorName() string {
+	return "PreferencesUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreferencesUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreferencesUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreferencesUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreferencesUpdateRequestValidationError{}
+
+// Validate checks the field values on PreferencesNotificationsUpdateRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *PreferencesNotificationsUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreferencesNotificationsUpdateRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PreferencesNotificationsUpdateRequestMultiError, or nil if none found.
+func (m *PreferencesNotificationsUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreferencesNotificationsUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Email != nil {
+		// no validation rules for Email
+	}
+
+	if m.Security != nil {
+		// no validation rules for Security
+	}
+
+	if len(errors) > 0 {
+		return PreferencesNotificationsUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreferencesNotificationsUpdateRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// PreferencesNotificationsUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PreferencesNotificationsUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreferencesNotificationsUpdateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreferencesNotificationsUpdateRequestMultiError) AllErrors() []error { return m }
+
+// PreferencesNotificationsUpdateRequestValidationError is the validation error
+// returned by PreferencesNotificationsUpdateRequest.Validate if the
+// designated constraints aren't met.
+type PreferencesNotificationsUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreferencesNotificationsUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreferencesNotificationsUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreferencesNotificationsUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreferencesNotificationsUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreferencesNotificationsUpdateRequestValidationError) ErrorName() string {
+	return "PreferencesNotificationsUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreferencesNotificationsUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreferencesNotificationsUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreferencesNotificationsUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreferencesNotificationsUpdateRequestValidationError{}
+
+// Validate checks the field values on PreferencesUpdateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreferencesUpdateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreferencesUpdateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreferencesUpdateResponseMultiError, or nil if none found.
+func (m *PreferencesUpdateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreferencesUpdateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreferencesUpdateResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreferencesUpdateResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreferencesUpdateResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PreferencesUpdateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreferencesUpdateResponseMultiError is an error wrapping multiple validation
+// errors returned by PreferencesUpdateResponse.ValidateAll() if the
+// designated constraints aren't met.
+type PreferencesUpdateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreferencesUpdateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreferencesUpdateResponseMultiError) AllErrors() []error { return m }
+
+// PreferencesUpdateResponseValidationError is the validation error returned by
+// PreferencesUpdateResponse.Validate if the designated constraints aren't met.
+type PreferencesUpdateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreferencesUpdateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreferencesUpdateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreferencesUpdateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreferencesUpdateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreferencesUpdateResponseValidationError) ErrorName() string {
+	return "PreferencesUpdateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreferencesUpdateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreferencesUpdateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreferencesUpdateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreferencesUpdateResponseValidationError{}
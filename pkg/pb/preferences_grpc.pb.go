@@ -0,0 +1,168 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: preferences.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PreferencesAPI_Get_FullMethodName    = "/preferences.PreferencesAPI/Get"
+	PreferencesAPI_Update_FullMethodName = "/preferences.PreferencesAPI/Update"
+)
+
+// PreferencesAPIClient is the client API for PreferencesAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PreferencesAPI настройки текущего пользователя
+type PreferencesAPIClient interface {
+	// Get
+	Get(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PreferencesGetResponse, error)
+	// Update изменяет только переданные настройки
+	Update(ctx context.Context, in *PreferencesUpdateRequest, opts ...grpc.CallOption) (*PreferencesUpdateResponse, error)
+}
+
+type preferencesAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPreferencesAPIClient(cc grpc.ClientConnInterface) PreferencesAPIClient {
+	return &preferencesAPIClient{cc}
+}
+
+func (c *preferencesAPIClient) Get(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PreferencesGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreferencesGetResponse)
+	err := c.cc.Invoke(ctx, PreferencesAPI_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *preferencesAPIClient) Update(ctx context.Context, in *PreferencesUpdateRequest, opts ...grpc.CallOption) (*PreferencesUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreferencesUpdateResponse)
+	err := c.cc.Invoke(ctx, PreferencesAPI_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PreferencesAPIServer is the server API for PreferencesAPI service.
+// All implementations must embed UnimplementedPreferencesAPIServer
+// for forward compatibility.
+//
+// PreferencesAPI настройки текущего пользователя
+type PreferencesAPIServer interface {
+	// Get
+	Get(context.Context, *emptypb.Empty) (*PreferencesGetResponse, error)
+	// Update изменяет только переданные настройки
+	Update(context.Context, *PreferencesUpdateRequest) (*PreferencesUpdateResponse, error)
+	mustEmbedUnimplementedPreferencesAPIServer()
+}
+
+// UnimplementedPreferencesAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPreferencesAPIServer struct{}
+
+func (UnimplementedPreferencesAPIServer) Get(context.Context, *emptypb.Empty) (*PreferencesGetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedPreferencesAPIServer) Update(context.Context, *PreferencesUpdateRequest) (*PreferencesUpdateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedPreferencesAPIServer) mustEmbedUnimplementedPreferencesAPIServer() {}
+func (UnimplementedPreferencesAPIServer) testEmbeddedByValue()                        {}
+
+// UnsafePreferencesAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PreferencesAPIServer will
+// result in compilation errors.
+type UnsafePreferencesAPIServer interface {
+	mustEmbedUnimplementedPreferencesAPIServer()
+}
+
+func RegisterPreferencesAPIServer(s grpc.ServiceRegistrar, srv PreferencesAPIServer) {
+	// If the following call panics, it indicates UnimplementedPreferencesAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PreferencesAPI_ServiceDesc, srv)
+}
+
+func _PreferencesAPI_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PreferencesAPIServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PreferencesAPI_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PreferencesAPIServer).Get(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PreferencesAPI_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreferencesUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PreferencesAPIServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PreferencesAPI_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PreferencesAPIServer).Update(ctx, req.(*PreferencesUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PreferencesAPI_ServiceDesc is the grpc.ServiceDesc for PreferencesAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PreferencesAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "preferences.PreferencesAPI",
+	HandlerType: (*PreferencesAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _PreferencesAPI_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _PreferencesAPI_Update_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "preferences.proto",
+}
@@ -0,0 +1,1328 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: scheduler.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on JobRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobRunMultiError, or nil if none found.
+func (m *JobRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRun) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	if m.Error != nil {
+		// no validation rules for Error
+	}
+
+	if m.DurationMs != nil {
+		// no validation rules for DurationMs
+	}
+
+	if len(errors) > 0 {
+		return JobRunMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRunMultiError is an error wrapping multiple validation errors returned by
+// JobRun.ValidateAll() if the designated constraints aren't met.
+type JobRunMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRunMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRunMultiError) AllErrors() []error { return m }
+
+// JobRunValidationError is the validation error returned by JobRun.Validate if
+// the designated constraints aren't met.
+type JobRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRunValidationError) ErrorName() string { return "JobRunValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRunValidationError{}
+
+// Validate checks the field values on Job with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Job) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Job with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobMultiError, or nil if none found.
+func (m *Job) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Job) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Schedule
+
+	// no validation rules for Timezone
+
+	// no validation rules for CatchUp
+
+	// no validation rules for Paused
+
+	if all {
+		switch v := interface{}(m.GetNextRunAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "NextRunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "NextRunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRunAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "NextRunAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTriggeredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "TriggeredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "TriggeredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTriggeredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "TriggeredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "LastRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "LastRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "LastRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return JobMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobMultiError is an error wrapping multiple validation errors returned by
+// Job.ValidateAll() if the designated constraints aren't met.
+type JobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobMultiError) AllErrors() []error { return m }
+
+// JobValidationError is the validation error returned by Job.Validate if the
+// designated constraints aren't met.
+type JobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobValidationError) ErrorName() string { return "JobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobValidationError{}
+
+// Validate checks the field values on JobListRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobListRequestMultiError,
+// or nil if none found.
+func (m *JobListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return JobListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobListRequestMultiError is an error wrapping multiple validation errors
+// returned by JobListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type JobListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobListRequestMultiError) AllErrors() []error { return m }
+
+// JobListRequestValidationError is the validation error returned by
+// JobListRequest.Validate if the designated constraints aren't met.
+type JobListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobListRequestValidationError) ErrorName() string { return "JobListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobListRequestValidationError{}
+
+// Validate checks the field values on JobListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobListResponseMultiError, or nil if none found.
+func (m *JobListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobListResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobListResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobListResponseValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return JobListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobListResponseMultiError is an error wrapping multiple validation errors
+// returned by JobListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type JobListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobListResponseMultiError) AllErrors() []error { return m }
+
+// JobListResponseValidationError is the validation error returned by
+// JobListResponse.Validate if the designated constraints aren't met.
+type JobListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobListResponseValidationError) ErrorName() string { return "JobListResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobListResponseValidationError{}
+
+// Validate checks the field values on JobTriggerRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobTriggerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobTriggerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobTriggerRequestMultiError, or nil if none found.
+func (m *JobTriggerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobTriggerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := JobTriggerRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return JobTriggerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobTriggerRequestMultiError is an error wrapping multiple validation errors
+// returned by JobTriggerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type JobTriggerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobTriggerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobTriggerRequestMultiError) AllErrors() []error { return m }
+
+// JobTriggerRequestValidationError is the validation error returned by
+// JobTriggerRequest.Validate if the designated constraints aren't met.
+type JobTriggerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobTriggerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobTriggerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobTriggerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobTriggerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobTriggerRequestValidationError) ErrorName() string {
+	return "JobTriggerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobTriggerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobTriggerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobTriggerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobTriggerRequestValidationError{}
+
+// Validate checks the field values on JobTriggerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobTriggerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobTriggerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobTriggerResponseMultiError, or nil if none found.
+func (m *JobTriggerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobTriggerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobTriggerResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobTriggerResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobTriggerResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return JobTriggerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobTriggerResponseMultiError is an error wrapping multiple validation errors
+// returned by JobTriggerResponse.ValidateAll() if the designated constraints
+// aren't met.
+type JobTriggerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobTriggerResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobTriggerResponseMultiError) AllErrors() []error { return m }
+
+// JobTriggerResponseValidationError is the validation error returned by
+// JobTriggerResponse.Validate if the designated constraints aren't met.
+type JobTriggerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobTriggerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobTriggerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobTriggerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobTriggerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobTriggerResponseValidationError) ErrorName() string {
+	return "JobTriggerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobTriggerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobTriggerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobTriggerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobTriggerResponseValidationError{}
+
+// Validate checks the field values on JobPauseRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobPauseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobPauseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobPauseRequestMultiError, or nil if none found.
+func (m *JobPauseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobPauseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := JobPauseRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return JobPauseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobPauseRequestMultiError is an error wrapping multiple validation errors
+// returned by JobPauseRequest.ValidateAll() if the designated constraints
+// aren't met.
+type JobPauseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobPauseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobPauseRequestMultiError) AllErrors() []error { return m }
+
+// JobPauseRequestValidationError is the validation error returned by
+// JobPauseRequest.Validate if the designated constraints aren't met.
+type JobPauseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobPauseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobPauseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobPauseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobPauseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobPauseRequestValidationError) ErrorName() string { return "JobPauseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobPauseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobPauseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobPauseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobPauseRequestValidationError{}
+
+// Validate checks the field values on JobPauseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobPauseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobPauseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobPauseResponseMultiError, or nil if none found.
+func (m *JobPauseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobPauseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobPauseResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobPauseResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobPauseResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return JobPauseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobPauseResponseMultiError is an error wrapping multiple validation errors
+// returned by JobPauseResponse.ValidateAll() if the designated constraints
+// aren't met.
+type JobPauseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobPauseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobPauseResponseMultiError) AllErrors() []error { return m }
+
+// JobPauseResponseValidationError is the validation error returned by
+// JobPauseResponse.Validate if the designated constraints aren't met.
+type JobPauseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobPauseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobPauseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobPauseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobPauseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobPauseResponseValidationError) ErrorName() string { return "JobPauseResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobPauseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobPauseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobPauseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobPauseResponseValidationError{}
+
+// Validate checks the field values on JobResumeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobResumeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobResumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobResumeRequestMultiError, or nil if none found.
+func (m *JobResumeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobResumeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := JobResumeRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return JobResumeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobResumeRequestMultiError is an error wrapping multiple validation errors
+// returned by JobResumeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type JobResumeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobResumeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobResumeRequestMultiError) AllErrors() []error { return m }
+
+// JobResumeRequestValidationError is the validation error returned by
+// JobResumeRequest.Validate if the designated constraints aren't met.
+type JobResumeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobResumeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobResumeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobResumeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobResumeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobResumeRequestValidationError) ErrorName() string { return "JobResumeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobResumeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobResumeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobResumeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobResumeRequestValidationError{}
+
+// Validate checks the field values on JobResumeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobResumeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobResumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobResumeResponseMultiError, or nil if none found.
+func (m *JobResumeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobResumeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobResumeResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobResumeResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobResumeResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return JobResumeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobResumeResponseMultiError is an error wrapping multiple validation errors
+// returned by JobResumeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type JobResumeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobResumeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobResumeResponseMultiError) AllErrors() []error { return m }
+
+// JobResumeResponseValidationError is the validation error returned by
+// JobResumeResponse.Validate if the designated constraints aren't met.
+type JobResumeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobResumeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobResumeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobResumeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobResumeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobResumeResponseValidationError) ErrorName() string {
+	return "JobResumeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobResumeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobResumeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobResumeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobResumeResponseValidationError{}
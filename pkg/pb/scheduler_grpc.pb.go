@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: scheduler.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SchedulerAPI_ListJobs_FullMethodName   = "/scheduler.SchedulerAPI/ListJobs"
+	SchedulerAPI_TriggerJob_FullMethodName = "/scheduler.SchedulerAPI/TriggerJob"
+	SchedulerAPI_PauseJob_FullMethodName   = "/scheduler.SchedulerAPI/PauseJob"
+	SchedulerAPI_ResumeJob_FullMethodName  = "/scheduler.SchedulerAPI/ResumeJob"
+)
+
+// SchedulerAPIClient is the client API for SchedulerAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SchedulerAPI управление периодическими задачами, доступно только администраторам
+type SchedulerAPIClient interface {
+	// ListJobs возвращает зарегистрированные задачи по имени
+	ListJobs(ctx context.Context, in *JobListRequest, opts ...grpc.CallOption) (*JobListResponse, error)
+	// TriggerJob запускает задачу вне расписания, в том числе приостановленную
+	TriggerJob(ctx context.Context, in *JobTriggerRequest, opts ...grpc.CallOption) (*JobTriggerResponse, error)
+	// PauseJob приостанавливает запуски задачи по расписанию
+	PauseJob(ctx context.Context, in *JobPauseRequest, opts ...grpc.CallOption) (*JobPauseResponse, error)
+	// ResumeJob возобновляет запуски задачи со следующего по расписанию
+	ResumeJob(ctx context.Context, in *JobResumeRequest, opts ...grpc.CallOption) (*JobResumeResponse, error)
+}
+
+type schedulerAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchedulerAPIClient(cc grpc.ClientConnInterface) SchedulerAPIClient {
+	return &schedulerAPIClient{cc}
+}
+
+func (c *schedulerAPIClient) ListJobs(ctx context.Context, in *JobListRequest, opts ...grpc.CallOption) (*JobListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobListResponse)
+	err := c.cc.Invoke(ctx, SchedulerAPI_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerAPIClient) TriggerJob(ctx context.Context, in *JobTriggerRequest, opts ...grpc.CallOption) (*JobTriggerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobTriggerResponse)
+	err := c.cc.Invoke(ctx, SchedulerAPI_TriggerJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerAPIClient) PauseJob(ctx context.Context, in *JobPauseRequest, opts ...grpc.CallOption) (*JobPauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobPauseResponse)
+	err := c.cc.Invoke(ctx, SchedulerAPI_PauseJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerAPIClient) ResumeJob(ctx context.Context, in *JobResumeRequest, opts ...grpc.CallOption) (*JobResumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobResumeResponse)
+	err := c.cc.Invoke(ctx, SchedulerAPI_ResumeJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchedulerAPIServer is the server API for SchedulerAPI service.
+// All implementations must embed UnimplementedSchedulerAPIServer
+// for forward compatibility.
+//
+// SchedulerAPI управление периодическими задачами, доступно только администраторам
+type SchedulerAPIServer interface {
+	// ListJobs возвращает зарегистрированные задачи по имени
+	ListJobs(context.Context, *JobListRequest) (*JobListResponse, error)
+	// TriggerJob запускает задачу вне расписания, в том числе приостановленную
+	TriggerJob(context.Context, *JobTriggerRequest) (*JobTriggerResponse, error)
+	// PauseJob приостанавливает запуски задачи по расписанию
+	PauseJob(context.Context, *JobPauseRequest) (*JobPauseResponse, error)
+	// ResumeJob возобновляет запуски задачи со следующего по расписанию
+	ResumeJob(context.Context, *JobResumeRequest) (*JobResumeResponse, error)
+	mustEmbedUnimplementedSchedulerAPIServer()
+}
+
+// UnimplementedSchedulerAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSchedulerAPIServer struct{}
+
+func (UnimplementedSchedulerAPIServer) ListJobs(context.Context, *JobListRequest) (*JobListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedSchedulerAPIServer) TriggerJob(context.Context, *JobTriggerRequest) (*JobTriggerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TriggerJob not implemented")
+}
+func (UnimplementedSchedulerAPIServer) PauseJob(context.Context, *JobPauseRequest) (*JobPauseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseJob not implemented")
+}
+func (UnimplementedSchedulerAPIServer) ResumeJob(context.Context, *JobResumeRequest) (*JobResumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeJob not implemented")
+}
+func (UnimplementedSchedulerAPIServer) mustEmbedUnimplementedSchedulerAPIServer() {}
+func (UnimplementedSchedulerAPIServer) testEmbeddedByValue()                      {}
+
+// UnsafeSchedulerAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SchedulerAPIServer will
+// result in compilation errors.
+type UnsafeSchedulerAPIServer interface {
+	mustEmbedUnimplementedSchedulerAPIServer()
+}
+
+func RegisterSchedulerAPIServer(s grpc.ServiceRegistrar, srv SchedulerAPIServer) {
+	// If the following call panics, it indicates UnimplementedSchedulerAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SchedulerAPI_ServiceDesc, srv)
+}
+
+func _SchedulerAPI_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerAPIServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerAPI_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerAPIServer).ListJobs(ctx, req.(*JobListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerAPI_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerAPIServer).TriggerJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerAPI_TriggerJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerAPIServer).TriggerJob(ctx, req.(*JobTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerAPI_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerAPIServer).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerAPI_PauseJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerAPIServer).PauseJob(ctx, req.(*JobPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerAPI_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerAPIServer).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerAPI_ResumeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerAPIServer).ResumeJob(ctx, req.(*JobResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SchedulerAPI_ServiceDesc is the grpc.ServiceDesc for SchedulerAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SchedulerAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scheduler.SchedulerAPI",
+	HandlerType: (*SchedulerAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListJobs",
+			Handler:    _SchedulerAPI_ListJobs_Handler,
+		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _SchedulerAPI_TriggerJob_Handler,
+		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _SchedulerAPI_PauseJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _SchedulerAPI_ResumeJob_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "scheduler.proto",
+}
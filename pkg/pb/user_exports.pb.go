@@ -0,0 +1,586 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: user_exports.proto
+
+package pb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UserExport
+type UserExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	DownloadUrl   *string                `protobuf:"bytes,5,opt,name=download_url,proto3,oneof" json:"download_url,omitempty"`
+	Error         *string                `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=finished_at,proto3,oneof" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserExport) Reset() {
+	*x = UserExport{}
+	mi := &file_user_exports_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserExport) ProtoMessage() {}
+
+func (x *UserExport) ProtoReflect() protoreflect.Message {
+	mi := &file_user_exports_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserExport.ProtoReflect.Descriptor instead.
+func (*UserExport) Descriptor() ([]byte, []int) {
+	return file_user_exports_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserExport) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserExport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserExport) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *UserExport) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *UserExport) GetDownloadUrl() string {
+	if x != nil && x.DownloadUrl != nil {
+		return *x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *UserExport) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *UserExport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserExport) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *UserExport) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+// UserExportFilter
+type UserExportFilter struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Ids         []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Name        *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Emails      []string               `protobuf:"bytes,3,rep,name=emails,proto3" json:"emails,omitempty"`
+	IsAdmin     *bool                  `protobuf:"varint,4,opt,name=is_admin,proto3,oneof" json:"is_admin,omitempty"`
+	WithDeleted *bool                  `protobuf:"varint,5,opt,name=with_deleted,proto3,oneof" json:"with_deleted,omitempty"`
+	// Пользователи, атрибуты которых содержат указанные
+	Attributes    *structpb.Struct `protobuf:"bytes,6,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserExportFilter) Reset() {
+	*x = UserExportFilter{}
+	mi := &file_user_exports_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserExportFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserExportFilter) ProtoMessage() {}
+
+func (x *UserExportFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_user_exports_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserExportFilter.ProtoReflect.Descriptor instead.
+func (*UserExportFilter) Descriptor() ([]byte, []int) {
+	return file_user_exports_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserExportFilter) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *UserExportFilter) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UserExportFilter) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (x *UserExportFilter) GetIsAdmin() bool {
+	if x != nil && x.IsAdmin != nil {
+		return *x.IsAdmin
+	}
+	return false
+}
+
+func (x *UserExportFilter) GetWithDeleted() bool {
+	if x != nil && x.WithDeleted != nil {
+		return *x.WithDeleted
+	}
+	return false
+}
+
+func (x *UserExportFilter) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// ExportUsersRequest
+type ExportUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Filter        *UserExportFilter      `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUsersRequest) Reset() {
+	*x = ExportUsersRequest{}
+	mi := &file_user_exports_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsersRequest) ProtoMessage() {}
+
+func (x *ExportUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_exports_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsersRequest.ProtoReflect.Descriptor instead.
+func (*ExportUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_exports_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportUsersRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportUsersRequest) GetFilter() *UserExportFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// ExportUsersResponse
+type ExportUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *UserExport            `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUsersResponse) Reset() {
+	*x = ExportUsersResponse{}
+	mi := &file_user_exports_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsersResponse) ProtoMessage() {}
+
+func (x *ExportUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_exports_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsersResponse.ProtoReflect.Descriptor instead.
+func (*ExportUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_exports_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExportUsersResponse) GetExport() *UserExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+// UserExportGetRequest
+type UserExportGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      int64                  `protobuf:"varint,1,opt,name=export_id,proto3" json:"export_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserExportGetRequest) Reset() {
+	*x = UserExportGetRequest{}
+	mi := &file_user_exports_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserExportGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserExportGetRequest) ProtoMessage() {}
+
+func (x *UserExportGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_exports_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserExportGetRequest.ProtoReflect.Descriptor instead.
+func (*UserExportGetRequest) Descriptor() ([]byte, []int) {
+	return file_user_exports_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UserExportGetRequest) GetExportId() int64 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+// UserExportGetResponse
+type UserExportGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *UserExport            `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserExportGetResponse) Reset() {
+	*x = UserExportGetResponse{}
+	mi := &file_user_exports_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserExportGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserExportGetResponse) ProtoMessage() {}
+
+func (x *UserExportGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_exports_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserExportGetResponse.ProtoReflect.Descriptor instead.
+func (*UserExportGetResponse) Descriptor() ([]byte, []int) {
+	return file_user_exports_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserExportGetResponse) GetExport() *UserExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+// UserExportGetFileRequest
+type UserExportGetFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      int64                  `protobuf:"varint,1,opt,name=export_id,proto3" json:"export_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserExportGetFileRequest) Reset() {
+	*x = UserExportGetFileRequest{}
+	mi := &file_user_exports_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserExportGetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserExportGetFileRequest) ProtoMessage() {}
+
+func (x *UserExportGetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_exports_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserExportGetFileRequest.ProtoReflect.Descriptor instead.
+func (*UserExportGetFileRequest) Descriptor() ([]byte, []int) {
+	return file_user_exports_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserExportGetFileRequest) GetExportId() int64 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+var File_user_exports_proto protoreflect.FileDescriptor
+
+const file_user_exports_proto_rawDesc = "" +
+	"\n" +
+	"\x12user_exports.proto\x12\fuser_exports\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x19google/api/httpbody.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x8c\x03\n" +
+	"\n" +
+	"UserExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\x12'\n" +
+	"\fdownload_url\x18\x05 \x01(\tH\x00R\fdownload_url\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\x06 \x01(\tH\x01R\x05error\x88\x01\x01\x12:\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\x12A\n" +
+	"\vfinished_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vfinished_at\x88\x01\x01B\x0f\n" +
+	"\r_download_urlB\b\n" +
+	"\x06_errorB\x0e\n" +
+	"\f_finished_at\"\xff\x01\n" +
+	"\x10UserExportFilter\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x16\n" +
+	"\x06emails\x18\x03 \x03(\tR\x06emails\x12\x1f\n" +
+	"\bis_admin\x18\x04 \x01(\bH\x01R\bis_admin\x88\x01\x01\x12'\n" +
+	"\fwith_deleted\x18\x05 \x01(\bH\x02R\fwith_deleted\x88\x01\x01\x127\n" +
+	"\n" +
+	"attributes\x18\x06 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributesB\a\n" +
+	"\x05_nameB\v\n" +
+	"\t_is_adminB\x0f\n" +
+	"\r_with_deleted\"{\n" +
+	"\x12ExportUsersRequest\x12-\n" +
+	"\x06format\x18\x01 \x01(\tB\x15\xfaB\x12r\x10R\x03csvR\x04xlsxR\x03pdfR\x06format\x126\n" +
+	"\x06filter\x18\x02 \x01(\v2\x1e.user_exports.UserExportFilterR\x06filter\"G\n" +
+	"\x13ExportUsersResponse\x120\n" +
+	"\x06export\x18\x01 \x01(\v2\x18.user_exports.UserExportR\x06export\"=\n" +
+	"\x14UserExportGetRequest\x12%\n" +
+	"\texport_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\texport_id\"I\n" +
+	"\x15UserExportGetResponse\x120\n" +
+	"\x06export\x18\x01 \x01(\v2\x18.user_exports.UserExportR\x06export\"A\n" +
+	"\x18UserExportGetFileRequest\x12%\n" +
+	"\texport_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\texport_id2\xe7\x02\n" +
+	"\x0eUserExportsAPI\x12m\n" +
+	"\vExportUsers\x12 .user_exports.ExportUsersRequest\x1a!.user_exports.ExportUsersResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/users/exports\x12r\n" +
+	"\x03Get\x12\".user_exports.UserExportGetRequest\x1a#.user_exports.UserExportGetResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/users/exports/{export_id}\x12r\n" +
+	"\aGetFile\x12&.user_exports.UserExportGetFileRequest\x1a\x14.google.api.HttpBody\"'\x82\xd3\xe4\x93\x02!\x12\x1f/users/exports/{export_id}/file0\x01B\xf8\x01\x92At\x12\x19\n" +
+	"\x10User Exports API2\x051.0.0\"\x04/api2\x10application/json:\x10application/jsonZ\x1f\n" +
+	"\x1d\n" +
+	"\x06x-auth\x12\x13\b\x02\x1a\rauthorization \x02b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06x-auth\x12\x00\n" +
+	"\x10com.user_exportsB\x10UserExportsProtoP\x01Z\x0fgreenaid/pkg/pb\xa2\x02\x03UXX\xaa\x02\vUserExports\xca\x02\vUserExports\xe2\x02\x17UserExports\\GPBMetadata\xea\x02\vUserExportsb\x06proto3"
+
+var (
+	file_user_exports_proto_rawDescOnce sync.Once
+	file_user_exports_proto_rawDescData []byte
+)
+
+func file_user_exports_proto_rawDescGZIP() []byte {
+	file_user_exports_proto_rawDescOnce.Do(func() {
+		file_user_exports_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_exports_proto_rawDesc), len(file_user_exports_proto_rawDesc)))
+	})
+	return file_user_exports_proto_rawDescData
+}
+
+var file_user_exports_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_user_exports_proto_goTypes = []any{
+	(*UserExport)(nil),               // 0: user_exports.UserExport
+	(*UserExportFilter)(nil),         // 1: user_exports.UserExportFilter
+	(*ExportUsersRequest)(nil),       // 2: user_exports.ExportUsersRequest
+	(*ExportUsersResponse)(nil),      // 3: user_exports.ExportUsersResponse
+	(*UserExportGetRequest)(nil),     // 4: user_exports.UserExportGetRequest
+	(*UserExportGetResponse)(nil),    // 5: user_exports.UserExportGetResponse
+	(*UserExportGetFileRequest)(nil), // 6: user_exports.UserExportGetFileRequest
+	(*timestamppb.Timestamp)(nil),    // 7: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),          // 8: google.protobuf.Struct
+	(*httpbody.HttpBody)(nil),        // 9: google.api.HttpBody
+}
+var file_user_exports_proto_depIdxs = []int32{
+	7,  // 0: user_exports.UserExport.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 1: user_exports.UserExport.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 2: user_exports.UserExport.finished_at:type_name -> google.protobuf.Timestamp
+	8,  // 3: user_exports.UserExportFilter.attributes:type_name -> google.protobuf.Struct
+	1,  // 4: user_exports.ExportUsersRequest.filter:type_name -> user_exports.UserExportFilter
+	0,  // 5: user_exports.ExportUsersResponse.export:type_name -> user_exports.UserExport
+	0,  // 6: user_exports.UserExportGetResponse.export:type_name -> user_exports.UserExport
+	2,  // 7: user_exports.UserExportsAPI.ExportUsers:input_type -> user_exports.ExportUsersRequest
+	4,  // 8: user_exports.UserExportsAPI.Get:input_type -> user_exports.UserExportGetRequest
+	6,  // 9: user_exports.UserExportsAPI.GetFile:input_type -> user_exports.UserExportGetFileRequest
+	3,  // 10: user_exports.UserExportsAPI.ExportUsers:output_type -> user_exports.ExportUsersResponse
+	5,  // 11: user_exports.UserExportsAPI.Get:output_type -> user_exports.UserExportGetResponse
+	9,  // 12: user_exports.UserExportsAPI.GetFile:output_type -> google.api.HttpBody
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_user_exports_proto_init() }
+func file_user_exports_proto_init() {
+	if File_user_exports_proto != nil {
+		return
+	}
+	file_user_exports_proto_msgTypes[0].OneofWrappers = []any{}
+	file_user_exports_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_exports_proto_rawDesc), len(file_user_exports_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_exports_proto_goTypes,
+		DependencyIndexes: file_user_exports_proto_depIdxs,
+		MessageInfos:      file_user_exports_proto_msgTypes,
+	}.Build()
+	File_user_exports_proto = out.File
+	file_user_exports_proto_goTypes = nil
+	file_user_exports_proto_depIdxs = nil
+}
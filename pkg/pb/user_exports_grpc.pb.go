@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: user_exports.proto
+
+package pb
+
+import (
+	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UserExportsAPI_ExportUsers_FullMethodName = "/user_exports.UserExportsAPI/ExportUsers"
+	UserExportsAPI_Get_FullMethodName         = "/user_exports.UserExportsAPI/Get"
+	UserExportsAPI_GetFile_FullMethodName     = "/user_exports.UserExportsAPI/GetFile"
+)
+
+// UserExportsAPIClient is the client API for UserExportsAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// UserExportsAPI
+type UserExportsAPIClient interface {
+	// ExportUsers
+	ExportUsers(ctx context.Context, in *ExportUsersRequest, opts ...grpc.CallOption) (*ExportUsersResponse, error)
+	// Get
+	Get(ctx context.Context, in *UserExportGetRequest, opts ...grpc.CallOption) (*UserExportGetResponse, error)
+	// GetFile
+	GetFile(ctx context.Context, in *UserExportGetFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
+}
+
+type userExportsAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserExportsAPIClient(cc grpc.ClientConnInterface) UserExportsAPIClient {
+	return &userExportsAPIClient{cc}
+}
+
+func (c *userExportsAPIClient) ExportUsers(ctx context.Context, in *ExportUsersRequest, opts ...grpc.CallOption) (*ExportUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUsersResponse)
+	err := c.cc.Invoke(ctx, UserExportsAPI_ExportUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExportsAPIClient) Get(ctx context.Context, in *UserExportGetRequest, opts ...grpc.CallOption) (*UserExportGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserExportGetResponse)
+	err := c.cc.Invoke(ctx, UserExportsAPI_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExportsAPIClient) GetFile(ctx context.Context, in *UserExportGetFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserExportsAPI_ServiceDesc.Streams[0], UserExportsAPI_GetFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UserExportGetFileRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserExportsAPI_GetFileClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
+// UserExportsAPIServer is the server API for UserExportsAPI service.
+// All implementations must embed UnimplementedUserExportsAPIServer
+// for forward compatibility.
+//
+// UserExportsAPI
+type UserExportsAPIServer interface {
+	// ExportUsers
+	ExportUsers(context.Context, *ExportUsersRequest) (*ExportUsersResponse, error)
+	// Get
+	Get(context.Context, *UserExportGetRequest) (*UserExportGetResponse, error)
+	// GetFile
+	GetFile(*UserExportGetFileRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
+	mustEmbedUnimplementedUserExportsAPIServer()
+}
+
+// UnimplementedUserExportsAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserExportsAPIServer struct{}
+
+func (UnimplementedUserExportsAPIServer) ExportUsers(context.Context, *ExportUsersRequest) (*ExportUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportUsers not implemented")
+}
+func (UnimplementedUserExportsAPIServer) Get(context.Context, *UserExportGetRequest) (*UserExportGetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedUserExportsAPIServer) GetFile(*UserExportGetFileRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Error(codes.Unimplemented, "method GetFile not implemented")
+}
+func (UnimplementedUserExportsAPIServer) mustEmbedUnimplementedUserExportsAPIServer() {}
+func (UnimplementedUserExportsAPIServer) testEmbeddedByValue()                        {}
+
+// UnsafeUserExportsAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserExportsAPIServer will
+// result in compilation errors.
+type UnsafeUserExportsAPIServer interface {
+	mustEmbedUnimplementedUserExportsAPIServer()
+}
+
+func RegisterUserExportsAPIServer(s grpc.ServiceRegistrar, srv UserExportsAPIServer) {
+	// If the following call panics, it indicates UnimplementedUserExportsAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserExportsAPI_ServiceDesc, srv)
+}
+
+func _UserExportsAPI_ExportUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExportsAPIServer).ExportUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExportsAPI_ExportUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExportsAPIServer).ExportUsers(ctx, req.(*ExportUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExportsAPI_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserExportGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExportsAPIServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExportsAPI_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExportsAPIServer).Get(ctx, req.(*UserExportGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExportsAPI_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UserExportGetFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserExportsAPIServer).GetFile(m, &grpc.GenericServerStream[UserExportGetFileRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserExportsAPI_GetFileServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
+// UserExportsAPI_ServiceDesc is the grpc.ServiceDesc for UserExportsAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserExportsAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user_exports.UserExportsAPI",
+	HandlerType: (*UserExportsAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportUsers",
+			Handler:    _UserExportsAPI_ExportUsers_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _UserExportsAPI_Get_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetFile",
+			Handler:       _UserExportsAPI_GetFile_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "user_exports.proto",
+}
@@ -0,0 +1,509 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: user_imports.proto
+
+package pb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UserImport
+type UserImport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	FilePath      string                 `protobuf:"bytes,3,opt,name=file_path,proto3" json:"file_path,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Processed     int64                  `protobuf:"varint,6,opt,name=processed,proto3" json:"processed,omitempty"`
+	Created       int64                  `protobuf:"varint,7,opt,name=created,proto3" json:"created,omitempty"`
+	Failed        int64                  `protobuf:"varint,8,opt,name=failed,proto3" json:"failed,omitempty"`
+	Error         *string                `protobuf:"bytes,9,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=finished_at,proto3,oneof" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserImport) Reset() {
+	*x = UserImport{}
+	mi := &file_user_imports_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserImport) ProtoMessage() {}
+
+func (x *UserImport) ProtoReflect() protoreflect.Message {
+	mi := &file_user_imports_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserImport.ProtoReflect.Descriptor instead.
+func (*UserImport) Descriptor() ([]byte, []int) {
+	return file_user_imports_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserImport) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserImport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserImport) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *UserImport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UserImport) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *UserImport) GetProcessed() int64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *UserImport) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *UserImport) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *UserImport) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *UserImport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserImport) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *UserImport) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+// UserImportCreateRequest
+type UserImportCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FilePath      string                 `protobuf:"bytes,1,opt,name=file_path,proto3" json:"file_path,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserImportCreateRequest) Reset() {
+	*x = UserImportCreateRequest{}
+	mi := &file_user_imports_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserImportCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserImportCreateRequest) ProtoMessage() {}
+
+func (x *UserImportCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_imports_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserImportCreateRequest.ProtoReflect.Descriptor instead.
+func (*UserImportCreateRequest) Descriptor() ([]byte, []int) {
+	return file_user_imports_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserImportCreateRequest) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *UserImportCreateRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// UserImportCreateResponse
+type UserImportCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Import        *UserImport            `protobuf:"bytes,1,opt,name=import,proto3" json:"import,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserImportCreateResponse) Reset() {
+	*x = UserImportCreateResponse{}
+	mi := &file_user_imports_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserImportCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserImportCreateResponse) ProtoMessage() {}
+
+func (x *UserImportCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_imports_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserImportCreateResponse.ProtoReflect.Descriptor instead.
+func (*UserImportCreateResponse) Descriptor() ([]byte, []int) {
+	return file_user_imports_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UserImportCreateResponse) GetImport() *UserImport {
+	if x != nil {
+		return x.Import
+	}
+	return nil
+}
+
+// UserImportGetRequest
+type UserImportGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImportId      int64                  `protobuf:"varint,1,opt,name=import_id,proto3" json:"import_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserImportGetRequest) Reset() {
+	*x = UserImportGetRequest{}
+	mi := &file_user_imports_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserImportGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserImportGetRequest) ProtoMessage() {}
+
+func (x *UserImportGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_imports_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserImportGetRequest.ProtoReflect.Descriptor instead.
+func (*UserImportGetRequest) Descriptor() ([]byte, []int) {
+	return file_user_imports_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UserImportGetRequest) GetImportId() int64 {
+	if x != nil {
+		return x.ImportId
+	}
+	return 0
+}
+
+// UserImportGetResponse
+type UserImportGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Import        *UserImport            `protobuf:"bytes,1,opt,name=import,proto3" json:"import,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserImportGetResponse) Reset() {
+	*x = UserImportGetResponse{}
+	mi := &file_user_imports_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserImportGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserImportGetResponse) ProtoMessage() {}
+
+func (x *UserImportGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_imports_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserImportGetResponse.ProtoReflect.Descriptor instead.
+func (*UserImportGetResponse) Descriptor() ([]byte, []int) {
+	return file_user_imports_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UserImportGetResponse) GetImport() *UserImport {
+	if x != nil {
+		return x.Import
+	}
+	return nil
+}
+
+// UserImportGetReportRequest
+type UserImportGetReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImportId      int64                  `protobuf:"varint,1,opt,name=import_id,proto3" json:"import_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserImportGetReportRequest) Reset() {
+	*x = UserImportGetReportRequest{}
+	mi := &file_user_imports_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserImportGetReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserImportGetReportRequest) ProtoMessage() {}
+
+func (x *UserImportGetReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_imports_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserImportGetReportRequest.ProtoReflect.Descriptor instead.
+func (*UserImportGetReportRequest) Descriptor() ([]byte, []int) {
+	return file_user_imports_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserImportGetReportRequest) GetImportId() int64 {
+	if x != nil {
+		return x.ImportId
+	}
+	return 0
+}
+
+var File_user_imports_proto protoreflect.FileDescriptor
+
+const file_user_imports_proto_rawDesc = "" +
+	"\n" +
+	"\x12user_imports.proto\x12\fuser_imports\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/api/httpbody.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xc2\x03\n" +
+	"\n" +
+	"UserImport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1c\n" +
+	"\tfile_path\x18\x03 \x01(\tR\tfile_path\x12\x18\n" +
+	"\adry_run\x18\x04 \x01(\bR\adry_run\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\x06 \x01(\x03R\tprocessed\x12\x18\n" +
+	"\acreated\x18\a \x01(\x03R\acreated\x12\x16\n" +
+	"\x06failed\x18\b \x01(\x03R\x06failed\x12\x19\n" +
+	"\x05error\x18\t \x01(\tH\x00R\x05error\x88\x01\x01\x12:\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\x12A\n" +
+	"\vfinished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vfinished_at\x88\x01\x01B\b\n" +
+	"\x06_errorB\x0e\n" +
+	"\f_finished_at\"Z\n" +
+	"\x17UserImportCreateRequest\x12%\n" +
+	"\tfile_path\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tfile_path\x12\x18\n" +
+	"\adry_run\x18\x02 \x01(\bR\adry_run\"L\n" +
+	"\x18UserImportCreateResponse\x120\n" +
+	"\x06import\x18\x01 \x01(\v2\x18.user_imports.UserImportR\x06import\"=\n" +
+	"\x14UserImportGetRequest\x12%\n" +
+	"\timport_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\timport_id\"I\n" +
+	"\x15UserImportGetResponse\x120\n" +
+	"\x06import\x18\x01 \x01(\v2\x18.user_imports.UserImportR\x06import\"C\n" +
+	"\x1aUserImportGetReportRequest\x12%\n" +
+	"\timport_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\timport_id2\xf0\x02\n" +
+	"\x0eUserImportsAPI\x12r\n" +
+	"\x06Create\x12%.user_imports.UserImportCreateRequest\x1a&.user_imports.UserImportCreateResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/users/imports\x12r\n" +
+	"\x03Get\x12\".user_imports.UserImportGetRequest\x1a#.user_imports.UserImportGetResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/users/imports/{import_id}\x12v\n" +
+	"\tGetReport\x12(.user_imports.UserImportGetReportRequest\x1a\x14.google.api.HttpBody\")\x82\xd3\xe4\x93\x02#\x12!/users/imports/{import_id}/reportB\xf8\x01\x92At\x12\x19\n" +
+	"\x10User Imports API2\x051.0.0\"\x04/api2\x10application/json:\x10application/jsonZ\x1f\n" +
+	"\x1d\n" +
+	"\x06x-auth\x12\x13\b\x02\x1a\rauthorization \x02b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06x-auth\x12\x00\n" +
+	"\x10com.user_importsB\x10UserImportsProtoP\x01Z\x0fgreenaid/pkg/pb\xa2\x02\x03UXX\xaa\x02\vUserImports\xca\x02\vUserImports\xe2\x02\x17UserImports\\GPBMetadata\xea\x02\vUserImportsb\x06proto3"
+
+var (
+	file_user_imports_proto_rawDescOnce sync.Once
+	file_user_imports_proto_rawDescData []byte
+)
+
+func file_user_imports_proto_rawDescGZIP() []byte {
+	file_user_imports_proto_rawDescOnce.Do(func() {
+		file_user_imports_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_imports_proto_rawDesc), len(file_user_imports_proto_rawDesc)))
+	})
+	return file_user_imports_proto_rawDescData
+}
+
+var file_user_imports_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_user_imports_proto_goTypes = []any{
+	(*UserImport)(nil),                 // 0: user_imports.UserImport
+	(*UserImportCreateRequest)(nil),    // 1: user_imports.UserImportCreateRequest
+	(*UserImportCreateResponse)(nil),   // 2: user_imports.UserImportCreateResponse
+	(*UserImportGetRequest)(nil),       // 3: user_imports.UserImportGetRequest
+	(*UserImportGetResponse)(nil),      // 4: user_imports.UserImportGetResponse
+	(*UserImportGetReportRequest)(nil), // 5: user_imports.UserImportGetReportRequest
+	(*timestamppb.Timestamp)(nil),      // 6: google.protobuf.Timestamp
+	(*httpbody.HttpBody)(nil),          // 7: google.api.HttpBody
+}
+var file_user_imports_proto_depIdxs = []int32{
+	6, // 0: user_imports.UserImport.created_at:type_name -> google.protobuf.Timestamp
+	6, // 1: user_imports.UserImport.updated_at:type_name -> google.protobuf.Timestamp
+	6, // 2: user_imports.UserImport.finished_at:type_name -> google.protobuf.Timestamp
+	0, // 3: user_imports.UserImportCreateResponse.import:type_name -> user_imports.UserImport
+	0, // 4: user_imports.UserImportGetResponse.import:type_name -> user_imports.UserImport
+	1, // 5: user_imports.UserImportsAPI.Create:input_type -> user_imports.UserImportCreateRequest
+	3, // 6: user_imports.UserImportsAPI.Get:input_type -> user_imports.UserImportGetRequest
+	5, // 7: user_imports.UserImportsAPI.GetReport:input_type -> user_imports.UserImportGetReportRequest
+	2, // 8: user_imports.UserImportsAPI.Create:output_type -> user_imports.UserImportCreateResponse
+	4, // 9: user_imports.UserImportsAPI.Get:output_type -> user_imports.UserImportGetResponse
+	7, // 10: user_imports.UserImportsAPI.GetReport:output_type -> google.api.HttpBody
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_user_imports_proto_init() }
+func file_user_imports_proto_init() {
+	if File_user_imports_proto != nil {
+		return
+	}
+	file_user_imports_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_imports_proto_rawDesc), len(file_user_imports_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_imports_proto_goTypes,
+		DependencyIndexes: file_user_imports_proto_depIdxs,
+		MessageInfos:      file_user_imports_proto_msgTypes,
+	}.Build()
+	File_user_imports_proto = out.File
+	file_user_imports_proto_goTypes = nil
+	file_user_imports_proto_depIdxs = nil
+}
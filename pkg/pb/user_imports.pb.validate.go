@@ -0,0 +1,849 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user_imports.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on UserImport with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserImport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserImport with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserImportMultiError, or
+// nil if none found.
+func (m *UserImport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserImport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for FilePath
+
+	// no validation rules for DryRun
+
+	// no validation rules for Total
+
+	// no validation rules for Processed
+
+	// no validation rules for Created
+
+	// no validation rules for Failed
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserImportValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserImportValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserImportValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserImportValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserImportValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserImportValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Error != nil {
+		// no validation rules for Error
+	}
+
+	if m.FinishedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetFinishedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserImportValidationError{
+						field:  "FinishedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserImportValidationError{
+						field:  "FinishedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserImportValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserImportMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserImportMultiError is an error wrapping multiple validation errors
+// returned by UserImport.ValidateAll() if the designated constraints aren't met.
+type UserImportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserImportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserImportMultiError) AllErrors() []error { return m }
+
+// UserImportValidationError is the validation error returned by
+// UserImport.Validate if the designated constraints aren't met.
+type UserImportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserImportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserImportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserImportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserImportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserImportValidationError) ErrorName() string { return "UserImportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserImportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserImport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserImportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserImportValidationError{}
+
+// Validate checks the field values on UserImportCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserImportCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserImportCreateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserImportCreateRequestMultiError, or nil if none found.
+func (m *UserImportCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserImportCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFilePath()) < 1 {
+		err := UserImportCreateRequestValidationError{
+			field:  "FilePath",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return UserImportCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserImportCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by UserImportCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UserImportCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserImportCreateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserImportCreateRequestMultiError) AllErrors() []error { return m }
+
+// UserImportCreateRequestValidationError is the validation error returned by
+// UserImportCreateRequest.Validate if the designated constraints aren't met.
+type UserImportCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserImportCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserImportCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserImportCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserImportCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserImportCreateRequestValidationError) ErrorName() string {
+	return "UserImportCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserImportCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserImportCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserImportCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserImportCreateRequestValidationError{}
+
+// Validate checks the field values on UserImportCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserImportCreateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserImportCreateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserImportCreateResponseMultiError, or nil if none found.
+func (m *UserImportCreateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserImportCreateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetImport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserImportCreateResponseValidationError{
+					field:  "Import",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserImportCreateResponseValidationError{
+					field:  "Import",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetImport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserImportCreateResponseValidationError{
+				field:  "Import",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserImportCreateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserImportCreateResponseMultiError is an error wrapping multiple validation
+// errors returned by UserImportCreateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UserImportCreateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserImportCreateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserImportCreateResponseMultiError) AllErrors() []error { return m }
+
+// UserImportCreateResponseValidationError is the validation error returned by
+// UserImportCreateResponse.Validate if the designated constraints aren't met.
+type UserImportCreateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserImportCreateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserImportCreateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserImportCreateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserImportCreateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserImportCreateResponseValidationError) ErrorName() string {
+	return "UserImportCreateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserImportCreateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserImportCreateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserImportCreateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserImportCreateResponseValidationError{}
+
+// Validate checks the field values on UserImportGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserImportGetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserImportGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserImportGetRequestMultiError, or nil if none found.
+func (m *UserImportGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserImportGetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetImportId() <= 0 {
+		err := UserImportGetRequestValidationError{
+			field:  "ImportId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserImportGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserImportGetRequestMultiError is an error wrapping multiple validation
+// errors returned by UserImportGetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UserImportGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserImportGetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserImportGetRequestMultiError) AllErrors() []error { return m }
+
+// UserImportGetRequestValidationError is the validation error returned by
+// UserImportGetRequest.Validate if the designated constraints aren't met.
+type UserImportGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserImportGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserImportGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserImportGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserImportGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserImportGetRequestValidationError) ErrorName() string {
+	return "UserImportGetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserImportGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserImportGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserImportGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserImportGetRequestValidationError{}
+
+// Validate checks the field values on UserImportGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserImportGetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserImportGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserImportGetResponseMultiError, or nil if none found.
+func (m *UserImportGetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserImportGetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetImport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserImportGetResponseValidationError{
+					field:  "Import",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserImportGetResponseValidationError{
+					field:  "Import",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetImport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserImportGetResponseValidationError{
+				field:  "Import",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserImportGetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserImportGetResponseMultiError is an error wrapping multiple validation
+// errors returned by UserImportGetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UserImportGetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserImportGetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserImportGetResponseMultiError) AllErrors() []error { return m }
+
+// UserImportGetResponseValidationError is the validation error returned by
+// UserImportGetResponse.Validate if the designated constraints aren't met.
+type UserImportGetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserImportGetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserImportGetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserImportGetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserImportGetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserImportGetResponseValidationError) ErrorName() string {
+	return "UserImportGetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserImportGetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserImportGetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserImportGetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserImportGetResponseValidationError{}
+
+// Validate checks the field values on UserImportGetReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserImportGetReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserImportGetReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserImportGetReportRequestMultiError, or nil if none found.
+func (m *UserImportGetReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserImportGetReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetImportId() <= 0 {
+		err := UserImportGetReportRequestValidationError{
+			field:  "ImportId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserImportGetReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserImportGetReportRequestMultiError is an error wrapping multiple
+// validation errors returned by UserImportGetReportRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UserImportGetReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserImportGetReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserImportGetReportRequestMultiError) AllErrors() []error { return m }
+
+// UserImportGetReportRequestValidationError is the validation error returned
+// by UserImportGetReportRequest.Validate if the designated constraints aren't met.
+type UserImportGetReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserImportGetReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserImportGetReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserImportGetReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserImportGetReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserImportGetReportRequestValidationError) ErrorName() string {
+	return "UserImportGetReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserImportGetReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserImportGetReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserImportGetReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserImportGetReportRequestValidationError{}
@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: user_imports.proto
+
+package pb
+
+import (
+	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UserImportsAPI_Create_FullMethodName    = "/user_imports.UserImportsAPI/Create"
+	UserImportsAPI_Get_FullMethodName       = "/user_imports.UserImportsAPI/Get"
+	UserImportsAPI_GetReport_FullMethodName = "/user_imports.UserImportsAPI/GetReport"
+)
+
+// UserImportsAPIClient is the client API for UserImportsAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// UserImportsAPI
+type UserImportsAPIClient interface {
+	// Create
+	Create(ctx context.Context, in *UserImportCreateRequest, opts ...grpc.CallOption) (*UserImportCreateResponse, error)
+	// Get
+	Get(ctx context.Context, in *UserImportGetRequest, opts ...grpc.CallOption) (*UserImportGetResponse, error)
+	// GetReport
+	GetReport(ctx context.Context, in *UserImportGetReportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+}
+
+type userImportsAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserImportsAPIClient(cc grpc.ClientConnInterface) UserImportsAPIClient {
+	return &userImportsAPIClient{cc}
+}
+
+func (c *userImportsAPIClient) Create(ctx context.Context, in *UserImportCreateRequest, opts ...grpc.CallOption) (*UserImportCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserImportCreateResponse)
+	err := c.cc.Invoke(ctx, UserImportsAPI_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userImportsAPIClient) Get(ctx context.Context, in *UserImportGetRequest, opts ...grpc.CallOption) (*UserImportGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserImportGetResponse)
+	err := c.cc.Invoke(ctx, UserImportsAPI_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userImportsAPIClient) GetReport(ctx context.Context, in *UserImportGetReportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, UserImportsAPI_GetReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserImportsAPIServer is the server API for UserImportsAPI service.
+// All implementations must embed UnimplementedUserImportsAPIServer
+// for forward compatibility.
+//
+// UserImportsAPI
+type UserImportsAPIServer interface {
+	// Create
+	Create(context.Context, *UserImportCreateRequest) (*UserImportCreateResponse, error)
+	// Get
+	Get(context.Context, *UserImportGetRequest) (*UserImportGetResponse, error)
+	// GetReport
+	GetReport(context.Context, *UserImportGetReportRequest) (*httpbody.HttpBody, error)
+	mustEmbedUnimplementedUserImportsAPIServer()
+}
+
+// UnimplementedUserImportsAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserImportsAPIServer struct{}
+
+func (UnimplementedUserImportsAPIServer) Create(context.Context, *UserImportCreateRequest) (*UserImportCreateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedUserImportsAPIServer) Get(context.Context, *UserImportGetRequest) (*UserImportGetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedUserImportsAPIServer) GetReport(context.Context, *UserImportGetReportRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReport not implemented")
+}
+func (UnimplementedUserImportsAPIServer) mustEmbedUnimplementedUserImportsAPIServer() {}
+func (UnimplementedUserImportsAPIServer) testEmbeddedByValue()                        {}
+
+// UnsafeUserImportsAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserImportsAPIServer will
+// result in compilation errors.
+type UnsafeUserImportsAPIServer interface {
+	mustEmbedUnimplementedUserImportsAPIServer()
+}
+
+func RegisterUserImportsAPIServer(s grpc.ServiceRegistrar, srv UserImportsAPIServer) {
+	// If the following call panics, it indicates UnimplementedUserImportsAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserImportsAPI_ServiceDesc, srv)
+}
+
+func _UserImportsAPI_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserImportCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserImportsAPIServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserImportsAPI_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserImportsAPIServer).Create(ctx, req.(*UserImportCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserImportsAPI_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserImportGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserImportsAPIServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserImportsAPI_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserImportsAPIServer).Get(ctx, req.(*UserImportGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserImportsAPI_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserImportGetReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserImportsAPIServer).GetReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserImportsAPI_GetReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserImportsAPIServer).GetReport(ctx, req.(*UserImportGetReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserImportsAPI_ServiceDesc is the grpc.ServiceDesc for UserImportsAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserImportsAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user_imports.UserImportsAPI",
+	HandlerType: (*UserImportsAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _UserImportsAPI_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _UserImportsAPI_Get_Handler,
+		},
+		{
+			MethodName: "GetReport",
+			Handler:    _UserImportsAPI_GetReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user_imports.proto",
+}
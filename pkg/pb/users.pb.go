@@ -13,6 +13,8 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -38,6 +40,8 @@ type User struct {
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
 	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deleted_at,proto3,oneof" json:"deleted_at,omitempty"`
+	Etag          string                 `protobuf:"bytes,10,opt,name=etag,proto3" json:"etag,omitempty"`
+	Attributes    *structpb.Struct       `protobuf:"bytes,11,opt,name=attributes,proto3" json:"attributes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -135,12 +139,28 @@ func (x *User) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *User) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 // UserCreateRequest
 type UserCreateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Email    string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Password string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// Произвольные атрибуты, проверяются по настроенной JSON Schema
+	Attributes    *structpb.Struct `protobuf:"bytes,4,opt,name=attributes,proto3" json:"attributes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -196,6 +216,13 @@ func (x *UserCreateRequest) GetPassword() string {
 	return ""
 }
 
+func (x *UserCreateRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 // UserCreateResponse
 type UserCreateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -243,8 +270,10 @@ func (x *UserCreateResponse) GetUser() *User {
 
 // UserGetRequest
 type UserGetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	// Состояние пользователя на указанный момент
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,proto3,oneof" json:"as_of,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -286,6 +315,13 @@ func (x *UserGetRequest) GetUserId() int64 {
 	return 0
 }
 
+func (x *UserGetRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
 // UserGetResponse
 type UserGetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -331,19 +367,254 @@ func (x *UserGetResponse) GetUser() *User {
 	return nil
 }
 
-// UserUpdateRequest
-type UserUpdateRequest struct {
+// UserGetHistoryRequest
+type UserGetHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Password      *string                `protobuf:"bytes,3,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserGetHistoryRequest) Reset() {
+	*x = UserGetHistoryRequest{}
+	mi := &file_users_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetHistoryRequest) ProtoMessage() {}
+
+func (x *UserGetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*UserGetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserGetHistoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// UserGetHistoryResponse
+type UserGetHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*UserHistoryEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserGetHistoryResponse) Reset() {
+	*x = UserGetHistoryResponse{}
+	mi := &file_users_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetHistoryResponse) ProtoMessage() {}
+
+func (x *UserGetHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetHistoryResponse.ProtoReflect.Descriptor instead.
+func (*UserGetHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserGetHistoryResponse) GetEntries() []*UserHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// UserHistoryEntry
+type UserHistoryEntry struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Version int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// create, update или delete
+	Operation     string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	ChangedBy     *int64                 `protobuf:"varint,3,opt,name=changed_by,proto3,oneof" json:"changed_by,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=changed_at,proto3" json:"changed_at,omitempty"`
+	Changes       []*UserFieldChange     `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserHistoryEntry) Reset() {
+	*x = UserHistoryEntry{}
+	mi := &file_users_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserHistoryEntry) ProtoMessage() {}
+
+func (x *UserHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserHistoryEntry.ProtoReflect.Descriptor instead.
+func (*UserHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserHistoryEntry) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UserHistoryEntry) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *UserHistoryEntry) GetChangedBy() int64 {
+	if x != nil && x.ChangedBy != nil {
+		return *x.ChangedBy
+	}
+	return 0
+}
+
+func (x *UserHistoryEntry) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *UserHistoryEntry) GetChanges() []*UserFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// UserFieldChange
+type UserFieldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Field string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Значения пароля не раскрываются
+	OldValue      *structpb.Value `protobuf:"bytes,2,opt,name=old_value,proto3" json:"old_value,omitempty"`
+	NewValue      *structpb.Value `protobuf:"bytes,3,opt,name=new_value,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFieldChange) Reset() {
+	*x = UserFieldChange{}
+	mi := &file_users_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFieldChange) ProtoMessage() {}
+
+func (x *UserFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFieldChange.ProtoReflect.Descriptor instead.
+func (*UserFieldChange) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UserFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *UserFieldChange) GetOldValue() *structpb.Value {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+func (x *UserFieldChange) GetNewValue() *structpb.Value {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
+// UserUpdateRequest
+type UserUpdateRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   int64                  `protobuf:"varint,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Name     *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Password *string                `protobuf:"bytes,3,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	Etag     *string                `protobuf:"bytes,4,opt,name=etag,proto3,oneof" json:"etag,omitempty"`
+	// Поля для обновления: name, password, attributes. Если не указана, обновляются переданные поля
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,proto3" json:"update_mask,omitempty"`
+	// Атрибуты заменяются целиком и проверяются по настроенной JSON Schema
+	Attributes    *structpb.Struct `protobuf:"bytes,6,opt,name=attributes,proto3" json:"attributes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserUpdateRequest) Reset() {
 	*x = UserUpdateRequest{}
-	mi := &file_users_proto_msgTypes[5]
+	mi := &file_users_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -355,7 +626,7 @@ func (x *UserUpdateRequest) String() string {
 func (*UserUpdateRequest) ProtoMessage() {}
 
 func (x *UserUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[5]
+	mi := &file_users_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -368,7 +639,7 @@ func (x *UserUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserUpdateRequest.ProtoReflect.Descriptor instead.
 func (*UserUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{5}
+	return file_users_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UserUpdateRequest) GetUserId() int64 {
@@ -392,6 +663,27 @@ func (x *UserUpdateRequest) GetPassword() string {
 	return ""
 }
 
+func (x *UserUpdateRequest) GetEtag() string {
+	if x != nil && x.Etag != nil {
+		return *x.Etag
+	}
+	return ""
+}
+
+func (x *UserUpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UserUpdateRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 // UserUpdateResponse
 type UserUpdateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -402,7 +694,7 @@ type UserUpdateResponse struct {
 
 func (x *UserUpdateResponse) Reset() {
 	*x = UserUpdateResponse{}
-	mi := &file_users_proto_msgTypes[6]
+	mi := &file_users_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -414,7 +706,7 @@ func (x *UserUpdateResponse) String() string {
 func (*UserUpdateResponse) ProtoMessage() {}
 
 func (x *UserUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[6]
+	mi := &file_users_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,7 +719,7 @@ func (x *UserUpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserUpdateResponse.ProtoReflect.Descriptor instead.
 func (*UserUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{6}
+	return file_users_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UserUpdateResponse) GetUser() *User {
@@ -441,13 +733,14 @@ func (x *UserUpdateResponse) GetUser() *User {
 type UserDeleteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Etag          *string                `protobuf:"bytes,2,opt,name=etag,proto3,oneof" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserDeleteRequest) Reset() {
 	*x = UserDeleteRequest{}
-	mi := &file_users_proto_msgTypes[7]
+	mi := &file_users_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -459,7 +752,7 @@ func (x *UserDeleteRequest) String() string {
 func (*UserDeleteRequest) ProtoMessage() {}
 
 func (x *UserDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[7]
+	mi := &file_users_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +765,7 @@ func (x *UserDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserDeleteRequest.ProtoReflect.Descriptor instead.
 func (*UserDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{7}
+	return file_users_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UserDeleteRequest) GetUserId() int64 {
@@ -482,11 +775,405 @@ func (x *UserDeleteRequest) GetUserId() int64 {
 	return 0
 }
 
+func (x *UserDeleteRequest) GetEtag() string {
+	if x != nil && x.Etag != nil {
+		return *x.Etag
+	}
+	return ""
+}
+
+// UserEmailChange
+type UserEmailChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	NewEmail      string                 `protobuf:"bytes,3,opt,name=new_email,proto3" json:"new_email,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,proto3" json:"expires_at,omitempty"`
+	UndoExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=undo_expires_at,proto3" json:"undo_expires_at,omitempty"`
+	ConfirmedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=confirmed_at,proto3,oneof" json:"confirmed_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserEmailChange) Reset() {
+	*x = UserEmailChange{}
+	mi := &file_users_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserEmailChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEmailChange) ProtoMessage() {}
+
+func (x *UserEmailChange) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEmailChange.ProtoReflect.Descriptor instead.
+func (*UserEmailChange) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UserEmailChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserEmailChange) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserEmailChange) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *UserEmailChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserEmailChange) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *UserEmailChange) GetUndoExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UndoExpiresAt
+	}
+	return nil
+}
+
+func (x *UserEmailChange) GetConfirmedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfirmedAt
+	}
+	return nil
+}
+
+func (x *UserEmailChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// UserChangeEmailRequest
+type UserChangeEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Etag          *string                `protobuf:"bytes,3,opt,name=etag,proto3,oneof" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserChangeEmailRequest) Reset() {
+	*x = UserChangeEmailRequest{}
+	mi := &file_users_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserChangeEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserChangeEmailRequest) ProtoMessage() {}
+
+func (x *UserChangeEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserChangeEmailRequest.ProtoReflect.Descriptor instead.
+func (*UserChangeEmailRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UserChangeEmailRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserChangeEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserChangeEmailRequest) GetEtag() string {
+	if x != nil && x.Etag != nil {
+		return *x.Etag
+	}
+	return ""
+}
+
+// UserChangeEmailResponse
+type UserChangeEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *UserEmailChange       `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserChangeEmailResponse) Reset() {
+	*x = UserChangeEmailResponse{}
+	mi := &file_users_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserChangeEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserChangeEmailResponse) ProtoMessage() {}
+
+func (x *UserChangeEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserChangeEmailResponse.ProtoReflect.Descriptor instead.
+func (*UserChangeEmailResponse) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UserChangeEmailResponse) GetChange() *UserEmailChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// UserConfirmEmailChangeRequest
+type UserConfirmEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserConfirmEmailChangeRequest) Reset() {
+	*x = UserConfirmEmailChangeRequest{}
+	mi := &file_users_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserConfirmEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserConfirmEmailChangeRequest) ProtoMessage() {}
+
+func (x *UserConfirmEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserConfirmEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*UserConfirmEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UserConfirmEmailChangeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// UserConfirmEmailChangeResponse
+type UserConfirmEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserConfirmEmailChangeResponse) Reset() {
+	*x = UserConfirmEmailChangeResponse{}
+	mi := &file_users_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserConfirmEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserConfirmEmailChangeResponse) ProtoMessage() {}
+
+func (x *UserConfirmEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserConfirmEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*UserConfirmEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UserConfirmEmailChangeResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// UserUndoEmailChangeRequest
+type UserUndoEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserUndoEmailChangeRequest) Reset() {
+	*x = UserUndoEmailChangeRequest{}
+	mi := &file_users_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserUndoEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUndoEmailChangeRequest) ProtoMessage() {}
+
+func (x *UserUndoEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUndoEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*UserUndoEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UserUndoEmailChangeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// UserUndoEmailChangeResponse
+type UserUndoEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserUndoEmailChangeResponse) Reset() {
+	*x = UserUndoEmailChangeResponse{}
+	mi := &file_users_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserUndoEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUndoEmailChangeResponse) ProtoMessage() {}
+
+func (x *UserUndoEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUndoEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*UserUndoEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UserUndoEmailChangeResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
 var File_users_proto protoreflect.FileDescriptor
 
 const file_users_proto_rawDesc = "" +
 	"\n" +
-	"\vusers.proto\x12\x05users\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xd2\x02\n" +
+	"\vusers.proto\x12\x05users\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9f\x03\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -502,33 +1189,104 @@ const file_users_proto_rawDesc = "" +
 	"updated_at\x12?\n" +
 	"\n" +
 	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
-	"deleted_at\x88\x01\x01B\r\n" +
-	"\v_deleted_at\"Y\n" +
+	"deleted_at\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\n" +
+	" \x01(\tR\x04etag\x127\n" +
+	"\n" +
+	"attributes\x18\v \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributesB\r\n" +
+	"\v_deleted_at\"\x92\x01\n" +
 	"\x11UserCreateRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"5\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x127\n" +
+	"\n" +
+	"attributes\x18\x04 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\"5\n" +
 	"\x12UserCreateResponse\x12\x1f\n" +
-	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\"*\n" +
+	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\"k\n" +
 	"\x0eUserGetRequest\x12\x18\n" +
-	"\auser_id\x18\x01 \x01(\x03R\auser_id\"2\n" +
+	"\auser_id\x18\x01 \x01(\x03R\auser_id\x125\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x05as_of\x88\x01\x01B\b\n" +
+	"\x06_as_of\"2\n" +
 	"\x0fUserGetResponse\x12\x1f\n" +
-	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\"}\n" +
+	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\"1\n" +
+	"\x15UserGetHistoryRequest\x12\x18\n" +
+	"\auser_id\x18\x01 \x01(\x03R\auser_id\"K\n" +
+	"\x16UserGetHistoryResponse\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.users.UserHistoryEntryR\aentries\"\xec\x01\n" +
+	"\x10UserHistoryEntry\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12#\n" +
+	"\n" +
+	"changed_by\x18\x03 \x01(\x03H\x00R\n" +
+	"changed_by\x88\x01\x01\x12:\n" +
+	"\n" +
+	"changed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"changed_at\x120\n" +
+	"\achanges\x18\x05 \x03(\v2\x16.users.UserFieldChangeR\achangesB\r\n" +
+	"\v_changed_by\"\x93\x01\n" +
+	"\x0fUserFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x124\n" +
+	"\told_value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\told_value\x124\n" +
+	"\tnew_value\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\tnew_value\"\x96\x02\n" +
 	"\x11UserUpdateRequest\x12\x18\n" +
 	"\auser_id\x18\x01 \x01(\x03R\auser_id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1f\n" +
-	"\bpassword\x18\x03 \x01(\tH\x01R\bpassword\x88\x01\x01B\a\n" +
+	"\bpassword\x18\x03 \x01(\tH\x01R\bpassword\x88\x01\x01\x12\x17\n" +
+	"\x04etag\x18\x04 \x01(\tH\x02R\x04etag\x88\x01\x01\x12<\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\vupdate_mask\x127\n" +
+	"\n" +
+	"attributes\x18\x06 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributesB\a\n" +
 	"\x05_nameB\v\n" +
-	"\t_password\"5\n" +
+	"\t_passwordB\a\n" +
+	"\x05_etag\"5\n" +
 	"\x12UserUpdateResponse\x12\x1f\n" +
-	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\"-\n" +
+	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\"O\n" +
 	"\x11UserDeleteRequest\x12\x18\n" +
-	"\auser_id\x18\x01 \x01(\x03R\auser_id2\xde\x02\n" +
+	"\auser_id\x18\x01 \x01(\x03R\auser_id\x12\x17\n" +
+	"\x04etag\x18\x02 \x01(\tH\x00R\x04etag\x88\x01\x01B\a\n" +
+	"\x05_etag\"\x85\x03\n" +
+	"\x0fUserEmailChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\auser_id\x18\x02 \x01(\x03R\auser_id\x12\x1c\n" +
+	"\tnew_email\x18\x03 \x01(\tR\tnew_email\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12:\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expires_at\x12D\n" +
+	"\x0fundo_expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0fundo_expires_at\x12C\n" +
+	"\fconfirmed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\fconfirmed_at\x88\x01\x01\x12:\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_atB\x0f\n" +
+	"\r_confirmed_at\"s\n" +
+	"\x16UserChangeEmailRequest\x12\x18\n" +
+	"\auser_id\x18\x01 \x01(\x03R\auser_id\x12\x1d\n" +
+	"\x05email\x18\x02 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12\x17\n" +
+	"\x04etag\x18\x03 \x01(\tH\x00R\x04etag\x88\x01\x01B\a\n" +
+	"\x05_etag\"I\n" +
+	"\x17UserChangeEmailResponse\x12.\n" +
+	"\x06change\x18\x01 \x01(\v2\x16.users.UserEmailChangeR\x06change\">\n" +
+	"\x1dUserConfirmEmailChangeRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\"A\n" +
+	"\x1eUserConfirmEmailChangeResponse\x12\x1f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\";\n" +
+	"\x1aUserUndoEmailChangeRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\">\n" +
+	"\x1bUserUndoEmailChangeResponse\x12\x1f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user2\xe7\x06\n" +
 	"\bUsersAPI\x12P\n" +
 	"\x06Create\x12\x18.users.UserCreateRequest\x1a\x19.users.UserCreateResponse\"\x11\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/users\x12N\n" +
-	"\x03Get\x12\x15.users.UserGetRequest\x1a\x16.users.UserGetResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/users/{user_id}\x12Z\n" +
+	"\x03Get\x12\x15.users.UserGetRequest\x1a\x16.users.UserGetResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/users/{user_id}\x12k\n" +
+	"\n" +
+	"GetHistory\x12\x1c.users.UserGetHistoryRequest\x1a\x1d.users.UserGetHistoryResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/users/{user_id}/history\x12Z\n" +
 	"\x06Update\x12\x18.users.UserUpdateRequest\x1a\x19.users.UserUpdateResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/users/{user_id}\x12T\n" +
-	"\x06Delete\x12\x18.users.UserDeleteRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/users/{user_id}B\xcc\x01\x92Am\x12\x12\n" +
+	"\x06Delete\x12\x18.users.UserDeleteRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/users/{user_id}\x12o\n" +
+	"\vChangeEmail\x12\x1d.users.UserChangeEmailRequest\x1a\x1e.users.UserChangeEmailResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/users/{user_id}/email\x12\x9a\x01\n" +
+	"\x12ConfirmEmailChange\x12$.users.UserConfirmEmailChangeRequest\x1a%.users.UserConfirmEmailChangeResponse\"7\x82\xd3\xe4\x93\x021Z\x19:\x01*\"\x14/users/email/confirm\x12\x14/users/email/confirm\x12\x8b\x01\n" +
+	"\x0fUndoEmailChange\x12!.users.UserUndoEmailChangeRequest\x1a\".users.UserUndoEmailChangeResponse\"1\x82\xd3\xe4\x93\x02+Z\x16:\x01*\"\x11/users/email/undo\x12\x11/users/email/undoB\xcc\x01\x92Am\x12\x12\n" +
 	"\tUsers API2\x051.0.0\"\x04/api2\x10application/json:\x10application/jsonZ\x1f\n" +
 	"\x1d\n" +
 	"\x06x-auth\x12\x13\b\x02\x1a\rauthorization \x02b\f\n" +
@@ -550,39 +1308,78 @@ func file_users_proto_rawDescGZIP() []byte {
 	return file_users_proto_rawDescData
 }
 
-var file_users_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_users_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_users_proto_goTypes = []any{
-	(*User)(nil),                  // 0: users.User
-	(*UserCreateRequest)(nil),     // 1: users.UserCreateRequest
-	(*UserCreateResponse)(nil),    // 2: users.UserCreateResponse
-	(*UserGetRequest)(nil),        // 3: users.UserGetRequest
-	(*UserGetResponse)(nil),       // 4: users.UserGetResponse
-	(*UserUpdateRequest)(nil),     // 5: users.UserUpdateRequest
-	(*UserUpdateResponse)(nil),    // 6: users.UserUpdateResponse
-	(*UserDeleteRequest)(nil),     // 7: users.UserDeleteRequest
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
+	(*User)(nil),                           // 0: users.User
+	(*UserCreateRequest)(nil),              // 1: users.UserCreateRequest
+	(*UserCreateResponse)(nil),             // 2: users.UserCreateResponse
+	(*UserGetRequest)(nil),                 // 3: users.UserGetRequest
+	(*UserGetResponse)(nil),                // 4: users.UserGetResponse
+	(*UserGetHistoryRequest)(nil),          // 5: users.UserGetHistoryRequest
+	(*UserGetHistoryResponse)(nil),         // 6: users.UserGetHistoryResponse
+	(*UserHistoryEntry)(nil),               // 7: users.UserHistoryEntry
+	(*UserFieldChange)(nil),                // 8: users.UserFieldChange
+	(*UserUpdateRequest)(nil),              // 9: users.UserUpdateRequest
+	(*UserUpdateResponse)(nil),             // 10: users.UserUpdateResponse
+	(*UserDeleteRequest)(nil),              // 11: users.UserDeleteRequest
+	(*UserEmailChange)(nil),                // 12: users.UserEmailChange
+	(*UserChangeEmailRequest)(nil),         // 13: users.UserChangeEmailRequest
+	(*UserChangeEmailResponse)(nil),        // 14: users.UserChangeEmailResponse
+	(*UserConfirmEmailChangeRequest)(nil),  // 15: users.UserConfirmEmailChangeRequest
+	(*UserConfirmEmailChangeResponse)(nil), // 16: users.UserConfirmEmailChangeResponse
+	(*UserUndoEmailChangeRequest)(nil),     // 17: users.UserUndoEmailChangeRequest
+	(*UserUndoEmailChangeResponse)(nil),    // 18: users.UserUndoEmailChangeResponse
+	(*timestamppb.Timestamp)(nil),          // 19: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                // 20: google.protobuf.Struct
+	(*structpb.Value)(nil),                 // 21: google.protobuf.Value
+	(*fieldmaskpb.FieldMask)(nil),          // 22: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                  // 23: google.protobuf.Empty
 }
 var file_users_proto_depIdxs = []int32{
-	8,  // 0: users.User.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 1: users.User.updated_at:type_name -> google.protobuf.Timestamp
-	8,  // 2: users.User.deleted_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: users.UserCreateResponse.user:type_name -> users.User
-	0,  // 4: users.UserGetResponse.user:type_name -> users.User
-	0,  // 5: users.UserUpdateResponse.user:type_name -> users.User
-	1,  // 6: users.UsersAPI.Create:input_type -> users.UserCreateRequest
-	3,  // 7: users.UsersAPI.Get:input_type -> users.UserGetRequest
-	5,  // 8: users.UsersAPI.Update:input_type -> users.UserUpdateRequest
-	7,  // 9: users.UsersAPI.Delete:input_type -> users.UserDeleteRequest
-	2,  // 10: users.UsersAPI.Create:output_type -> users.UserCreateResponse
-	4,  // 11: users.UsersAPI.Get:output_type -> users.UserGetResponse
-	6,  // 12: users.UsersAPI.Update:output_type -> users.UserUpdateResponse
-	9,  // 13: users.UsersAPI.Delete:output_type -> google.protobuf.Empty
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	19, // 0: users.User.created_at:type_name -> google.protobuf.Timestamp
+	19, // 1: users.User.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 2: users.User.deleted_at:type_name -> google.protobuf.Timestamp
+	20, // 3: users.User.attributes:type_name -> google.protobuf.Struct
+	20, // 4: users.UserCreateRequest.attributes:type_name -> google.protobuf.Struct
+	0,  // 5: users.UserCreateResponse.user:type_name -> users.User
+	19, // 6: users.UserGetRequest.as_of:type_name -> google.protobuf.Timestamp
+	0,  // 7: users.UserGetResponse.user:type_name -> users.User
+	7,  // 8: users.UserGetHistoryResponse.entries:type_name -> users.UserHistoryEntry
+	19, // 9: users.UserHistoryEntry.changed_at:type_name -> google.protobuf.Timestamp
+	8,  // 10: users.UserHistoryEntry.changes:type_name -> users.UserFieldChange
+	21, // 11: users.UserFieldChange.old_value:type_name -> google.protobuf.Value
+	21, // 12: users.UserFieldChange.new_value:type_name -> google.protobuf.Value
+	22, // 13: users.UserUpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	20, // 14: users.UserUpdateRequest.attributes:type_name -> google.protobuf.Struct
+	0,  // 15: users.UserUpdateResponse.user:type_name -> users.User
+	19, // 16: users.UserEmailChange.expires_at:type_name -> google.protobuf.Timestamp
+	19, // 17: users.UserEmailChange.undo_expires_at:type_name -> google.protobuf.Timestamp
+	19, // 18: users.UserEmailChange.confirmed_at:type_name -> google.protobuf.Timestamp
+	19, // 19: users.UserEmailChange.created_at:type_name -> google.protobuf.Timestamp
+	12, // 20: users.UserChangeEmailResponse.change:type_name -> users.UserEmailChange
+	0,  // 21: users.UserConfirmEmailChangeResponse.user:type_name -> users.User
+	0,  // 22: users.UserUndoEmailChangeResponse.user:type_name -> users.User
+	1,  // 23: users.UsersAPI.Create:input_type -> users.UserCreateRequest
+	3,  // 24: users.UsersAPI.Get:input_type -> users.UserGetRequest
+	5,  // 25: users.UsersAPI.GetHistory:input_type -> users.UserGetHistoryRequest
+	9,  // 26: users.UsersAPI.Update:input_type -> users.UserUpdateRequest
+	11, // 27: users.UsersAPI.Delete:input_type -> users.UserDeleteRequest
+	13, // 28: users.UsersAPI.ChangeEmail:input_type -> users.UserChangeEmailRequest
+	15, // 29: users.UsersAPI.ConfirmEmailChange:input_type -> users.UserConfirmEmailChangeRequest
+	17, // 30: users.UsersAPI.UndoEmailChange:input_type -> users.UserUndoEmailChangeRequest
+	2,  // 31: users.UsersAPI.Create:output_type -> users.UserCreateResponse
+	4,  // 32: users.UsersAPI.Get:output_type -> users.UserGetResponse
+	6,  // 33: users.UsersAPI.GetHistory:output_type -> users.UserGetHistoryResponse
+	10, // 34: users.UsersAPI.Update:output_type -> users.UserUpdateResponse
+	23, // 35: users.UsersAPI.Delete:output_type -> google.protobuf.Empty
+	14, // 36: users.UsersAPI.ChangeEmail:output_type -> users.UserChangeEmailResponse
+	16, // 37: users.UsersAPI.ConfirmEmailChange:output_type -> users.UserConfirmEmailChangeResponse
+	18, // 38: users.UsersAPI.UndoEmailChange:output_type -> users.UserUndoEmailChangeResponse
+	31, // [31:39] is the sub-list for method output_type
+	23, // [23:31] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_users_proto_init() }
@@ -591,14 +1388,19 @@ func file_users_proto_init() {
 		return
 	}
 	file_users_proto_msgTypes[0].OneofWrappers = []any{}
-	file_users_proto_msgTypes[5].OneofWrappers = []any{}
+	file_users_proto_msgTypes[3].OneofWrappers = []any{}
+	file_users_proto_msgTypes[7].OneofWrappers = []any{}
+	file_users_proto_msgTypes[9].OneofWrappers = []any{}
+	file_users_proto_msgTypes[11].OneofWrappers = []any{}
+	file_users_proto_msgTypes[12].OneofWrappers = []any{}
+	file_users_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_users_proto_rawDesc), len(file_users_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -126,6 +126,37 @@ func (m *User) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.DeletedAt != nil {
 
 		if all {
@@ -264,6 +295,35 @@ func (m *UserCreateRequest) validate(all bool) error {
 
 	// no validation rules for Password
 
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserCreateRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserCreateRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserCreateRequestValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return UserCreateRequestMultiError(errors)
 	}
@@ -499,6 +559,39 @@ func (m *UserGetRequest) validate(all bool) error {
 
 	// no validation rules for UserId
 
+	if m.AsOf != nil {
+
+		if all {
+			switch v := interface{}(m.GetAsOf()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserGetRequestValidationError{
+						field:  "AsOf",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserGetRequestValidationError{
+						field:  "AsOf",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAsOf()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserGetRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return UserGetRequestMultiError(errors)
 	}
@@ -706,22 +799,22 @@ var _ interface {
 	ErrorName() string
 } = UserGetResponseValidationError{}
 
-// Validate checks the field values on UserUpdateRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UserUpdateRequest) Validate() error {
+// Validate checks the field values on UserGetHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserGetHistoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserUpdateRequest with the rules
+// ValidateAll checks the field values on UserGetHistoryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserUpdateRequestMultiError, or nil if none found.
-func (m *UserUpdateRequest) ValidateAll() error {
+// UserGetHistoryRequestMultiError, or nil if none found.
+func (m *UserGetHistoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserUpdateRequest) validate(all bool) error {
+func (m *UserGetHistoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -730,28 +823,20 @@ func (m *UserUpdateRequest) validate(all bool) error {
 
 	// no validation rules for UserId
 
-	if m.Name != nil {
-		// no validation rules for Name
-	}
-
-	if m.Password != nil {
-		// no validation rules for Password
-	}
-
 	if len(errors) > 0 {
-		return UserUpdateRequestMultiError(errors)
+		return UserGetHistoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserUpdateRequestMultiError is an error wrapping multiple validation errors
-// returned by UserUpdateRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UserUpdateRequestMultiError []error
+// UserGetHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by UserGetHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UserGetHistoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserUpdateRequestMultiError) Error() string {
+func (m UserGetHistoryRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -760,11 +845,11 @@ func (m UserUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserUpdateRequestMultiError) AllErrors() []error { return m }
+func (m UserGetHistoryRequestMultiError) AllErrors() []error { return m }
 
-// UserUpdateRequestValidationError is the validation error returned by
-// UserUpdateRequest.Validate if the designated constraints aren't met.
-type UserUpdateRequestValidationError struct {
+// UserGetHistoryRequestValidationError is the validation error returned by
+// UserGetHistoryRequest.Validate if the designated constraints aren't met.
+type UserGetHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -772,24 +857,24 @@ type UserUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserUpdateRequestValidationError) Field() string { return e.field }
+func (e UserGetHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserUpdateRequestValidationError) Reason() string { return e.reason }
+func (e UserGetHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserUpdateRequestValidationError) Cause() error { return e.cause }
+func (e UserGetHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserUpdateRequestValidationError) Key() bool { return e.key }
+func (e UserGetHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserUpdateRequestValidationError) ErrorName() string {
-	return "UserUpdateRequestValidationError"
+func (e UserGetHistoryRequestValidationError) ErrorName() string {
+	return "UserGetHistoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserUpdateRequestValidationError) Error() string {
+func (e UserGetHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -801,14 +886,14 @@ func (e UserUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserUpdateRequest.%s: %s%s",
+		"invalid %sUserGetHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserUpdateRequestValidationError{}
+var _ error = UserGetHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -816,73 +901,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserUpdateRequestValidationError{}
+} = UserGetHistoryRequestValidationError{}
 
-// Validate checks the field values on UserUpdateResponse with the rules
+// Validate checks the field values on UserGetHistoryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserUpdateResponse) Validate() error {
+func (m *UserGetHistoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserUpdateResponse with the rules
+// ValidateAll checks the field values on UserGetHistoryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserUpdateResponseMultiError, or nil if none found.
-func (m *UserUpdateResponse) ValidateAll() error {
+// UserGetHistoryResponseMultiError, or nil if none found.
+func (m *UserGetHistoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserUpdateResponse) validate(all bool) error {
+func (m *UserGetHistoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserUpdateResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserGetHistoryResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserGetHistoryResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UserUpdateResponseValidationError{
-					field:  "User",
+				return UserGetHistoryResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UserUpdateResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UserUpdateResponseMultiError(errors)
+		return UserGetHistoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserUpdateResponseMultiError is an error wrapping multiple validation errors
-// returned by UserUpdateResponse.ValidateAll() if the designated constraints
-// aren't met.
-type UserUpdateResponseMultiError []error
+// UserGetHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by UserGetHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UserGetHistoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserUpdateResponseMultiError) Error() string {
+func (m UserGetHistoryResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -891,11 +981,11 @@ func (m UserUpdateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserUpdateResponseMultiError) AllErrors() []error { return m }
+func (m UserGetHistoryResponseMultiError) AllErrors() []error { return m }
 
-// UserUpdateResponseValidationError is the validation error returned by
-// UserUpdateResponse.Validate if the designated constraints aren't met.
-type UserUpdateResponseValidationError struct {
+// UserGetHistoryResponseValidationError is the validation error returned by
+// UserGetHistoryResponse.Validate if the designated constraints aren't met.
+type UserGetHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -903,24 +993,24 @@ type UserUpdateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserUpdateResponseValidationError) Field() string { return e.field }
+func (e UserGetHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserUpdateResponseValidationError) Reason() string { return e.reason }
+func (e UserGetHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserUpdateResponseValidationError) Cause() error { return e.cause }
+func (e UserGetHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserUpdateResponseValidationError) Key() bool { return e.key }
+func (e UserGetHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserUpdateResponseValidationError) ErrorName() string {
-	return "UserUpdateResponseValidationError"
+func (e UserGetHistoryResponseValidationError) ErrorName() string {
+	return "UserGetHistoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserUpdateResponseValidationError) Error() string {
+func (e UserGetHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -932,14 +1022,14 @@ func (e UserUpdateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserUpdateResponse.%s: %s%s",
+		"invalid %sUserGetHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserUpdateResponseValidationError{}
+var _ error = UserGetHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -947,46 +1037,115 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserUpdateResponseValidationError{}
+} = UserGetHistoryResponseValidationError{}
 
-// Validate checks the field values on UserDeleteRequest with the rules defined
+// Validate checks the field values on UserHistoryEntry with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *UserDeleteRequest) Validate() error {
+func (m *UserHistoryEntry) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserDeleteRequest with the rules
+// ValidateAll checks the field values on UserHistoryEntry with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserDeleteRequestMultiError, or nil if none found.
-func (m *UserDeleteRequest) ValidateAll() error {
+// UserHistoryEntryMultiError, or nil if none found.
+func (m *UserHistoryEntry) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserDeleteRequest) validate(all bool) error {
+func (m *UserHistoryEntry) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Version
+
+	// no validation rules for Operation
+
+	if all {
+		switch v := interface{}(m.GetChangedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserHistoryEntryValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserHistoryEntryValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserHistoryEntryValidationError{
+				field:  "ChangedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserHistoryEntryValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserHistoryEntryValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserHistoryEntryValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ChangedBy != nil {
+		// no validation rules for ChangedBy
+	}
 
 	if len(errors) > 0 {
-		return UserDeleteRequestMultiError(errors)
+		return UserHistoryEntryMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserDeleteRequestMultiError is an error wrapping multiple validation errors
-// returned by UserDeleteRequest.ValidateAll() if the designated constraints
+// UserHistoryEntryMultiError is an error wrapping multiple validation errors
+// returned by UserHistoryEntry.ValidateAll() if the designated constraints
 // aren't met.
-type UserDeleteRequestMultiError []error
+type UserHistoryEntryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserDeleteRequestMultiError) Error() string {
+func (m UserHistoryEntryMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -995,11 +1154,11 @@ func (m UserDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserDeleteRequestMultiError) AllErrors() []error { return m }
+func (m UserHistoryEntryMultiError) AllErrors() []error { return m }
 
-// UserDeleteRequestValidationError is the validation error returned by
-// UserDeleteRequest.Validate if the designated constraints aren't met.
-type UserDeleteRequestValidationError struct {
+// UserHistoryEntryValidationError is the validation error returned by
+// UserHistoryEntry.Validate if the designated constraints aren't met.
+type UserHistoryEntryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1007,24 +1166,22 @@ type UserDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserDeleteRequestValidationError) Field() string { return e.field }
+func (e UserHistoryEntryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserDeleteRequestValidationError) Reason() string { return e.reason }
+func (e UserHistoryEntryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserDeleteRequestValidationError) Cause() error { return e.cause }
+func (e UserHistoryEntryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserDeleteRequestValidationError) Key() bool { return e.key }
+func (e UserHistoryEntryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserDeleteRequestValidationError) ErrorName() string {
-	return "UserDeleteRequestValidationError"
-}
+func (e UserHistoryEntryValidationError) ErrorName() string { return "UserHistoryEntryValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserDeleteRequestValidationError) Error() string {
+func (e UserHistoryEntryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1036,14 +1193,14 @@ func (e UserDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserDeleteRequest.%s: %s%s",
+		"invalid %sUserHistoryEntry.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserDeleteRequestValidationError{}
+var _ error = UserHistoryEntryValidationError{}
 
 var _ interface {
 	Field() string
@@ -1051,4 +1208,1597 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserDeleteRequestValidationError{}
+} = UserHistoryEntryValidationError{}
+
+// Validate checks the field values on UserFieldChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserFieldChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserFieldChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserFieldChangeMultiError, or nil if none found.
+func (m *UserFieldChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserFieldChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	if all {
+		switch v := interface{}(m.GetOldValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserFieldChangeValidationError{
+					field:  "OldValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserFieldChangeValidationError{
+					field:  "OldValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOldValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserFieldChangeValidationError{
+				field:  "OldValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNewValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserFieldChangeValidationError{
+					field:  "NewValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserFieldChangeValidationError{
+					field:  "NewValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNewValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserFieldChangeValidationError{
+				field:  "NewValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserFieldChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserFieldChangeMultiError is an error wrapping multiple validation errors
+// returned by UserFieldChange.ValidateAll() if the designated constraints
+// aren't met.
+type UserFieldChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserFieldChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserFieldChangeMultiError) AllErrors() []error { return m }
+
+// UserFieldChangeValidationError is the validation error returned by
+// UserFieldChange.Validate if the designated constraints aren't met.
+type UserFieldChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserFieldChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserFieldChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserFieldChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserFieldChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserFieldChangeValidationError) ErrorName() string { return "UserFieldChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserFieldChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserFieldChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserFieldChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserFieldChangeValidationError{}
+
+// Validate checks the field values on UserUpdateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserUpdateRequestMultiError, or nil if none found.
+func (m *UserUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserUpdateRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserUpdateRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserUpdateRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserUpdateRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserUpdateRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserUpdateRequestValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.Password != nil {
+		// no validation rules for Password
+	}
+
+	if m.Etag != nil {
+		// no validation rules for Etag
+	}
+
+	if len(errors) > 0 {
+		return UserUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserUpdateRequestMultiError is an error wrapping multiple validation errors
+// returned by UserUpdateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserUpdateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserUpdateRequestMultiError) AllErrors() []error { return m }
+
+// UserUpdateRequestValidationError is the validation error returned by
+// UserUpdateRequest.Validate if the designated constraints aren't met.
+type UserUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserUpdateRequestValidationError) ErrorName() string {
+	return "UserUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserUpdateRequestValidationError{}
+
+// Validate checks the field values on UserUpdateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserUpdateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserUpdateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserUpdateResponseMultiError, or nil if none found.
+func (m *UserUpdateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserUpdateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserUpdateResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserUpdateResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserUpdateResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserUpdateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserUpdateResponseMultiError is an error wrapping multiple validation errors
+// returned by UserUpdateResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UserUpdateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserUpdateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserUpdateResponseMultiError) AllErrors() []error { return m }
+
+// UserUpdateResponseValidationError is the validation error returned by
+// UserUpdateResponse.Validate if the designated constraints aren't met.
+type UserUpdateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserUpdateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserUpdateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserUpdateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserUpdateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserUpdateResponseValidationError) ErrorName() string {
+	return "UserUpdateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserUpdateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserUpdateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserUpdateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserUpdateResponseValidationError{}
+
+// Validate checks the field values on UserDeleteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserDeleteRequestMultiError, or nil if none found.
+func (m *UserDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if m.Etag != nil {
+		// no validation rules for Etag
+	}
+
+	if len(errors) > 0 {
+		return UserDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDeleteRequestMultiError is an error wrapping multiple validation errors
+// returned by UserDeleteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDeleteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDeleteRequestMultiError) AllErrors() []error { return m }
+
+// UserDeleteRequestValidationError is the validation error returned by
+// UserDeleteRequest.Validate if the designated constraints aren't met.
+type UserDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDeleteRequestValidationError) ErrorName() string {
+	return "UserDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDeleteRequestValidationError{}
+
+// Validate checks the field values on UserEmailChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserEmailChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserEmailChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserEmailChangeMultiError, or nil if none found.
+func (m *UserEmailChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserEmailChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for NewEmail
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserEmailChangeValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserEmailChangeValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserEmailChangeValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUndoExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserEmailChangeValidationError{
+					field:  "UndoExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserEmailChangeValidationError{
+					field:  "UndoExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUndoExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserEmailChangeValidationError{
+				field:  "UndoExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserEmailChangeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserEmailChangeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserEmailChangeValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.ConfirmedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetConfirmedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserEmailChangeValidationError{
+						field:  "ConfirmedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserEmailChangeValidationError{
+						field:  "ConfirmedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConfirmedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserEmailChangeValidationError{
+					field:  "ConfirmedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserEmailChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserEmailChangeMultiError is an error wrapping multiple validation errors
+// returned by UserEmailChange.ValidateAll() if the designated constraints
+// aren't met.
+type UserEmailChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserEmailChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserEmailChangeMultiError) AllErrors() []error { return m }
+
+// UserEmailChangeValidationError is the validation error returned by
+// UserEmailChange.Validate if the designated constraints aren't met.
+type UserEmailChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserEmailChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserEmailChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserEmailChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserEmailChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserEmailChangeValidationError) ErrorName() string { return "UserEmailChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserEmailChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserEmailChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserEmailChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserEmailChangeValidationError{}
+
+// Validate checks the field values on UserChangeEmailRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserChangeEmailRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserChangeEmailRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserChangeEmailRequestMultiError, or nil if none found.
+func (m *UserChangeEmailRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserChangeEmailRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = UserChangeEmailRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Etag != nil {
+		// no validation rules for Etag
+	}
+
+	if len(errors) > 0 {
+		return UserChangeEmailRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UserChangeEmailRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *UserChangeEmailRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// UserChangeEmailRequestMultiError is an error wrapping multiple validation
+// errors returned by UserChangeEmailRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UserChangeEmailRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserChangeEmailRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserChangeEmailRequestMultiError) AllErrors() []error { return m }
+
+// UserChangeEmailRequestValidationError is the validation error returned by
+// UserChangeEmailRequest.Validate if the designated constraints aren't met.
+type UserChangeEmailRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserChangeEmailRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserChangeEmailRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserChangeEmailRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserChangeEmailRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserChangeEmailRequestValidationError) ErrorName() string {
+	return "UserChangeEmailRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserChangeEmailRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserChangeEmailRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserChangeEmailRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserChangeEmailRequestValidationError{}
+
+// Validate checks the field values on UserChangeEmailResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserChangeEmailResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserChangeEmailResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserChangeEmailResponseMultiError, or nil if none found.
+func (m *UserChangeEmailResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserChangeEmailResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserChangeEmailResponseValidationError{
+					field:  "Change",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserChangeEmailResponseValidationError{
+					field:  "Change",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserChangeEmailResponseValidationError{
+				field:  "Change",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserChangeEmailResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserChangeEmailResponseMultiError is an error wrapping multiple validation
+// errors returned by UserChangeEmailResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UserChangeEmailResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserChangeEmailResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserChangeEmailResponseMultiError) AllErrors() []error { return m }
+
+// UserChangeEmailResponseValidationError is the validation error returned by
+// UserChangeEmailResponse.Validate if the designated constraints aren't met.
+type UserChangeEmailResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserChangeEmailResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserChangeEmailResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserChangeEmailResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserChangeEmailResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserChangeEmailResponseValidationError) ErrorName() string {
+	return "UserChangeEmailResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserChangeEmailResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserChangeEmailResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserChangeEmailResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserChangeEmailResponseValidationError{}
+
+// Validate checks the field values on UserConfirmEmailChangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserConfirmEmailChangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserConfirmEmailChangeRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UserConfirmEmailChangeRequestMultiError, or nil if none found.
+func (m *UserConfirmEmailChangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserConfirmEmailChangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := UserConfirmEmailChangeRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserConfirmEmailChangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserConfirmEmailChangeRequestMultiError is an error wrapping multiple
+// validation errors returned by UserConfirmEmailChangeRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UserConfirmEmailChangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserConfirmEmailChangeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserConfirmEmailChangeRequestMultiError) AllErrors() []error { return m }
+
+// UserConfirmEmailChangeRequestValidationError is the validation error
+// returned by UserConfirmEmailChangeRequest.Validate if the designated
+// constraints aren't met.
+type UserConfirmEmailChangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserConfirmEmailChangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserConfirmEmailChangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserConfirmEmailChangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserConfirmEmailChangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserConfirmEmailChangeRequestValidationError) ErrorName() string {
+	return "UserConfirmEmailChangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserConfirmEmailChangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserConfirmEmailChangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserConfirmEmailChangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserConfirmEmailChangeRequestValidationError{}
+
+// Validate checks the field values on UserConfirmEmailChangeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserConfirmEmailChangeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserConfirmEmailChangeResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UserConfirmEmailChangeResponseMultiError, or nil if none found.
+func (m *UserConfirmEmailChangeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserConfirmEmailChangeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserConfirmEmailChangeResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserConfirmEmailChangeResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserConfirmEmailChangeResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserConfirmEmailChangeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserConfirmEmailChangeResponseMultiError is an error wrapping multiple
+// validation errors returned by UserConfirmEmailChangeResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UserConfirmEmailChangeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserConfirmEmailChangeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserConfirmEmailChangeResponseMultiError) AllErrors() []error { return m }
+
+// UserConfirmEmailChangeResponseValidationError is the validation error
+// returned by UserConfirmEmailChangeResponse.Validate if the designated
+// constraints aren't met.
+type UserConfirmEmailChangeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserConfirmEmailChangeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserConfirmEmailChangeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserConfirmEmailChangeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserConfirmEmailChangeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserConfirmEmailChangeResponseValidationError) ErrorName() string {
+	return "UserConfirmEmailChangeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserConfirmEmailChangeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserConfirmEmailChangeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserConfirmEmailChangeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserConfirmEmailChangeResponseValidationError{}
+
+// Validate checks the field values on UserUndoEmailChangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserUndoEmailChangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserUndoEmailChangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserUndoEmailChangeRequestMultiError, or nil if none found.
+func (m *UserUndoEmailChangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserUndoEmailChangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := UserUndoEmailChangeRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserUndoEmailChangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserUndoEmailChangeRequestMultiError is an error wrapping multiple
+// validation errors returned by UserUndoEmailChangeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UserUndoEmailChangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserUndoEmailChangeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserUndoEmailChangeRequestMultiError) AllErrors() []error { return m }
+
+// UserUndoEmailChangeRequestValidationError is the validation error returned
+// by UserUndoEmailChangeRequest.Validate if the designated constraints aren't met.
+type UserUndoEmailChangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserUndoEmailChangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserUndoEmailChangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserUndoEmailChangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserUndoEmailChangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserUndoEmailChangeRequestValidationError) ErrorName() string {
+	return "UserUndoEmailChangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserUndoEmailChangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserUndoEmailChangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserUndoEmailChangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserUndoEmailChangeRequestValidationError{}
+
+// Validate checks the field values on UserUndoEmailChangeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserUndoEmailChangeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserUndoEmailChangeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserUndoEmailChangeResponseMultiError, or nil if none found.
+func (m *UserUndoEmailChangeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserUndoEmailChangeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserUndoEmailChangeResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserUndoEmailChangeResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserUndoEmailChangeResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserUndoEmailChangeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserUndoEmailChangeResponseMultiError is an error wrapping multiple
+// validation errors returned by UserUndoEmailChangeResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UserUndoEmailChangeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserUndoEmailChangeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserUndoEmailChangeResponseMultiError) AllErrors() []error { return m }
+
+// UserUndoEmailChangeResponseValidationError is the validation error returned
+// by UserUndoEmailChangeResponse.Validate if the designated constraints
+// aren't met.
+type UserUndoEmailChangeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserUndoEmailChangeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserUndoEmailChangeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserUndoEmailChangeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserUndoEmailChangeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserUndoEmailChangeResponseValidationError) ErrorName() string {
+	return "UserUndoEmailChangeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserUndoEmailChangeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserUndoEmailChangeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserUndoEmailChangeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserUndoEmailChangeResponseValidationError{}
@@ -20,10 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UsersAPI_Create_FullMethodName = "/users.UsersAPI/Create"
-	UsersAPI_Get_FullMethodName    = "/users.UsersAPI/Get"
-	UsersAPI_Update_FullMethodName = "/users.UsersAPI/Update"
-	UsersAPI_Delete_FullMethodName = "/users.UsersAPI/Delete"
+	UsersAPI_Create_FullMethodName             = "/users.UsersAPI/Create"
+	UsersAPI_Get_FullMethodName                = "/users.UsersAPI/Get"
+	UsersAPI_GetHistory_FullMethodName         = "/users.UsersAPI/GetHistory"
+	UsersAPI_Update_FullMethodName             = "/users.UsersAPI/Update"
+	UsersAPI_Delete_FullMethodName             = "/users.UsersAPI/Delete"
+	UsersAPI_ChangeEmail_FullMethodName        = "/users.UsersAPI/ChangeEmail"
+	UsersAPI_ConfirmEmailChange_FullMethodName = "/users.UsersAPI/ConfirmEmailChange"
+	UsersAPI_UndoEmailChange_FullMethodName    = "/users.UsersAPI/UndoEmailChange"
 )
 
 // UsersAPIClient is the client API for UsersAPI service.
@@ -36,10 +40,18 @@ type UsersAPIClient interface {
 	Create(ctx context.Context, in *UserCreateRequest, opts ...grpc.CallOption) (*UserCreateResponse, error)
 	// Get
 	Get(ctx context.Context, in *UserGetRequest, opts ...grpc.CallOption) (*UserGetResponse, error)
+	// GetHistory возвращает историю изменений пользователя
+	GetHistory(ctx context.Context, in *UserGetHistoryRequest, opts ...grpc.CallOption) (*UserGetHistoryResponse, error)
 	// Update
 	Update(ctx context.Context, in *UserUpdateRequest, opts ...grpc.CallOption) (*UserUpdateResponse, error)
 	// Delete
 	Delete(ctx context.Context, in *UserDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ChangeEmail запрашивает смену email с подтверждением по ссылке
+	ChangeEmail(ctx context.Context, in *UserChangeEmailRequest, opts ...grpc.CallOption) (*UserChangeEmailResponse, error)
+	// ConfirmEmailChange подтверждает новый email по токену из письма
+	ConfirmEmailChange(ctx context.Context, in *UserConfirmEmailChangeRequest, opts ...grpc.CallOption) (*UserConfirmEmailChangeResponse, error)
+	// UndoEmailChange отменяет смену email по токену из письма на прежний email
+	UndoEmailChange(ctx context.Context, in *UserUndoEmailChangeRequest, opts ...grpc.CallOption) (*UserUndoEmailChangeResponse, error)
 }
 
 type usersAPIClient struct {
@@ -70,6 +82,16 @@ func (c *usersAPIClient) Get(ctx context.Context, in *UserGetRequest, opts ...gr
 	return out, nil
 }
 
+func (c *usersAPIClient) GetHistory(ctx context.Context, in *UserGetHistoryRequest, opts ...grpc.CallOption) (*UserGetHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserGetHistoryResponse)
+	err := c.cc.Invoke(ctx, UsersAPI_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersAPIClient) Update(ctx context.Context, in *UserUpdateRequest, opts ...grpc.CallOption) (*UserUpdateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UserUpdateResponse)
@@ -90,6 +112,36 @@ func (c *usersAPIClient) Delete(ctx context.Context, in *UserDeleteRequest, opts
 	return out, nil
 }
 
+func (c *usersAPIClient) ChangeEmail(ctx context.Context, in *UserChangeEmailRequest, opts ...grpc.CallOption) (*UserChangeEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserChangeEmailResponse)
+	err := c.cc.Invoke(ctx, UsersAPI_ChangeEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersAPIClient) ConfirmEmailChange(ctx context.Context, in *UserConfirmEmailChangeRequest, opts ...grpc.CallOption) (*UserConfirmEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserConfirmEmailChangeResponse)
+	err := c.cc.Invoke(ctx, UsersAPI_ConfirmEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersAPIClient) UndoEmailChange(ctx context.Context, in *UserUndoEmailChangeRequest, opts ...grpc.CallOption) (*UserUndoEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserUndoEmailChangeResponse)
+	err := c.cc.Invoke(ctx, UsersAPI_UndoEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsersAPIServer is the server API for UsersAPI service.
 // All implementations must embed UnimplementedUsersAPIServer
 // for forward compatibility.
@@ -100,10 +152,18 @@ type UsersAPIServer interface {
 	Create(context.Context, *UserCreateRequest) (*UserCreateResponse, error)
 	// Get
 	Get(context.Context, *UserGetRequest) (*UserGetResponse, error)
+	// GetHistory возвращает историю изменений пользователя
+	GetHistory(context.Context, *UserGetHistoryRequest) (*UserGetHistoryResponse, error)
 	// Update
 	Update(context.Context, *UserUpdateRequest) (*UserUpdateResponse, error)
 	// Delete
 	Delete(context.Context, *UserDeleteRequest) (*emptypb.Empty, error)
+	// ChangeEmail запрашивает смену email с подтверждением по ссылке
+	ChangeEmail(context.Context, *UserChangeEmailRequest) (*UserChangeEmailResponse, error)
+	// ConfirmEmailChange подтверждает новый email по токену из письма
+	ConfirmEmailChange(context.Context, *UserConfirmEmailChangeRequest) (*UserConfirmEmailChangeResponse, error)
+	// UndoEmailChange отменяет смену email по токену из письма на прежний email
+	UndoEmailChange(context.Context, *UserUndoEmailChangeRequest) (*UserUndoEmailChangeResponse, error)
 	mustEmbedUnimplementedUsersAPIServer()
 }
 
@@ -120,12 +180,24 @@ func (UnimplementedUsersAPIServer) Create(context.Context, *UserCreateRequest) (
 func (UnimplementedUsersAPIServer) Get(context.Context, *UserGetRequest) (*UserGetResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedUsersAPIServer) GetHistory(context.Context, *UserGetHistoryRequest) (*UserGetHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHistory not implemented")
+}
 func (UnimplementedUsersAPIServer) Update(context.Context, *UserUpdateRequest) (*UserUpdateResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Update not implemented")
 }
 func (UnimplementedUsersAPIServer) Delete(context.Context, *UserDeleteRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedUsersAPIServer) ChangeEmail(context.Context, *UserChangeEmailRequest) (*UserChangeEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeEmail not implemented")
+}
+func (UnimplementedUsersAPIServer) ConfirmEmailChange(context.Context, *UserConfirmEmailChangeRequest) (*UserConfirmEmailChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmEmailChange not implemented")
+}
+func (UnimplementedUsersAPIServer) UndoEmailChange(context.Context, *UserUndoEmailChangeRequest) (*UserUndoEmailChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UndoEmailChange not implemented")
+}
 func (UnimplementedUsersAPIServer) mustEmbedUnimplementedUsersAPIServer() {}
 func (UnimplementedUsersAPIServer) testEmbeddedByValue()                  {}
 
@@ -183,6 +255,24 @@ func _UsersAPI_Get_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersAPI_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserGetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersAPIServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersAPI_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersAPIServer).GetHistory(ctx, req.(*UserGetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UsersAPI_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserUpdateRequest)
 	if err := dec(in); err != nil {
@@ -219,6 +309,60 @@ func _UsersAPI_Delete_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersAPI_ChangeEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserChangeEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersAPIServer).ChangeEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersAPI_ChangeEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersAPIServer).ChangeEmail(ctx, req.(*UserChangeEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersAPI_ConfirmEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserConfirmEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersAPIServer).ConfirmEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersAPI_ConfirmEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersAPIServer).ConfirmEmailChange(ctx, req.(*UserConfirmEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersAPI_UndoEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserUndoEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersAPIServer).UndoEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersAPI_UndoEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersAPIServer).UndoEmailChange(ctx, req.(*UserUndoEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UsersAPI_ServiceDesc is the grpc.ServiceDesc for UsersAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -234,6 +378,10 @@ var UsersAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _UsersAPI_Get_Handler,
 		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _UsersAPI_GetHistory_Handler,
+		},
 		{
 			MethodName: "Update",
 			Handler:    _UsersAPI_Update_Handler,
@@ -242,6 +390,18 @@ var UsersAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _UsersAPI_Delete_Handler,
 		},
+		{
+			MethodName: "ChangeEmail",
+			Handler:    _UsersAPI_ChangeEmail_Handler,
+		},
+		{
+			MethodName: "ConfirmEmailChange",
+			Handler:    _UsersAPI_ConfirmEmailChange_Handler,
+		},
+		{
+			MethodName: "UndoEmailChange",
+			Handler:    _UsersAPI_UndoEmailChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "users.proto",